@@ -0,0 +1,279 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+ BusinessCalendarDto
+ ===================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\businesscalendardto.go
+
+ Overview and Usage
+ ==================
+ The 'BusinessCalendarDto' Type defines the days of the week which are
+ weekend days together with an optional holiday calendar. A business day
+ is any day which is neither a weekend day nor a holiday.
+
+ 'BusinessCalendarDto' is used by the business day methods of 'DateTzDto':
+
+	AddBusinessDays()
+	AddBusinessDaysToThis()
+	GetBusinessDaysBetween()
+	IsBusinessDay()
+	NextBusinessDay()
+	PreviousBusinessDay()
+
+ Business days are evaluated using the local calendar date of a date time
+ in its own time zone.
+
+ Holiday calendars are supplied through the 'HolidayCalendar' interface
+ which is also defined in this source file.
+
+ Example: An office with a Friday/Saturday weekend and no holidays.
+
+	bCal, err := BusinessCalendarDto{}.New(
+	                []time.Weekday{time.Friday, time.Saturday}, nil)
+
+*/
+
+// HolidayCalendar - Identifies holidays. Implementations report whether
+// the calendar date 'year', 'month', 'day' is a holiday on which business
+// is not conducted.
+type HolidayCalendar interface {
+	IsHoliday(year int, month time.Month, day int) bool
+}
+
+// maxConsecutiveNonBusinessDays - Limits the search for the next or
+// previous business day. If this many consecutive days are weekend
+// days or holidays, the search fails with an error.
+const maxConsecutiveNonBusinessDays = 366
+
+// BusinessCalendarDto - Defines weekend days and holidays used in
+// business day calculations.
+type BusinessCalendarDto struct {
+	WeekendDays []time.Weekday  // Days of the week which are NOT business days
+	Holidays    HolidayCalendar // Optional. If nil, no holidays are observed.
+}
+
+// CopyOut - Returns a copy of the current BusinessCalendarDto. The
+// Holidays calendar is shared, not copied.
+func (bCal *BusinessCalendarDto) CopyOut() BusinessCalendarDto {
+
+	bCal2 := BusinessCalendarDto{}
+
+	bCal2.WeekendDays = make([]time.Weekday, len(bCal.WeekendDays))
+
+	copy(bCal2.WeekendDays, bCal.WeekendDays)
+
+	bCal2.Holidays = bCal.Holidays
+
+	return bCal2
+}
+
+// IsBusinessDay - Returns 'true' if the calendar date 'year', 'month',
+// 'day' is neither a weekend day nor a holiday.
+func (bCal *BusinessCalendarDto) IsBusinessDay(year int, month time.Month, day int) bool {
+
+	weekDay := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()
+
+	if bCal.IsWeekendDay(weekDay) {
+		return false
+	}
+
+	if bCal.Holidays != nil && bCal.Holidays.IsHoliday(year, month, day) {
+		return false
+	}
+
+	return true
+}
+
+// IsValid - Returns an error if the current BusinessCalendarDto is
+// invalid. At least one day of the week must be a business day.
+func (bCal *BusinessCalendarDto) IsValid() error {
+
+	ePrefix := "BusinessCalendarDto.IsValid() "
+
+	weekendCnt := 0
+
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+
+		if bCal.IsWeekendDay(wd) {
+			weekendCnt++
+		}
+	}
+
+	if weekendCnt == 7 {
+		return fmt.Errorf(ePrefix + "Error: All seven days of the week are weekend days!")
+	}
+
+	for _, wd := range bCal.WeekendDays {
+
+		if wd < time.Sunday || wd > time.Saturday {
+			return fmt.Errorf(ePrefix + "Error: Invalid weekend day. WeekendDay='%v'", int(wd))
+		}
+	}
+
+	return nil
+}
+
+// IsWeekendDay - Returns 'true' if 'weekDay' is a weekend day.
+func (bCal *BusinessCalendarDto) IsWeekendDay(weekDay time.Weekday) bool {
+
+	for _, wd := range bCal.WeekendDays {
+
+		if wd == weekDay {
+			return true
+		}
+	}
+
+	return false
+}
+
+// New - Creates a new BusinessCalendarDto.
+//
+// Input Parameters:
+// =================
+//
+// weekendDays []time.Weekday  - The days of the week which are NOT business days.
+//                               Example: []time.Weekday{time.Saturday, time.Sunday}
+//                               An empty slice signals that every day of the week
+//                               is a business day.
+//
+// holidays    HolidayCalendar - Optional. The holiday calendar. If nil, no holidays
+//                               are observed.
+//
+func (bCal BusinessCalendarDto) New(
+	weekendDays []time.Weekday,
+	holidays HolidayCalendar) (BusinessCalendarDto, error) {
+
+	ePrefix := "BusinessCalendarDto.New() "
+
+	bCal2 := BusinessCalendarDto{}
+
+	bCal2.WeekendDays = make([]time.Weekday, len(weekendDays))
+
+	copy(bCal2.WeekendDays, weekendDays)
+
+	bCal2.Holidays = holidays
+
+	err := bCal2.IsValid()
+
+	if err != nil {
+		return BusinessCalendarDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return bCal2, nil
+}
+
+// NewSatSunWeekend - Creates a new BusinessCalendarDto with a Saturday
+// and Sunday weekend. Input parameter 'holidays' is optional and may be
+// nil.
+func (bCal BusinessCalendarDto) NewSatSunWeekend(holidays HolidayCalendar) BusinessCalendarDto {
+
+	bCal2 := BusinessCalendarDto{}
+
+	bCal2.WeekendDays = []time.Weekday{time.Saturday, time.Sunday}
+
+	bCal2.Holidays = holidays
+
+	return bCal2
+}
+
+// addBusinessDays - Adds 'days' business days to date time 't'. Negative
+// values subtract business days. The wall clock time of day is retained.
+// Daylight savings gaps and overlaps are resolved as in addLocalDays().
+// If 'days' is zero, 't' is returned unchanged.
+func (bCal *BusinessCalendarDto) addBusinessDays(t time.Time, days int) (time.Time, error) {
+
+	err := bCal.IsValid()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	step := 1
+
+	if days < 0 {
+		step = -1
+		days = -days
+	}
+
+	year, month, day := t.Date()
+
+	offset := 0
+	nonBusinessCnt := 0
+
+	for days > 0 {
+
+		offset += step
+
+		d := time.Date(year, month, day+offset, 0, 0, 0, 0, time.UTC)
+
+		if bCal.IsBusinessDay(d.Year(), d.Month(), d.Day()) {
+			days--
+			nonBusinessCnt = 0
+			continue
+		}
+
+		nonBusinessCnt++
+
+		if nonBusinessCnt > maxConsecutiveNonBusinessDays {
+			return time.Time{},
+				fmt.Errorf("Error: No business day found within %v days of '%v'.",
+					maxConsecutiveNonBusinessDays, d.Format("2006-01-02"))
+		}
+	}
+
+	return addLocalDays(t, offset), nil
+}
+
+// countBusinessDays - Returns the number of business days between the
+// local calendar dates of 't1' and 't2'. Days are counted in the same
+// manner as addBusinessDays() steps through the calendar. If 't2' is
+// later than 't1', business days 'd' where date(t1) < d <= date(t2) are
+// counted. If 't2' is earlier than 't1', business days 'd' where
+// date(t2) <= d < date(t1) are counted and the result is negative.
+//
+// As a result, if t2 = addBusinessDays(t1, n), then
+// countBusinessDays(t1, t2) = n.
+func (bCal *BusinessCalendarDto) countBusinessDays(t1, t2 time.Time) (int, error) {
+
+	err := bCal.IsValid()
+
+	if err != nil {
+		return 0, err
+	}
+
+	dayNum1 := civilDayNumber(t1)
+	dayNum2 := civilDayNumber(t2)
+
+	sign := 1
+	firstDay := dayNum1 + 1
+	lastDay := dayNum2
+
+	if dayNum2 < dayNum1 {
+		sign = -1
+		firstDay = dayNum2
+		lastDay = dayNum1 - 1
+	}
+
+	count := 0
+
+	for dayNum := firstDay; dayNum <= lastDay; dayNum++ {
+
+		y, m, d := civilDate(dayNum)
+
+		if bCal.IsBusinessDay(y, m, d) {
+			count++
+		}
+	}
+
+	return count * sign, nil
+}
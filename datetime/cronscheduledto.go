@@ -0,0 +1,899 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ CronScheduleDto
+ ===============
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\cronscheduledto.go
+
+ Overview and Usage
+ ==================
+ The 'CronScheduleDto' Type parses cron expressions and computes fire
+ times in an IANA time zone.
+
+ Expression Formats:
+
+	5 Fields: Minute Hour Day-of-Month Month Day-of-Week
+	6 Fields: Second Minute Hour Day-of-Month Month Day-of-Week
+
+	Field          Allowed Values     Special Characters
+	-----          --------------     ------------------
+	Second         0-59               * , - /
+	Minute         0-59               * , - /
+	Hour           0-23               * , - /
+	Day-of-Month   1-31               * , - / ? L W
+	Month          1-12 or JAN-DEC    * , - /
+	Day-of-Week    0-7 or SUN-SAT     * , - / ? L #
+	               (0 and 7 = Sunday)
+
+ Macros:
+
+	@yearly, @annually  - 0 0 1 1 *
+	@monthly            - 0 0 1 * *
+	@weekly             - 0 0 * * 0
+	@daily, @midnight   - 0 0 * * *
+	@hourly             - 0 * * * *
+
+ Modifiers:
+
+	L     Day-of-Month: The last day of the month. "L-3" = three days
+	      before the last day of the month.
+	LW    Day-of-Month: The last weekday (Monday-Friday) of the month.
+	nW    Day-of-Month: The weekday nearest to day 'n' of the month. The
+	      result never crosses into an adjacent month. Example: "15W"
+	nL    Day-of-Week: The last weekday 'n' of the month. "5L" = the last
+	      Friday of the month.
+	n#k   Day-of-Week: The k-th weekday 'n' of the month. "MON#2" = the
+	      second Monday of the month.
+
+ If both Day-of-Month and Day-of-Week are restricted (neither begins with
+ '*' or '?'), a day matches if EITHER field matches. This is the traditional
+ cron behavior.
+
+ Daylight Savings Time Policy:
+
+ Cron fields specify local wall clock times in the schedule time zone.
+
+	(1) Wall clock times skipped when Daylight Savings Time begins fire
+	    once at the first instant following the gap. Example: In
+	    America/New_York on 2026-03-08, a 02:30 job fires at 03:00. If
+	    several scheduled times fall in the gap (02:00, 02:15, ...), the
+	    job fires only once at 03:00.
+
+	(2) Wall clock times which occur twice when Daylight Savings Time ends
+	    fire only once, at the first occurrence. Example: In America/New_York
+	    on 2026-11-01, a job scheduled every 15 minutes fires at 01:00,
+	    01:15, 01:30 and 01:45 EDT, does NOT fire during the repeated hour
+	    01:00 - 01:59 EST, and resumes at 02:00 EST.
+
+ Example:
+
+	cron, err := CronScheduleDto{}.New("30 2 * * MON-FRI", TzIanaUsEast)
+
+	nextDtz, err := cron.GetNextFireTime(refDtz, "")
+
+*/
+
+// cronMacros - Cron macros and their equivalent 5 field expressions
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronMonthNames - Month names indexed by month number - 1
+var cronMonthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN",
+	"JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+
+// cronWeekDayNames - Day of week names indexed by time.Weekday
+var cronWeekDayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+// cronMaxSearchYears - The maximum number of years searched for a fire
+// time. Eight years are required to reach February 29th across a
+// century year which is not a leap year.
+const cronMaxSearchYears = 9
+
+// cronNthWeekDay - A Day-of-Week "n#k" element
+type cronNthWeekDay struct {
+	weekDay time.Weekday
+	nth     int
+}
+
+// CronScheduleDto - A parsed cron expression evaluated in an IANA
+// time zone.
+type CronScheduleDto struct {
+	Expression       string // The cron expression
+	TimeZoneLocation string // IANA Time Zone Location in which fire times are computed
+
+	seconds        uint64           // Bit n set = second n
+	minutes        uint64           // Bit n set = minute n
+	hours          uint64           // Bit n set = hour n
+	months         uint64           // Bit n set = month n
+	domBits        uint64           // Bit n set = day of month n
+	domLastOffsets []int            // "L" = 0, "L-3" = 3
+	domLastWeekday bool             // "LW"
+	domNearest     []int            // "nW"
+	domRestricted  bool             // Day-of-Month does not begin with '*' or '?'
+	dowBits        uint64           // Bit n set = time.Weekday n
+	dowLast        []time.Weekday   // "nL"
+	dowNth         []cronNthWeekDay // "n#k"
+	dowRestricted  bool             // Day-of-Week does not begin with '*' or '?'
+	loc            *time.Location
+}
+
+// CopyOut - Returns a deep copy of the current CronScheduleDto.
+func (cron *CronScheduleDto) CopyOut() CronScheduleDto {
+
+	cron2 := *cron
+
+	cron2.domLastOffsets = append([]int(nil), cron.domLastOffsets...)
+	cron2.domNearest = append([]int(nil), cron.domNearest...)
+	cron2.dowLast = append([]time.Weekday(nil), cron.dowLast...)
+	cron2.dowNth = append([]cronNthWeekDay(nil), cron.dowNth...)
+
+	return cron2
+}
+
+// GetNextFireTime - Returns the first fire time which is later than
+// 'referenceTime'. The returned DateTzDto is expressed in the schedule time
+// zone. If 'dateTimeFmtStr' is an empty string, the format of
+// 'referenceTime' is applied.
+func (cron *CronScheduleDto) GetNextFireTime(referenceTime DateTzDto, dateTimeFmtStr string) (DateTzDto, error) {
+
+	ePrefix := "CronScheduleDto.GetNextFireTime() "
+
+	t, err := cron.nextFireTime(referenceTime.DateTime)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return cron.newFireTimeDto(t, referenceTime, dateTimeFmtStr, ePrefix)
+}
+
+// GetNextFireTimes - Returns the next 'count' fire times which are later
+// than 'referenceTime'. See method GetNextFireTime().
+func (cron *CronScheduleDto) GetNextFireTimes(
+	referenceTime DateTzDto,
+	count int,
+	dateTimeFmtStr string) ([]DateTzDto, error) {
+
+	ePrefix := "CronScheduleDto.GetNextFireTimes() "
+
+	fireTimes := make([]DateTzDto, 0, count)
+
+	t := referenceTime.DateTime
+
+	for i := 0; i < count; i++ {
+
+		var err error
+
+		t, err = cron.nextFireTime(t)
+
+		if err != nil {
+			return nil, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		dtz, err := cron.newFireTimeDto(t, referenceTime, dateTimeFmtStr, ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fireTimes = append(fireTimes, dtz)
+	}
+
+	return fireTimes, nil
+}
+
+// GetPreviousFireTime - Returns the last fire time which is earlier than
+// 'referenceTime'. The returned DateTzDto is expressed in the schedule time
+// zone. If 'dateTimeFmtStr' is an empty string, the format of
+// 'referenceTime' is applied.
+func (cron *CronScheduleDto) GetPreviousFireTime(referenceTime DateTzDto, dateTimeFmtStr string) (DateTzDto, error) {
+
+	ePrefix := "CronScheduleDto.GetPreviousFireTime() "
+
+	t, err := cron.previousFireTime(referenceTime.DateTime)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return cron.newFireTimeDto(t, referenceTime, dateTimeFmtStr, ePrefix)
+}
+
+// IsFireTime - Returns 'true' if 'dateTime' is a fire time of the
+// current CronScheduleDto.
+func (cron *CronScheduleDto) IsFireTime(dateTime DateTzDto) bool {
+
+	t, err := cron.nextFireTime(dateTime.DateTime.Add(-time.Nanosecond))
+
+	if err != nil {
+		return false
+	}
+
+	return t.Equal(dateTime.DateTime)
+}
+
+// New - Parses a cron expression and returns a new CronScheduleDto.
+//
+// Input Parameters:
+// =================
+//
+// expression string        - A 5 field or 6 field cron expression or a macro.
+//                            Examples: "*/15 9-17 * * MON-FRI", "0 30 2 L * ?",
+//                            "@daily"
+//
+// timeZoneLocation string  - The IANA time zone in which fire times are
+//                            computed. If 'timeZoneLocation' is submitted as an
+//                            empty string, it will default to "Etc/UTC".
+//
+func (cron CronScheduleDto) New(expression, timeZoneLocation string) (CronScheduleDto, error) {
+
+	ePrefix := "CronScheduleDto.New() "
+
+	cron2 := CronScheduleDto{}
+
+	cron2.Expression = strings.TrimSpace(expression)
+
+	cron2.TimeZoneLocation = cron2.preProcessTimeZoneLocation(timeZoneLocation)
+
+	loc, err := time.LoadLocation(cron2.TimeZoneLocation)
+
+	if err != nil {
+		return CronScheduleDto{}, fmt.Errorf(ePrefix +
+			"Error: 'timeZoneLocation' input parameter is INVALID! " +
+			"timeZoneLocation='%v' Error='%v'", timeZoneLocation, err.Error())
+	}
+
+	cron2.loc = loc
+
+	fields := strings.Fields(cron2.Expression)
+
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+
+		macro, ok := cronMacros[strings.ToLower(fields[0])]
+
+		if !ok {
+			return CronScheduleDto{}, fmt.Errorf(ePrefix + "Error: Unknown macro. expression='%v'", expression)
+		}
+
+		fields = strings.Fields(macro)
+	}
+
+	if len(fields) == 5 {
+		fields = append([]string{"0"}, fields...)
+	}
+
+	if len(fields) != 6 {
+		return CronScheduleDto{}, fmt.Errorf(ePrefix +
+			"Error: A cron expression must contain 5 or 6 fields. expression='%v'", expression)
+	}
+
+	err = cron2.parseFields(fields)
+
+	if err != nil {
+		return CronScheduleDto{}, fmt.Errorf(ePrefix + "%v expression='%v'", err.Error(), expression)
+	}
+
+	return cron2, nil
+}
+
+// dayMatches - Returns 'true' if the wall clock date 'day' satisfies the
+// Day-of-Month and Day-of-Week fields.
+func (cron *CronScheduleDto) dayMatches(day time.Time) bool {
+
+	domMatch := cron.domMatches(day)
+	dowMatch := cron.dowMatches(day)
+
+	if cron.domRestricted && cron.dowRestricted {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
+
+// domMatches - Returns 'true' if the wall clock date 'day' satisfies the
+// Day-of-Month field.
+func (cron *CronScheduleDto) domMatches(day time.Time) bool {
+
+	d := day.Day()
+
+	if cron.domBits&(1<<uint(d)) != 0 {
+		return true
+	}
+
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	for _, offset := range cron.domLastOffsets {
+
+		if d == lastDay-offset {
+			return true
+		}
+	}
+
+	if cron.domLastWeekday && d == cronNearestWeekday(day.Year(), day.Month(), lastDay) {
+		return true
+	}
+
+	for _, n := range cron.domNearest {
+
+		if n <= lastDay && d == cronNearestWeekday(day.Year(), day.Month(), n) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dowMatches - Returns 'true' if the wall clock date 'day' satisfies the
+// Day-of-Week field.
+func (cron *CronScheduleDto) dowMatches(day time.Time) bool {
+
+	wd := day.Weekday()
+
+	if cron.dowBits&(1<<uint(wd)) != 0 {
+		return true
+	}
+
+	lastDay := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	for _, lastWd := range cron.dowLast {
+
+		if wd == lastWd && day.Day()+7 > lastDay {
+			return true
+		}
+	}
+
+	for _, nthWd := range cron.dowNth {
+
+		if wd == nthWd.weekDay && (day.Day()-1)/7+1 == nthWd.nth {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fireInstant - Converts the wall clock fire time 'naive' to an instant in
+// the schedule time zone. Wall clock times in a Daylight Savings Time gap
+// resolve to the first instant after the gap. Wall clock times which occur
+// twice resolve to the first occurrence.
+func (cron *CronScheduleDto) fireInstant(naive time.Time) time.Time {
+
+	y, m, d := naive.Date()
+
+	wallNs := naive.Sub(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)).Nanoseconds()
+
+	return resolveWallClock(y, m, d, wallNs, cron.loc)[0]
+}
+
+// newFireTimeDto - Converts fire time 't' to a DateTzDto in the schedule
+// time zone.
+func (cron *CronScheduleDto) newFireTimeDto(
+	t time.Time,
+	referenceTime DateTzDto,
+	dateTimeFmtStr,
+	ePrefix string) (DateTzDto, error) {
+
+	fmtStr := dateTimeFmtStr
+
+	if len(fmtStr) == 0 {
+		fmtStr = referenceTime.DateTimeFmt
+	}
+
+	dtz, err := DateTzDto{}.New(t.In(cron.loc), fmtStr)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "Error returned by DateTzDto{}.New(). Error='%v'", err.Error())
+	}
+
+	return dtz, nil
+}
+
+// nextFireTime - Returns the first fire instant later than 'ref'.
+func (cron *CronScheduleDto) nextFireTime(ref time.Time) (time.Time, error) {
+
+	if cron.loc == nil {
+		return time.Time{}, errors.New("Error: The CronScheduleDto has not been initialized. Use CronScheduleDto{}.New()")
+	}
+
+	if ref.IsZero() {
+		return time.Time{}, errors.New("Error: The reference date time is a ZERO value!")
+	}
+
+	naive := wallClockAsUTC(ref.In(cron.loc)).Truncate(time.Second)
+
+	for {
+
+		var err error
+
+		naive, err = cron.nextNaive(naive)
+
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		t := cron.fireInstant(naive)
+
+		if t.After(ref) {
+			return t, nil
+		}
+	}
+}
+
+// nextNaive - Returns the first wall clock time later than 'after' which
+// satisfies all cron fields.
+func (cron *CronScheduleDto) nextNaive(after time.Time) (time.Time, error) {
+
+	t := after.Truncate(time.Second).Add(time.Second)
+
+	yearLimit := after.Year() + cronMaxSearchYears
+
+	for t.Year() <= yearLimit {
+
+		if cron.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+		if !cron.dayMatches(day) {
+			t = day.AddDate(0, 0, 1)
+			continue
+		}
+
+		if cron.hours&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if cron.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+
+		if cron.seconds&(1<<uint(t.Second())) == 0 {
+			t = t.Add(time.Second)
+			continue
+		}
+
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("Error: No fire time found within %v years. expression='%v'",
+		cronMaxSearchYears, cron.Expression)
+}
+
+// parseFields - Parses the six cron fields.
+func (cron *CronScheduleDto) parseFields(fields []string) error {
+
+	var err error
+
+	cron.seconds, err = parseCronField(fields[0], 0, 59, nil)
+
+	if err != nil {
+		return fmt.Errorf("Second %v", err.Error())
+	}
+
+	cron.minutes, err = parseCronField(fields[1], 0, 59, nil)
+
+	if err != nil {
+		return fmt.Errorf("Minute %v", err.Error())
+	}
+
+	cron.hours, err = parseCronField(fields[2], 0, 23, nil)
+
+	if err != nil {
+		return fmt.Errorf("Hour %v", err.Error())
+	}
+
+	cron.months, err = parseCronField(fields[4], 1, 12, cronMonthNames)
+
+	if err != nil {
+		return fmt.Errorf("Month %v", err.Error())
+	}
+
+	err = cron.parseDayOfMonth(fields[3])
+
+	if err != nil {
+		return fmt.Errorf("Day-of-Month %v", err.Error())
+	}
+
+	err = cron.parseDayOfWeek(fields[5])
+
+	if err != nil {
+		return fmt.Errorf("Day-of-Week %v", err.Error())
+	}
+
+	return nil
+}
+
+// parseDayOfMonth - Parses the Day-of-Month field including the
+// L and W modifiers.
+func (cron *CronScheduleDto) parseDayOfMonth(field string) error {
+
+	cron.domRestricted = !strings.HasPrefix(field, "*") && !strings.HasPrefix(field, "?")
+
+	if field == "?" {
+		field = "*"
+	}
+
+	for _, element := range strings.Split(strings.ToUpper(field), ",") {
+
+		switch {
+
+		case element == "LW":
+
+			cron.domLastWeekday = true
+
+		case element == "L":
+
+			cron.domLastOffsets = append(cron.domLastOffsets, 0)
+
+		case strings.HasPrefix(element, "L-"):
+
+			offset, err := strconv.Atoi(element[2:])
+
+			if err != nil || offset < 0 || offset > 30 {
+				return fmt.Errorf("Error: Invalid 'L-n' value. element='%v'", element)
+			}
+
+			cron.domLastOffsets = append(cron.domLastOffsets, offset)
+
+		case strings.HasSuffix(element, "W"):
+
+			n, err := strconv.Atoi(element[:len(element)-1])
+
+			if err != nil || n < 1 || n > 31 {
+				return fmt.Errorf("Error: Invalid 'nW' value. element='%v'", element)
+			}
+
+			cron.domNearest = append(cron.domNearest, n)
+
+		default:
+
+			bits, err := parseCronField(element, 1, 31, nil)
+
+			if err != nil {
+				return err
+			}
+
+			cron.domBits |= bits
+		}
+	}
+
+	return nil
+}
+
+// parseDayOfWeek - Parses the Day-of-Week field including the
+// L and # modifiers.
+func (cron *CronScheduleDto) parseDayOfWeek(field string) error {
+
+	cron.dowRestricted = !strings.HasPrefix(field, "*") && !strings.HasPrefix(field, "?")
+
+	if field == "?" {
+		field = "*"
+	}
+
+	parseWeekDay := func(s string) (time.Weekday, error) {
+
+		bits, err := parseCronField(s, 0, 7, cronWeekDayNames)
+
+		if err != nil {
+			return time.Sunday, err
+		}
+
+		for wd := 0; wd <= 7; wd++ {
+
+			if bits == 1<<uint(wd) {
+				return time.Weekday(wd % 7), nil
+			}
+		}
+
+		return time.Sunday, fmt.Errorf("Error: Expected a single day of week. value='%v'", s)
+	}
+
+	for _, element := range strings.Split(strings.ToUpper(field), ",") {
+
+		if idx := strings.Index(element, "#"); idx > 0 {
+
+			wd, err := parseWeekDay(element[:idx])
+
+			if err != nil {
+				return err
+			}
+
+			nth, err := strconv.Atoi(element[idx+1:])
+
+			if err != nil || nth < 1 || nth > 5 {
+				return fmt.Errorf("Error: Invalid 'n#k' value. element='%v'", element)
+			}
+
+			cron.dowNth = append(cron.dowNth, cronNthWeekDay{weekDay: wd, nth: nth})
+
+			continue
+		}
+
+		if len(element) > 1 && strings.HasSuffix(element, "L") {
+
+			wd, err := parseWeekDay(element[:len(element)-1])
+
+			if err != nil {
+				return err
+			}
+
+			cron.dowLast = append(cron.dowLast, wd)
+
+			continue
+		}
+
+		bits, err := parseCronField(element, 0, 7, cronWeekDayNames)
+
+		if err != nil {
+			return err
+		}
+
+		// Day of week 7 is Sunday
+		if bits&(1<<7) != 0 {
+			bits = (bits | 1) &^ (1 << 7)
+		}
+
+		cron.dowBits |= bits
+	}
+
+	return nil
+}
+
+// preProcessTimeZoneLocation - Converts an empty time zone location to
+// "Etc/UTC" and standardizes the value "Local".
+func (cron *CronScheduleDto) preProcessTimeZoneLocation(timeZoneLocation string) string {
+
+	if len(timeZoneLocation) == 0 {
+		return TzIanaUTC
+	}
+
+	if strings.ToLower(timeZoneLocation) == "local" {
+		return "Local"
+	}
+
+	return timeZoneLocation
+}
+
+// previousFireTime - Returns the last fire instant earlier than 'ref'.
+func (cron *CronScheduleDto) previousFireTime(ref time.Time) (time.Time, error) {
+
+	if cron.loc == nil {
+		return time.Time{}, errors.New("Error: The CronScheduleDto has not been initialized. Use CronScheduleDto{}.New()")
+	}
+
+	if ref.IsZero() {
+		return time.Time{}, errors.New("Error: The reference date time is a ZERO value!")
+	}
+
+	// If 'ref' falls in a repeated hour, wall clock times later than the
+	// wall clock time of 'ref' may have a first occurrence preceding 'ref'.
+	// The search therefore begins at the later of the two wall clock times
+	// computed with the UTC offsets in effect at 'ref' and one day earlier.
+	wall := wallClockAsUTC(ref.In(cron.loc))
+
+	priorWall := wallClockAsUTC(ref.Add(-24 * time.Hour).In(cron.loc)).Add(24 * time.Hour)
+
+	if priorWall.After(wall) {
+		wall = priorWall
+	}
+
+	// Candidates are strictly earlier than 'naive'. A reference time
+	// with fractional seconds may follow a fire time in the same second.
+	naive := wall.Truncate(time.Second).Add(time.Second)
+
+	for {
+
+		var err error
+
+		naive, err = cron.previousNaive(naive)
+
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		t := cron.fireInstant(naive)
+
+		if t.Before(ref) {
+			return t, nil
+		}
+	}
+}
+
+// previousNaive - Returns the last wall clock time earlier than 'before'
+// which satisfies all cron fields.
+func (cron *CronScheduleDto) previousNaive(before time.Time) (time.Time, error) {
+
+	t := before.Truncate(time.Second).Add(-time.Second)
+
+	yearLimit := before.Year() - cronMaxSearchYears
+
+	for t.Year() >= yearLimit {
+
+		if cron.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+			continue
+		}
+
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+		if !cron.dayMatches(day) {
+			t = day.Add(-time.Second)
+			continue
+		}
+
+		if cron.hours&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(-time.Second)
+			continue
+		}
+
+		if cron.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Truncate(time.Minute).Add(-time.Second)
+			continue
+		}
+
+		if cron.seconds&(1<<uint(t.Second())) == 0 {
+			t = t.Add(-time.Second)
+			continue
+		}
+
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("Error: No fire time found within %v years. expression='%v'",
+		cronMaxSearchYears, cron.Expression)
+}
+
+// cronNearestWeekday - Returns the day of the month of the weekday
+// (Monday-Friday) nearest to 'day' without leaving the month.
+func cronNearestWeekday(year int, month time.Month, day int) int {
+
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+
+	case time.Saturday:
+
+		if day == 1 {
+			return 3
+		}
+
+		return day - 1
+
+	case time.Sunday:
+
+		if day == lastDay {
+			return day - 2
+		}
+
+		return day + 1
+	}
+
+	return day
+}
+
+// parseCronField - Parses a comma separated cron field consisting of
+// values, ranges and steps. Returns a bit set in which bit n is set if
+// value n is selected. 'names' optionally supplies text names for the
+// values beginning with 'min'.
+func parseCronField(field string, min, max int, names []string) (uint64, error) {
+
+	var bits uint64
+
+	parseValue := func(s string) (int, error) {
+
+		for i, name := range names {
+
+			if strings.EqualFold(name, s) {
+				return i + min, nil
+			}
+		}
+
+		v, err := strconv.Atoi(s)
+
+		if err != nil || v < min || v > max {
+			return 0, fmt.Errorf("Error: Invalid value. value='%v'", s)
+		}
+
+		return v, nil
+	}
+
+	for _, element := range strings.Split(field, ",") {
+
+		if len(element) == 0 {
+			return 0, fmt.Errorf("Error: Empty list element. field='%v'", field)
+		}
+
+		rangePart := element
+		step := 1
+
+		if idx := strings.Index(element, "/"); idx >= 0 {
+
+			var err error
+
+			step, err = strconv.Atoi(element[idx+1:])
+
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("Error: Invalid step. element='%v'", element)
+			}
+
+			rangePart = element[:idx]
+		}
+
+		var low, high int
+
+		switch {
+
+		case rangePart == "*" || rangePart == "?":
+
+			low, high = min, max
+
+		case strings.Contains(rangePart, "-"):
+
+			idx := strings.Index(rangePart, "-")
+
+			var err error
+
+			low, err = parseValue(rangePart[:idx])
+
+			if err != nil {
+				return 0, err
+			}
+
+			high, err = parseValue(rangePart[idx+1:])
+
+			if err != nil {
+				return 0, err
+			}
+
+			if high < low {
+				return 0, fmt.Errorf("Error: Invalid range. element='%v'", element)
+			}
+
+		default:
+
+			var err error
+
+			low, err = parseValue(rangePart)
+
+			if err != nil {
+				return 0, err
+			}
+
+			high = low
+
+			if step > 1 {
+				high = max
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
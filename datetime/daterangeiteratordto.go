@@ -0,0 +1,405 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+/*
+ DateRangeIteratorDto
+ ====================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\daterangeiteratordto.go
+
+ Overview and Usage
+ ==================
+ The 'DateRangeIteratorDto' Type yields a series of DateTzDto values
+ between a starting and an ending date time. Successive values are
+ separated by a step of 'N' time units where the time unit ranges from
+ nanoseconds through years.
+
+ Steps of Nanoseconds through Hours are exact durations. Steps of Days
+ and Weeks add calendar days to the local date while preserving the
+ local wall clock time. Steps of Months, Quarters, Half-Years and Years
+ add calendar months. If the day of the month does not exist in the
+ target month, the last day of that month is used.
+
+ Two step modes are supported:
+
+	DateRangeStepANCHORED - Every value is computed from the origin.
+	                        The 'k'th value is origin + k * step.
+
+	                        Jan 31 -> Feb 28 -> Mar 31 -> Apr 30
+
+	DateRangeStepCHAINED  - Every value is computed from the previous
+	                        value. Once a value is clamped to the end of
+	                        a month, subsequent values retain the clamped
+	                        day.
+
+	                        Jan 31 -> Feb 28 -> Mar 28 -> Apr 28
+
+ The origin is always the starting date time. The starting date time is
+ always returned. The ending date time is returned only if 'includeEnd'
+ is 'true' and the series lands on it exactly. A reversed iteration
+ returns the same values in reverse order:
+
+	Jan 31 -> Apr 15 monthly, anchored   Jan 31, Feb 28, Mar 31
+	Reversed                             Mar 31, Feb 28, Jan 31
+
+ A reversed iteration computes the index of the last value of the series
+ and steps down from it. The exception is DateRangeStepCHAINED with steps
+ of Days through Years. Those values depend on their predecessors, so the
+ complete series is computed when the first value is requested. All
+ values are expressed in the time zone of the starting date time and wall
+ clock arithmetic is performed in that time zone.
+
+ Example:
+
+	dtIter, err := DateRangeIteratorDto{}.New(startDtz, endDtz, 1,
+		TimeUnitMONTHS, DateRangeStepANCHORED, true, false)
+
+	for dtz, ok := dtIter.Next(); ok; dtz, ok = dtIter.Next() {
+		...
+	}
+
+*/
+
+// DateRangeStepModeType - Specifies whether the values generated by a
+// DateRangeIteratorDto are computed from the origin or from the
+// previous value.
+type DateRangeStepModeType int
+
+// String - Returns a string equivalent to the
+// integer value of DateRangeStepModeType
+func (stepMode DateRangeStepModeType) String() string {
+
+	if stepMode < 0 || int(stepMode) >= len(DateRangeStepModeTypeLabels) {
+		return ""
+	}
+
+	return DateRangeStepModeTypeLabels[stepMode]
+}
+
+// Date Range Step Modes
+const (
+
+	// DateRangeStepANCHORED - Each value is computed by adding k steps
+	// to the origin. Month end clamping does not accumulate.
+	DateRangeStepANCHORED DateRangeStepModeType = iota
+
+	// DateRangeStepCHAINED - Each value is computed by adding one step
+	// to the previous value.
+	DateRangeStepCHAINED
+)
+
+// DateRangeStepModeTypeLabels - Text Names associated with
+// DateRangeStepModeType types.
+var DateRangeStepModeTypeLabels = [...]string{"Anchored", "Chained"}
+
+// DateRangeIteratorDto - Lazily computes a series of date times between
+// two bounds. Create with DateRangeIteratorDto{}.New().
+type DateRangeIteratorDto struct {
+	startTime  time.Time
+	endTime    time.Time
+	step       int
+	unit       TimeUnitType
+	stepMode   DateRangeStepModeType
+	includeEnd bool
+	reverse    bool
+	series     []time.Time
+	lastIndex  int
+	index      int
+	last       time.Time
+	done       bool
+	loc        *time.Location
+	dtFmt      string
+	err        error
+}
+
+// Error - Returns the error which terminated the iteration, if any.
+func (drIter *DateRangeIteratorDto) Error() error {
+
+	return drIter.err
+}
+
+// GetAll - Returns all remaining values of the iteration.
+func (drIter *DateRangeIteratorDto) GetAll() ([]DateTzDto, error) {
+
+	result := make([]DateTzDto, 0)
+
+	for dtz, ok := drIter.Next(); ok; dtz, ok = drIter.Next() {
+		result = append(result, dtz)
+	}
+
+	if drIter.err != nil {
+		return nil, drIter.err
+	}
+
+	return result, nil
+}
+
+// New - Creates a new DateRangeIteratorDto.
+//
+// Input Parameters:
+// =================
+//
+// startDateTime DateTzDto  - The starting date time. Values are expressed
+//                            in the time zone of 'startDateTime'.
+//
+// endDateTime   DateTzDto  - The ending date time. Must not be earlier
+//                            than 'startDateTime'.
+//
+// step          int        - The number of time units separating
+//                            successive values. Must be greater than zero.
+//
+// unit          TimeUnitType - The time unit of 'step'. Example:
+//                            TimeUnitHOURS, TimeUnitDAYS, TimeUnitMONTHS
+//
+// stepMode      DateRangeStepModeType - DateRangeStepANCHORED or
+//                            DateRangeStepCHAINED
+//
+// includeEnd    bool       - If 'true', 'endDateTime' is returned when the
+//                            series lands on it.
+//
+// reverse       bool       - If 'true', the values of the series are
+//                            returned in reverse order, beginning with the
+//                            value nearest 'endDateTime' and ending with
+//                            'startDateTime'.
+//
+func (drIter DateRangeIteratorDto) New(
+	startDateTime,
+	endDateTime DateTzDto,
+	step int,
+	unit TimeUnitType,
+	stepMode DateRangeStepModeType,
+	includeEnd,
+	reverse bool) (*DateRangeIteratorDto, error) {
+
+	ePrefix := "DateRangeIteratorDto.New() "
+
+	if startDateTime.DateTime.IsZero() || endDateTime.DateTime.IsZero() {
+		return nil, errors.New(ePrefix + "Error: 'startDateTime' and 'endDateTime' must be initialized!")
+	}
+
+	if endDateTime.DateTime.Before(startDateTime.DateTime) {
+		return nil, fmt.Errorf(ePrefix +
+			"Error: 'endDateTime' is earlier than 'startDateTime'. startDateTime='%v' endDateTime='%v'",
+			startDateTime.String(), endDateTime.String())
+	}
+
+	if step < 1 {
+		return nil, fmt.Errorf(ePrefix + "Error: 'step' must be greater than zero. step='%v'", step)
+	}
+
+	if !unit.IsValid() {
+		return nil, fmt.Errorf(ePrefix + "Error: 'unit' is invalid. unit='%v'", int(unit))
+	}
+
+	if stepMode < DateRangeStepANCHORED || stepMode > DateRangeStepCHAINED {
+		return nil, fmt.Errorf(ePrefix + "Error: 'stepMode' is invalid. stepMode='%v'", int(stepMode))
+	}
+
+	loc := startDateTime.DateTime.Location()
+
+	return &DateRangeIteratorDto{
+		startTime:  startDateTime.DateTime,
+		endTime:    endDateTime.DateTime.In(loc),
+		step:       step,
+		unit:       unit,
+		stepMode:   stepMode,
+		includeEnd: includeEnd,
+		reverse:    reverse,
+		loc:        loc,
+		dtFmt:      startDateTime.DateTimeFmt,
+	}, nil
+}
+
+// Next - Returns the next value of the iteration. The boolean return
+// value is 'false' when there are no more values.
+func (drIter *DateRangeIteratorDto) Next() (DateTzDto, bool) {
+
+	if drIter.err != nil || drIter.done {
+		return DateTzDto{}, false
+	}
+
+	var candidate time.Time
+	var ok bool
+
+	if drIter.reverse && drIter.stepMode == DateRangeStepCHAINED && !drIter.isExactStep() {
+
+		if drIter.series == nil {
+			drIter.series = drIter.forwardSeries()
+		}
+
+		ok = drIter.index < len(drIter.series)
+
+		if ok {
+			candidate = drIter.series[len(drIter.series)-1-drIter.index]
+		}
+
+	} else if drIter.reverse {
+
+		if drIter.index == 0 {
+			drIter.lastIndex = drIter.getLastIndex()
+		}
+
+		ok = drIter.index <= drIter.lastIndex
+
+		if ok {
+			candidate = drIter.anchoredValue(drIter.lastIndex - drIter.index)
+		}
+
+	} else {
+		candidate, ok = drIter.forwardValue(drIter.index, drIter.last)
+	}
+
+	if !ok {
+		drIter.done = true
+		return DateTzDto{}, false
+	}
+
+	dtz, err := DateTzDto{}.New(candidate, drIter.dtFmt)
+
+	if err != nil {
+		drIter.err = err
+		return DateTzDto{}, false
+	}
+
+	drIter.index++
+	drIter.last = candidate
+
+	return dtz, true
+}
+
+// Reset - Restarts the iteration at the origin.
+func (drIter *DateRangeIteratorDto) Reset() {
+
+	drIter.index = 0
+	drIter.last = time.Time{}
+	drIter.done = false
+	drIter.err = nil
+}
+
+// advance - Adds 'count' time units to 't'. 'count' may be negative.
+func (drIter *DateRangeIteratorDto) advance(t time.Time, count int) time.Time {
+
+	months := drIter.unit.calendarMonths()
+
+	if months > 0 {
+		return addLocalMonthsClamped(t, count*months)
+	}
+
+	switch drIter.unit {
+	case TimeUnitDAYS:
+		return addLocalDays(t, count)
+	case TimeUnitWEEKS:
+		return addLocalDays(t, count*7)
+	}
+
+	nanosecs, _ := drIter.unit.nanoseconds()
+
+	return t.Add(time.Duration(int64(count) * nanosecs)).In(drIter.loc)
+}
+
+// anchoredValue - Returns the date time located 'index' steps after the
+// starting date time.
+func (drIter *DateRangeIteratorDto) anchoredValue(index int) time.Time {
+
+	if index == 0 {
+		return drIter.startTime
+	}
+
+	return drIter.advance(drIter.startTime, drIter.step*index)
+}
+
+// forwardSeries - Returns all values of the series in ascending order.
+func (drIter *DateRangeIteratorDto) forwardSeries() []time.Time {
+
+	series := make([]time.Time, 0)
+
+	var last time.Time
+
+	for index := 0; ; index++ {
+
+		candidate, ok := drIter.forwardValue(index, last)
+
+		if !ok {
+			break
+		}
+
+		series = append(series, candidate)
+		last = candidate
+	}
+
+	return series
+}
+
+// forwardValue - Returns the value at position 'index' of the series
+// computed from the starting date time. 'last' is the value at position
+// 'index' - 1. The boolean return value is 'false' if the value lies
+// beyond the ending date time.
+func (drIter *DateRangeIteratorDto) forwardValue(index int, last time.Time) (time.Time, bool) {
+
+	if index == 0 {
+		return drIter.startTime, true
+	}
+
+	var candidate time.Time
+
+	if drIter.stepMode == DateRangeStepCHAINED {
+		candidate = drIter.advance(last, drIter.step)
+	} else {
+		candidate = drIter.anchoredValue(index)
+	}
+
+	if drIter.isPastEnd(candidate) {
+		return time.Time{}, false
+	}
+
+	return candidate, true
+}
+
+// getLastIndex - Returns the index of the last value of the anchored
+// series. The index is estimated from the average length of the time unit
+// and then adjusted.
+func (drIter *DateRangeIteratorDto) getLastIndex() int {
+
+	unitNanosecs, _ := drIter.unit.nanoseconds()
+
+	index := int(int64(drIter.endTime.Sub(drIter.startTime)) / unitNanosecs / int64(drIter.step))
+
+	for index > 0 && drIter.isPastEnd(drIter.anchoredValue(index)) {
+		index--
+	}
+
+	for !drIter.isPastEnd(drIter.anchoredValue(index + 1)) {
+		index++
+	}
+
+	return index
+}
+
+// isExactStep - Returns 'true' if the step is an exact duration. Steps
+// of Nanoseconds through Hours are exact. Anchored and chained values are
+// identical for exact steps.
+func (drIter *DateRangeIteratorDto) isExactStep() bool {
+
+	switch drIter.unit {
+	case TimeUnitDAYS, TimeUnitWEEKS:
+		return false
+	}
+
+	return drIter.unit.calendarMonths() == 0
+}
+
+// isPastEnd - Returns 'true' if 't' lies beyond the ending date time. If
+// 'includeEnd' is 'false', the ending date time itself is past the end.
+func (drIter *DateRangeIteratorDto) isPastEnd(t time.Time) bool {
+
+	return t.After(drIter.endTime) || (!drIter.includeEnd && t.Equal(drIter.endTime))
+}
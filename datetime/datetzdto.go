@@ -67,9 +67,106 @@ type DateTzDto struct {
 	// 		associated with this date time.
 }
 
+// AddBusinessDays - Adds 'days' business days to the date time value of
+// the current DateTzDto and returns the result in a new DateTzDto instance.
+// Weekend days and holidays are defined by input parameter 'bCal'. The
+// local wall clock time of day is retained.
+//
+// Business days are counted on the local calendar in the time zone of the
+// current DateTzDto. Counting begins with the day following the current
+// date. Example: With a Saturday/Sunday weekend, adding 1 business day to
+// a Friday, Saturday or Sunday returns the following Monday.
+//
+// Input Parameters
+// ================
+//
+// days						int									- Number of business days to add. Negative values
+//																			subtract business days. If zero, the current
+//																			date time is returned unchanged.
+//
+// bCal						BusinessCalendarDto	- Defines weekend days and the optional holiday
+//																			calendar. See source file 'businesscalendardto.go'.
+//
+// dateTimeFmtStr string							- A date time format string which will be used
+//																			to format and display the returned DateTzDto.
+//																			If 'dateTimeFmtStr' is submitted as an 'empty
+//																			string', the current DateTzDto format string
+//																			is applied.
+//
+// Example Usage
+// =============
+//
+//	Five business days after Friday, 2018-06-29 (July 4th is a holiday):
+//
+//	bCal := BusinessCalendarDto{}.NewSatSunWeekend(usHolidays)
+//	dtz2, err := dtz.AddBusinessDays(5, bCal, "")
+//
+//	dtz2 = Monday, 2018-07-09
+//
+func (dtz *DateTzDto) AddBusinessDays(days int, bCal BusinessCalendarDto,
+	dateTimeFmtStr string) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddBusinessDays() "
+
+	err := dtz.IsValid()
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"The current DateTzDto is INVALID! dtz.DateTime='%v'", dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	newDt, err := bCal.addBusinessDays(dtz.DateTime, days)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	fmtStr := dateTimeFmtStr
+
+	if len(fmtStr) == 0 {
+		fmtStr = dtz.DateTimeFmt
+	}
+
+	dtz2, err := DateTzDto{}.New(newDt, fmtStr)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"Error returned by DateTzDto{}.New(newDt, fmtStr). newDt='%v'  Error='%v'",
+			newDt.Format(FmtDateTimeYrMDayFmtStr), err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// AddBusinessDaysToThis - Adds 'days' business days to the date time value
+// of the current DateTzDto. The updated DateTime is retained in the current
+// DateTzDto instance. Negative values subtract business days. Weekend days
+// and holidays are defined by input parameter 'bCal'.
+//
+// See method AddBusinessDays() for details.
+//
+func (dtz *DateTzDto) AddBusinessDaysToThis(days int, bCal BusinessCalendarDto) error {
+
+	ePrefix := "DateTzDto.AddBusinessDaysToThis() "
+
+	dtz2, err := dtz.AddBusinessDays(days, bCal, dtz.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	dtz.CopyIn(dtz2)
+
+	return nil
+}
+
 // AddDate - Adds input parameters 'years, 'months' and 'days' to date time value of the
 // current DateTzDto and returns the updated value in a new DateTzDto instance.
 //
+// Arithmetic Mode: Years and months are calendar units. Days are added as
+// elapsed 24-hour days (TimeMathABSOLUTE). To add days to the local wall
+// clock reading, use method AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -87,10 +184,8 @@ type DateTzDto struct {
 //															"2006-01-02 15:04:05.000000000 -0700 MST"
 //
 //														If 'dateTimeFmtStr' is submitted as an
-//															'empty string', a default date time format
-//															string will be applied. The default date time
-//															format string is:
-//															FmtDateTimeYrMDayFmtStr = "2006-01-02 15:04:05.000000000 -0700 MST"
+//															'empty string', the date time format string
+//															of the current DateTzDto will be applied.
 //
 // Returns
 // =======
@@ -125,7 +220,43 @@ type DateTzDto struct {
 //
 func (dtz *DateTzDto) AddDate(years, months, days int, dateTimeFormatStr string) (DateTzDto, error) {
 
-	ePrefix := "DateTzDto.AddDate() "
+	return dtz.AddDateOverflow(years, months, days, MonthEndOverflowNORMALIZE, dateTimeFormatStr)
+}
+
+// AddDateOverflow - Adds input parameters 'years', 'months' and 'days' to
+// the date time value of the current DateTzDto and returns the result as
+// a new DateTzDto instance. Month end overflow is resolved according to
+// input parameter 'overflow'. The current DateTzDto is not altered.
+//
+// Arithmetic Mode: TimeMathABSOLUTE. See method AddDateTimeMode() for
+// wall clock arithmetic.
+//
+// Years and months are added first and the month end overflow policy is
+// applied. Days are then added as 24-hour days.
+//
+// Input Parameters
+// ================
+//
+// years              int - Number of years to add to the current date.
+// months             int - Number of months to add to the current date.
+// days               int - Number of days to add to the current date.
+//
+// overflow MonthEndOverflowType - Specifies the treatment of a day of the
+//                          month which does not exist in the target month.
+//                          Example: 2019-01-31 plus 1 month yields:
+//                            MonthEndOverflowNORMALIZE  2019-03-03
+//                            MonthEndOverflowCLAMP      2019-02-28
+//                            MonthEndOverflowROLLNEXT   2019-03-01
+//
+// dateTimeFormatStr string - A date time format string used to format the
+//                          returned DateTzDto. If submitted as an empty
+//                          string, the date time format of the current
+//                          DateTzDto is applied.
+//
+func (dtz *DateTzDto) AddDateOverflow(years, months, days int, overflow MonthEndOverflowType,
+	dateTimeFormatStr string) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddDateOverflow() "
 
 	err := dtz.IsValid()
 
@@ -133,25 +264,62 @@ func (dtz *DateTzDto) AddDate(years, months, days int, dateTimeFormatStr string)
 		return DateTzDto{}, fmt.Errorf(ePrefix + "The current DateTzDto is INVALID! dtz.DateTime='%v'", dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
 	}
 
-	newDt1 := dtz.DateTime.AddDate(years, months, 0)
+	err = validateMonthEndOverflow(overflow)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	newDt1 := addYearsMonthsOverflow(dtz.DateTime, years, months, overflow)
 
 	dur := DayNanoSeconds * int64(days)
 	newDt2 := newDt1.Add(time.Duration(dur))
 
-	dtz2, err := DateTzDto{}.New(newDt2, dtz.DateTimeFmt)
+	fmtStr := dateTimeFormatStr
 
-	if err != nil {
-		return DateTzDto{}, fmt.Errorf(ePrefix + "Error returned by DateTzDto{}.New(newDt2, dtz.DateTimeFmt). newDt='%v'  Error='%v'", newDt2.Format(FmtDateTimeYrMDayFmtStr), err.Error())
+	if len(fmtStr) == 0 {
+		fmtStr = dtz.DateTimeFmt
 	}
 
+	dtz2, err := DateTzDto{}.New(newDt2, fmtStr)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "Error returned by DateTzDto{}.New(newDt2, fmtStr). newDt='%v'  Error='%v'", newDt2.Format(FmtDateTimeYrMDayFmtStr), err.Error())
+	}
 
 	return dtz2, nil
 }
 
+// AddDateOverflowToThis - Adds input parameters 'years', 'months' and 'days'
+// to the date time value of the current DateTzDto. Month end overflow is
+// resolved according to input parameter 'overflow'. The updated date time
+// is retained in the current DateTzDto instance.
+//
+// See method AddDateOverflow() for details.
+//
+func (dtz *DateTzDto) AddDateOverflowToThis(years, months, days int, overflow MonthEndOverflowType) error {
+
+	ePrefix := "DateTzDto.AddDateOverflowToThis() "
+
+	dtz2, err := dtz.AddDateOverflow(years, months, days, overflow, dtz.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	dtz.CopyIn(dtz2)
+
+	return nil
+}
+
 // AddDateToThis - Adds input parameters 'years, 'months' and 'days' to date time value
 // of the current DateTzDto. The updated DateTime is retained in the current
 // DateTzDto instance.
 //
+// Arithmetic Mode: Years and months are calendar units. Days are added as
+// elapsed 24-hour days (TimeMathABSOLUTE). To add days to the local wall
+// clock reading, use method AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -173,34 +341,17 @@ func (dtz *DateTzDto) AddDate(years, months, days int, dateTimeFormatStr string)
 //
 func (dtz *DateTzDto) AddDateToThis(years, months, days int) error {
 
-	ePrefix := "DateTzDto.AddDate() "
-
-	err := dtz.IsValid()
-
-	if err != nil {
-		return fmt.Errorf(ePrefix + "The current DateTzDto is INVALID! dtz.DateTime='%v'", dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
-	}
-
-	newDt1 := dtz.DateTime.AddDate(years, months, 0)
-	dur := int64(days) * DayNanoSeconds
-	newDt2 := newDt1.Add(time.Duration(dur))
-
-	dtz2, err := DateTzDto{}.New(newDt2, dtz.DateTimeFmt)
-
-	if err != nil {
-		return fmt.Errorf(ePrefix + "Error returned by DateTzDto{}.New(newDt2, dtz.DateTimeFmt). newDt='%v'  Error='%v'", newDt2.Format(FmtDateTimeYrMDayFmtStr), err.Error())
-	}
-
-	dtz.CopyIn(dtz2)
-
-	return nil
-
+	return dtz.AddDateOverflowToThis(years, months, days, MonthEndOverflowNORMALIZE)
 }
 
 // AddDateTime - Adds date time components to the date time value of the
 // current DateTzDto instance. The updated date time value is returned to
 // the calling function as a new DateTzDto instance.
 //
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// components are added as elapsed time (TimeMathABSOLUTE). For wall clock
+// arithmetic, use method AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -264,19 +415,69 @@ func (dtz *DateTzDto) AddDateTime(years, months, days, hours, minutes, seconds,
 milliseconds, microseconds, nanoseconds int,
 	dateTimeFormatStr string) (DateTzDto, error ) {
 
-	ePrefix := "DateTzDto.AddDateTime() "
+	return dtz.AddDateTimeOverflow(years, months, days, hours, minutes, seconds,
+		milliseconds, microseconds, nanoseconds, MonthEndOverflowNORMALIZE, dateTimeFormatStr)
+}
+
+// AddDateTimeMode - Adds date time components to the date time value of
+// the current DateTzDto instance and returns the result as a new DateTzDto
+// instance. Days and time components are added according to arithmetic
+// mode 'mode' and month end overflow is resolved according to 'overflow'.
+// The current DateTzDto is not altered.
+//
+// Years and months are added first and the month end overflow policy is
+// applied. Days and the remaining time components are then added either as
+// elapsed time (TimeMathABSOLUTE) or to the local wall clock reading
+// (TimeMathWALLCLOCK). Example, America/Chicago, spring forward 2026-03-08:
+//
+//	2026-03-07 09:00 CST + 24 hours
+//
+//	TimeMathABSOLUTE   2026-03-08 10:00 CDT
+//	TimeMathWALLCLOCK  2026-03-08 09:00 CDT
+//
+// Input Parameters
+// ================
+//
+// years, months, days, hours, minutes, seconds, milliseconds,
+// microseconds, nanoseconds int - The date time components to add. Values
+//                          may be positive or negative.
+//
+// mode TimeMathModeType  - TimeMathABSOLUTE or TimeMathWALLCLOCK.
+//
+// overflow MonthEndOverflowType - Specifies the treatment of a day of the
+//                          month which does not exist in the target month.
+//                          See method AddDateOverflow().
+//
+// dateTimeFormatStr string - A date time format string used to format the
+//                          returned DateTzDto. If submitted as an empty
+//                          string, the default format is applied.
+//
+func (dtz *DateTzDto) AddDateTimeMode(years, months, days, hours, minutes, seconds,
+	milliseconds, microseconds, nanoseconds int, mode TimeMathModeType,
+	overflow MonthEndOverflowType, dateTimeFormatStr string) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddDateTimeMode() "
 
-	newDate := dtz.DateTime.AddDate(years, months, 0)
+	err := validateTimeMathMode(mode)
 
-	totNanoSecs := int64(days) * DayNanoSeconds
-	totNanoSecs += int64(hours) * int64(time.Hour)
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	err = validateMonthEndOverflow(overflow)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	totNanoSecs := int64(hours) * int64(time.Hour)
 	totNanoSecs += int64(minutes) * int64(time.Minute)
 	totNanoSecs += int64(seconds) * int64(time.Second)
 	totNanoSecs += int64(milliseconds) * int64(time.Millisecond)
 	totNanoSecs += int64(microseconds) * int64(time.Microsecond)
 	totNanoSecs += int64(nanoseconds)
 
-	newDateTime := newDate.Add(time.Duration(totNanoSecs))
+	newDateTime := addTimeMathMode(dtz.DateTime, years, months, days, totNanoSecs, mode, overflow)
 
 	dtz2, err := DateTzDto{}.New(newDateTime, dateTimeFormatStr)
 
@@ -289,9 +490,97 @@ milliseconds, microseconds, nanoseconds int,
 	return dtz2, nil
 }
 
+// AddDateTimeModeToThis - Adds date time components to the date time value
+// of the current DateTzDto instance using arithmetic mode 'mode' and month
+// end overflow policy 'overflow'. The updated date time is retained in the
+// current DateTzDto instance.
+//
+// See method AddDateTimeMode() for details.
+//
+func (dtz *DateTzDto) AddDateTimeModeToThis(years, months, days, hours, minutes, seconds,
+	milliseconds, microseconds, nanoseconds int, mode TimeMathModeType,
+	overflow MonthEndOverflowType) error {
+
+	ePrefix := "DateTzDto.AddDateTimeModeToThis() "
+
+	dtz2, err := dtz.AddDateTimeMode(years, months, days, hours, minutes, seconds,
+		milliseconds, microseconds, nanoseconds, mode, overflow, dtz.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	dtz.CopyIn(dtz2)
+
+	return nil
+}
+
+// AddDateTimeOverflow - Adds date time components to the date time value
+// of the current DateTzDto instance and returns the result as a new
+// DateTzDto instance. Month end overflow is resolved according to input
+// parameter 'overflow'. The current DateTzDto is not altered.
+//
+// Arithmetic Mode: TimeMathABSOLUTE. See method AddDateTimeMode() for
+// wall clock arithmetic.
+//
+// Years and months are added first and the month end overflow policy is
+// applied. Days are added as 24-hour days together with the remaining
+// time components.
+//
+// Input Parameters
+// ================
+//
+// years, months, days, hours, minutes, seconds, milliseconds,
+// microseconds, nanoseconds int - The date time components to add. Values
+//                          may be positive or negative.
+//
+// overflow MonthEndOverflowType - Specifies the treatment of a day of the
+//                          month which does not exist in the target month.
+//                          See method AddDateOverflow().
+//
+// dateTimeFormatStr string - A date time format string used to format the
+//                          returned DateTzDto. If submitted as an empty
+//                          string, the default format is applied.
+//
+func (dtz *DateTzDto) AddDateTimeOverflow(years, months, days, hours, minutes, seconds,
+	milliseconds, microseconds, nanoseconds int, overflow MonthEndOverflowType,
+	dateTimeFormatStr string) (DateTzDto, error) {
+
+	return dtz.AddDateTimeMode(years, months, days, hours, minutes, seconds,
+		milliseconds, microseconds, nanoseconds, TimeMathABSOLUTE, overflow, dateTimeFormatStr)
+}
+
+// AddDateTimeOverflowToThis - Adds date time components to the date time
+// value of the current DateTzDto instance. Month end overflow is resolved
+// according to input parameter 'overflow'. The updated date time is
+// retained in the current DateTzDto instance.
+//
+// See method AddDateTimeOverflow() for details.
+//
+func (dtz *DateTzDto) AddDateTimeOverflowToThis(years, months, days, hours, minutes, seconds,
+	milliseconds, microseconds, nanoseconds int, overflow MonthEndOverflowType) error {
+
+	ePrefix := "DateTzDto.AddDateTimeOverflowToThis() "
+
+	dtz2, err := dtz.AddDateTimeOverflow(years, months, days, hours, minutes, seconds,
+		milliseconds, microseconds, nanoseconds, overflow, dtz.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	dtz.CopyIn(dtz2)
+
+	return nil
+}
+
 // AddDateTimeToThis - Adds date time components to the date time value of the current
 // DateTzDto instance.
 //
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// components are added as elapsed time (TimeMathABSOLUTE). For wall clock
+// arithmetic, use method AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -337,6 +626,10 @@ func (dtz *DateTzDto) AddDateTimeToThis(years, months, days, hours, minutes, sec
 // DateTzDto and returns a new DateTzDto instance with the updated
 // Date Time value.
 //
+// Arithmetic Mode: 'duration' is added as elapsed time (TimeMathABSOLUTE).
+// For wall clock arithmetic, use method AddDurationMode() with
+// TimeMathWALLCLOCK.
+//
 // Input Parameter
 // ===============
 //
@@ -406,10 +699,82 @@ func (dtz *DateTzDto) AddDuration(duration time.Duration, dateTimeFmtStr string)
 
 }
 
+// AddDurationMode - Adds 'duration' to the date time value of the current
+// DateTzDto according to arithmetic mode 'mode' and returns the result as
+// a new DateTzDto instance. The current DateTzDto is not altered.
+//
+// With TimeMathABSOLUTE, 'duration' is elapsed time and the result is
+// identical to that of method AddDuration(). With TimeMathWALLCLOCK,
+// 'duration' is added to the local wall clock reading. Example,
+// America/Chicago, spring forward 2026-03-08:
+//
+//	2026-03-07 09:00 CST + 24h
+//
+//	TimeMathABSOLUTE   2026-03-08 10:00 CDT
+//	TimeMathWALLCLOCK  2026-03-08 09:00 CDT
+//
+// Input Parameters
+// ================
+//
+// duration time.Duration  - May be positive or negative.
+//
+// mode TimeMathModeType   - TimeMathABSOLUTE or TimeMathWALLCLOCK.
+//
+// dateTimeFmtStr string   - A date time format string used to format the
+//                           returned DateTzDto. If submitted as an empty
+//                           string, the default format is applied.
+//
+func (dtz *DateTzDto) AddDurationMode(duration time.Duration, mode TimeMathModeType,
+	dateTimeFmtStr string) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddDurationMode() "
+
+	err := validateTimeMathMode(mode)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	newDateTime := addTimeMathMode(dtz.DateTime, 0, 0, 0, int64(duration), mode, MonthEndOverflowNORMALIZE)
+
+	dtz2, err := DateTzDto{}.New(newDateTime, dateTimeFmtStr)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "Error returned by DateTzDto{}.New(newDateTime, dateTimeFmtStr). newDateTime='%v'  Error='%v'", newDateTime.Format(FmtDateTimeYrMDayFmtStr), err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// AddDurationModeToThis - Adds 'duration' to the date time value of the
+// current DateTzDto according to arithmetic mode 'mode'. The updated date
+// time is retained in the current DateTzDto instance.
+//
+// See method AddDurationMode() for details.
+//
+func (dtz *DateTzDto) AddDurationModeToThis(duration time.Duration, mode TimeMathModeType) error {
+
+	ePrefix := "DateTzDto.AddDurationModeToThis() "
+
+	dtz2, err := dtz.AddDurationMode(duration, mode, dtz.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	dtz.CopyIn(dtz2)
+
+	return nil
+}
+
 // AddDurationToThis - Receives a time.Duration input parameter and adds this
 // duration value to the Date Time value of the current DateTzDto. The current
 // DateTzDto Date Time values are updated to reflect the added 'duration'.
 //
+// Arithmetic Mode: 'duration' is added as elapsed time (TimeMathABSOLUTE).
+// For wall clock arithmetic, use method AddDurationMode() with
+// TimeMathWALLCLOCK.
+//
 // Input Parameter
 // ===============
 //
@@ -449,6 +814,10 @@ func (dtz *DateTzDto) AddDurationToThis(duration time.Duration) error {
 // AddMinusTimeDto - Creates and returns a new DateTzDto by subtracting a TimeDto
 // from the value of the current DateTzDto Instance.
 //
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// components are subtracted as elapsed time (TimeMathABSOLUTE). For wall
+// clock arithmetic, use method AddMinusTimeDtoMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -491,37 +860,59 @@ func (dtz *DateTzDto) AddMinusTimeDto(minusTimeDto TimeDto) (DateTzDto, error) {
 	return dtz2, nil
 }
 
-// AddMinusTimeDtoToThis - Modifies the current DateTzDto instance by subtracting a TimeDto
-// from the value of the current DateTzDto Instance.
+// AddMinusTimeDtoMode - Subtracts the time components of input parameter
+// 'minusTimeDto' from the date time value of the current DateTzDto and
+// returns the result as a new DateTzDto instance. Days and time components
+// are subtracted according to arithmetic mode 'mode' and month end overflow
+// is resolved according to 'overflow'. The current DateTzDto is not altered.
 //
-// Input Parameters
-// ================
+// Example, America/Chicago, fall back 2026-11-01:
 //
-// minusTimeDto	TimeDto 	- A TimeDto instance consisting of time components
-//													(years, months, weeks, days, hours, minutes etc.)
-//													which will be subtracted from the date time value
-//													of the current DateTzDto instance.
+//	2026-11-01 09:00 CST minus 1-Day
 //
+//	TimeMathABSOLUTE   2026-10-31 10:00 CDT
+//	TimeMathWALLCLOCK  2026-10-31 09:00 CDT
 //
-//									type TimeDto struct {
-//										Years          int // Number of Years
-//										Months         int // Number of Months
-//										Weeks          int // Number of Weeks
-//										WeekDays       int // Number of Week-WeekDays. Total WeekDays/7 + Remainder WeekDays
-//										DateDays       int // Total Number of Days. Weeks x 7 plus WeekDays
-//										Hours          int // Number of Hours.
-//										Minutes        int // Number of Minutes
-//										Seconds        int // Number of Seconds
-//										Milliseconds   int // Number of Milliseconds
-//										Microseconds   int // Number of Microseconds
-//										Nanoseconds    int // Remaining Nanoseconds after Milliseconds & Microseconds
-//										TotSubSecNanoseconds int // Total Nanoseconds. Millisecond NanoSecs + Microsecond NanoSecs
-//																			 // 	plus remaining Nanoseconds
-//									}
+func (dtz *DateTzDto) AddMinusTimeDtoMode(minusTimeDto TimeDto, mode TimeMathModeType,
+	overflow MonthEndOverflowType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddMinusTimeDtoMode() "
+
+	dtz2 := dtz.CopyOut()
+
+	err := dtz2.AddMinusTimeDtoModeToThis(minusTimeDto, mode, overflow)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// AddMinusTimeDtoModeToThis - Subtracts the time components of input
+// parameter 'minusTimeDto' from the date time value of the current
+// DateTzDto using arithmetic mode 'mode' and month end overflow policy
+// 'overflow'. The updated date time is retained in the current DateTzDto
+// instance.
 //
-func (dtz *DateTzDto) AddMinusTimeDtoToThis(minusTimeDto TimeDto) error {
+// See method AddMinusTimeDtoMode() for details.
+//
+func (dtz *DateTzDto) AddMinusTimeDtoModeToThis(minusTimeDto TimeDto, mode TimeMathModeType,
+	overflow MonthEndOverflowType) error {
 
-	ePrefix := "DateTzDto.AddMinusTimeDto() "
+	ePrefix := "DateTzDto.AddMinusTimeDtoModeToThis() "
+
+	err := validateTimeMathMode(mode)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	err = validateMonthEndOverflow(overflow)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
 
 	tDto := minusTimeDto.CopyOut()
 
@@ -530,25 +921,19 @@ func (dtz *DateTzDto) AddMinusTimeDtoToThis(minusTimeDto TimeDto) error {
 
 	tDto.ConvertToNegativeValues()
 
-	dt1 := dtz.DateTime.AddDate(tDto.Years,
-		tDto.Months,
-		0)
-
-	totNanosecs := int64(tDto.DateDays) * DayNanoSeconds
-	totNanosecs += int64(tDto.Hours) * HourNanoSeconds
+	totNanosecs := int64(tDto.Hours) * HourNanoSeconds
 	totNanosecs += int64(tDto.Minutes) * MinuteNanoSeconds
 	totNanosecs += int64(tDto.Seconds) * SecondNanoseconds
 	totNanosecs += int64(tDto.Milliseconds) * MilliSecondNanoseconds
 	totNanosecs += int64(tDto.Microseconds) * MicroSecondNanoseconds
 	totNanosecs += int64(tDto.Nanoseconds)
 
-	dt2 := dt1.Add(time.Duration(totNanosecs))
-
+	dt2 := addTimeMathMode(dtz.DateTime, tDto.Years, tDto.Months, tDto.DateDays, totNanosecs, mode, overflow)
 
 	dtz2, err := DateTzDto{}.NewTz(dt2, dtz.TimeZone.LocationName, dtz.DateTimeFmt)
 
 	if err != nil {
-		return fmt.Errorf(ePrefix + "Error returned from DateTzDto{}.New(dt2, dtz.DateTimeFmt). " +
+		return fmt.Errorf(ePrefix + "Error returned from DateTzDto{}.NewTz(dt2, dtz.TimeZone.LocationName, dtz.DateTimeFmt). " +
 			" Error='%v'", err.Error())
 	}
 
@@ -557,16 +942,63 @@ func (dtz *DateTzDto) AddMinusTimeDtoToThis(minusTimeDto TimeDto) error {
 	return nil
 }
 
-// AddPlusTimeDto - Creates and returns a new DateTzDto by adding a TimeDto
-// to the value of the current DateTzDto Instance.
+// AddMinusTimeDtoOverflow - Subtracts the time components of input
+// parameter 'minusTimeDto' from the date time value of the current
+// DateTzDto and returns the result as a new DateTzDto instance. Month
+// end overflow is resolved according to input parameter 'overflow'.
+// The current DateTzDto is not altered.
+//
+// Arithmetic Mode: TimeMathABSOLUTE. See method AddMinusTimeDtoMode()
+// for wall clock arithmetic.
+//
+// Years and months are subtracted first and the month end overflow policy
+// is applied. The remaining time components are then subtracted. Example:
+// 2019-03-31 minus 1 month with MonthEndOverflowCLAMP yields 2019-02-28.
+//
+func (dtz *DateTzDto) AddMinusTimeDtoOverflow(minusTimeDto TimeDto,
+	overflow MonthEndOverflowType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddMinusTimeDtoOverflow() "
+
+	dtz2 := dtz.CopyOut()
+
+	err := dtz2.AddMinusTimeDtoOverflowToThis(minusTimeDto, overflow)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// AddMinusTimeDtoOverflowToThis - Subtracts the time components of input
+// parameter 'minusTimeDto' from the date time value of the current
+// DateTzDto. Month end overflow is resolved according to input parameter
+// 'overflow'. The updated date time is retained in the current DateTzDto
+// instance.
+//
+// See method AddMinusTimeDtoOverflow() for details.
+//
+func (dtz *DateTzDto) AddMinusTimeDtoOverflowToThis(minusTimeDto TimeDto,
+	overflow MonthEndOverflowType) error {
+
+	return dtz.AddMinusTimeDtoModeToThis(minusTimeDto, TimeMathABSOLUTE, overflow)
+}
+
+// AddMinusTimeDtoToThis - Modifies the current DateTzDto instance by subtracting a TimeDto
+// from the value of the current DateTzDto Instance.
+//
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// components are subtracted as elapsed time (TimeMathABSOLUTE). For wall
+// clock arithmetic, use method AddMinusTimeDtoMode() with TimeMathWALLCLOCK.
 //
 // Input Parameters
 // ================
 //
-// plusTimeDto	TimeDto 	- A TimeDto instance consisting of time components
+// minusTimeDto	TimeDto 	- A TimeDto instance consisting of time components
 //													(years, months, weeks, days, hours, minutes etc.)
-//													which will be added to the date time value of the
-//													current DateTzDto instance.
+//													which will be subtracted from the date time value
+//													of the current DateTzDto instance.
 //
 //
 //									type TimeDto struct {
@@ -585,26 +1017,18 @@ func (dtz *DateTzDto) AddMinusTimeDtoToThis(minusTimeDto TimeDto) error {
 //																			 // 	plus remaining Nanoseconds
 //									}
 //
-func (dtz *DateTzDto) AddPlusTimeDto(plusTimeDto TimeDto) (DateTzDto, error) {
-
-	ePrefix := "DateTzDto.AddPlusTimeDto() "
-
-	dtz2 := dtz.CopyOut()
-
-	err := dtz2.AddPlusTimeDtoToThis(plusTimeDto)
-
-	if err != nil {
-		return DateTzDto{},
-			fmt.Errorf(ePrefix + "Error returned from dtz2.AddPlusTimeDtoToThis(plusTimeDto). " +
-				" Error='%v'", err.Error())
-	}
+func (dtz *DateTzDto) AddMinusTimeDtoToThis(minusTimeDto TimeDto) error {
 
-	return dtz2, nil
+	return dtz.AddMinusTimeDtoOverflowToThis(minusTimeDto, MonthEndOverflowNORMALIZE)
 }
 
-// AddPlusTimeDtoToThis - Modifies the current DateTzDto instance by adding a TimeDto
+// AddPlusTimeDto - Creates and returns a new DateTzDto by adding a TimeDto
 // to the value of the current DateTzDto Instance.
 //
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// components are added as elapsed time (TimeMathABSOLUTE). For wall clock
+// arithmetic, use method AddPlusTimeDtoMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -630,9 +1054,76 @@ func (dtz *DateTzDto) AddPlusTimeDto(plusTimeDto TimeDto) (DateTzDto, error) {
 //																			 // 	plus remaining Nanoseconds
 //									}
 //
-func (dtz *DateTzDto) AddPlusTimeDtoToThis(plusTimeDto TimeDto) error {
+func (dtz *DateTzDto) AddPlusTimeDto(plusTimeDto TimeDto) (DateTzDto, error) {
 
 	ePrefix := "DateTzDto.AddPlusTimeDto() "
+
+	dtz2 := dtz.CopyOut()
+
+	err := dtz2.AddPlusTimeDtoToThis(plusTimeDto)
+
+	if err != nil {
+		return DateTzDto{},
+			fmt.Errorf(ePrefix + "Error returned from dtz2.AddPlusTimeDtoToThis(plusTimeDto). " +
+				" Error='%v'", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// AddPlusTimeDtoMode - Adds the time components of input parameter
+// 'plusTimeDto' to the date time value of the current DateTzDto and returns
+// the result as a new DateTzDto instance. Days and time components are
+// added according to arithmetic mode 'mode' and month end overflow is
+// resolved according to 'overflow'. The current DateTzDto is not altered.
+//
+// Example, America/Chicago, spring forward 2026-03-08:
+//
+//	2026-03-07 09:00 CST plus 1-Day
+//
+//	TimeMathABSOLUTE   2026-03-08 10:00 CDT
+//	TimeMathWALLCLOCK  2026-03-08 09:00 CDT
+//
+func (dtz *DateTzDto) AddPlusTimeDtoMode(plusTimeDto TimeDto, mode TimeMathModeType,
+	overflow MonthEndOverflowType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddPlusTimeDtoMode() "
+
+	dtz2 := dtz.CopyOut()
+
+	err := dtz2.AddPlusTimeDtoModeToThis(plusTimeDto, mode, overflow)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// AddPlusTimeDtoModeToThis - Adds the time components of input parameter
+// 'plusTimeDto' to the date time value of the current DateTzDto using
+// arithmetic mode 'mode' and month end overflow policy 'overflow'. The
+// updated date time is retained in the current DateTzDto instance.
+//
+// See method AddPlusTimeDtoMode() for details.
+//
+func (dtz *DateTzDto) AddPlusTimeDtoModeToThis(plusTimeDto TimeDto, mode TimeMathModeType,
+	overflow MonthEndOverflowType) error {
+
+	ePrefix := "DateTzDto.AddPlusTimeDtoModeToThis() "
+
+	err := validateTimeMathMode(mode)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	err = validateMonthEndOverflow(overflow)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
 	tDto := plusTimeDto.CopyOut()
 
 	tDto.NormalizeTimeElements()
@@ -640,25 +1131,20 @@ func (dtz *DateTzDto) AddPlusTimeDtoToThis(plusTimeDto TimeDto) error {
 
 	tDto.ConvertToAbsoluteValues()
 
-	dt1 := dtz.DateTime.AddDate(tDto.Years,
-		tDto.Months,
-		0)
-
-	incrementalDur := int64(tDto.DateDays) * DayNanoSeconds
-	incrementalDur += int64(tDto.Hours) * HourNanoSeconds
+	incrementalDur := int64(tDto.Hours) * HourNanoSeconds
 	incrementalDur += int64(tDto.Minutes) * MinuteNanoSeconds
 	incrementalDur += int64(tDto.Seconds) * SecondNanoseconds
 	incrementalDur += int64(tDto.Milliseconds) * MilliSecondNanoseconds
 	incrementalDur += int64(tDto.Microseconds) * MicroSecondNanoseconds
 	incrementalDur += int64(tDto.Nanoseconds)
 
-	dt2 := dt1.Add(time.Duration(incrementalDur))
+	dt2 := addTimeMathMode(dtz.DateTime, tDto.Years, tDto.Months, tDto.DateDays, incrementalDur, mode, overflow)
 
 	dtz2, err := DateTzDto{}.New(dt2, dtz.DateTimeFmt)
 
 	if err != nil {
-		fmt.Errorf(ePrefix + "Error returned from DateTzDto{}.New(dt2, dtz.DateTimeFmt). " +
-				" Error='%v'", err.Error())
+		return fmt.Errorf(ePrefix + "Error returned from DateTzDto{}.New(dt2, dtz.DateTimeFmt). " +
+			" Error='%v'", err.Error())
 	}
 
 	dtz.CopyIn(dtz2)
@@ -666,10 +1152,93 @@ func (dtz *DateTzDto) AddPlusTimeDtoToThis(plusTimeDto TimeDto) error {
 	return nil
 }
 
+// AddPlusTimeDtoOverflow - Adds the time components of input parameter
+// 'plusTimeDto' to the date time value of the current DateTzDto and returns
+// the result as a new DateTzDto instance. Month end overflow is resolved
+// according to input parameter 'overflow'. The current DateTzDto is not
+// altered.
+//
+// Arithmetic Mode: TimeMathABSOLUTE. See method AddPlusTimeDtoMode()
+// for wall clock arithmetic.
+//
+// Years and months are added first and the month end overflow policy is
+// applied. The remaining time components are then added. Example:
+// 2019-01-31 plus 1 month with MonthEndOverflowCLAMP yields 2019-02-28.
+//
+func (dtz *DateTzDto) AddPlusTimeDtoOverflow(plusTimeDto TimeDto,
+	overflow MonthEndOverflowType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddPlusTimeDtoOverflow() "
+
+	dtz2 := dtz.CopyOut()
+
+	err := dtz2.AddPlusTimeDtoOverflowToThis(plusTimeDto, overflow)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// AddPlusTimeDtoOverflowToThis - Adds the time components of input parameter
+// 'plusTimeDto' to the date time value of the current DateTzDto. Month end
+// overflow is resolved according to input parameter 'overflow'. The updated
+// date time is retained in the current DateTzDto instance.
+//
+// See method AddPlusTimeDtoOverflow() for details.
+//
+func (dtz *DateTzDto) AddPlusTimeDtoOverflowToThis(plusTimeDto TimeDto,
+	overflow MonthEndOverflowType) error {
+
+	return dtz.AddPlusTimeDtoModeToThis(plusTimeDto, TimeMathABSOLUTE, overflow)
+}
+
+// AddPlusTimeDtoToThis - Modifies the current DateTzDto instance by adding a TimeDto
+// to the value of the current DateTzDto Instance.
+//
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// components are added as elapsed time (TimeMathABSOLUTE). For wall clock
+// arithmetic, use method AddPlusTimeDtoMode() with TimeMathWALLCLOCK.
+//
+// Input Parameters
+// ================
+//
+// plusTimeDto	TimeDto 	- A TimeDto instance consisting of time components
+//													(years, months, weeks, days, hours, minutes etc.)
+//													which will be added to the date time value of the
+//													current DateTzDto instance.
+//
+//
+//									type TimeDto struct {
+//										Years          int // Number of Years
+//										Months         int // Number of Months
+//										Weeks          int // Number of Weeks
+//										WeekDays       int // Number of Week-WeekDays. Total WeekDays/7 + Remainder WeekDays
+//										DateDays       int // Total Number of Days. Weeks x 7 plus WeekDays
+//										Hours          int // Number of Hours.
+//										Minutes        int // Number of Minutes
+//										Seconds        int // Number of Seconds
+//										Milliseconds   int // Number of Milliseconds
+//										Microseconds   int // Number of Microseconds
+//										Nanoseconds    int // Remaining Nanoseconds after Milliseconds & Microseconds
+//										TotSubSecNanoseconds int // Total Nanoseconds. Millisecond NanoSecs + Microsecond NanoSecs
+//																			 // 	plus remaining Nanoseconds
+//									}
+//
+func (dtz *DateTzDto) AddPlusTimeDtoToThis(plusTimeDto TimeDto) error {
+
+	return dtz.AddPlusTimeDtoOverflowToThis(plusTimeDto, MonthEndOverflowNORMALIZE)
+}
+
 // AddTime - Adds time components to the date time value of the current
 // DateTzDto instance. The resulting updated date time value is returned
 // to the calling function in the form of a new DateTzDto instance.
 //
+// Arithmetic Mode: Time components are added as elapsed time
+// (TimeMathABSOLUTE). For wall clock arithmetic, use method
+// AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -756,6 +1325,10 @@ nanoseconds int, dateTimeFormatStr string ) (DateTzDto, error) {
 // AddTimeToThis - Adds time components (hours, minutes, seconds etc.)
 // to the current value of this DateTzDto instance.
 //
+// Arithmetic Mode: Time components are added as elapsed time
+// (TimeMathABSOLUTE). For wall clock arithmetic, use method
+// AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -797,6 +1370,96 @@ microseconds,	nanoseconds int ) error {
 	return nil
 }
 
+// AddWorkingDuration - Adds working time to the current DateTzDto and
+// returns the result as a new DateTzDto. Only time falling within the
+// scheduled work periods of 'schedule' is counted. Negative values
+// subtract working time. The current DateTzDto is not altered.
+//
+// If the working time is exhausted exactly at the end of a work period,
+// the end of that work period is returned.
+//
+// Input Parameters
+// ================
+//
+// workingTime		time.Duration		- The quantity of working time to add. Negative
+//																	values subtract working time. If zero, the
+//																	current date time is returned unchanged.
+//
+// schedule				WorkScheduleDto	- The weekly work schedule and optional holiday
+//																	calendar. See source file 'workscheduledto.go'.
+//
+// dateTimeFmtStr string					- A date time format string which will be used
+//																	to format and display the returned DateTzDto.
+//																	If 'dateTimeFmtStr' is submitted as an 'empty
+//																	string', the current DateTzDto format string
+//																	is applied.
+//
+// Example Usage
+// =============
+//
+//	Ten working hours after Friday, 2018-06-29 15:00 with a Monday through
+//	Friday 09:00 - 17:00 schedule and US holidays:
+//
+//	dtz2, err := dtz.AddWorkingDuration(10 * time.Hour, schedule, "")
+//
+//	dtz2 = Monday, 2018-07-02 17:00:00
+//
+func (dtz *DateTzDto) AddWorkingDuration(workingTime time.Duration, schedule WorkScheduleDto,
+	dateTimeFmtStr string) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.AddWorkingDuration() "
+
+	err := dtz.IsValid()
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"The current DateTzDto is INVALID! dtz.DateTime='%v'", dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	newDt, err := schedule.addWorkingDuration(dtz.DateTime, workingTime)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	fmtStr := dateTimeFmtStr
+
+	if len(fmtStr) == 0 {
+		fmtStr = dtz.DateTimeFmt
+	}
+
+	dtz2, err := DateTzDto{}.New(newDt, fmtStr)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"Error returned by DateTzDto{}.New(newDt, fmtStr). newDt='%v'  Error='%v'",
+			newDt.Format(FmtDateTimeYrMDayFmtStr), err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// CeilingToUnit - Returns a new DateTzDto containing the earliest local
+// boundary of 'step' units of 'unit' which is greater than or equal to
+// the current date time. Boundaries are computed using the local day and
+// month boundaries of the current DateTzDto time zone. See method
+// RoundToUnit() for a description of boundary anchors.
+//
+// Example: 2018-03-06 20:02:18 rounded up to 15 minutes = 2018-03-06 20:15:00
+//
+func (dtz *DateTzDto) CeilingToUnit(unit TimeUnitType, step int) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.CeilingToUnit() "
+
+	dtz2, err := dtz.RoundToUnit(unit, step, RoundCEILING)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
 // CopyIn - Receives an incoming DateTzDto and
 // copies those data fields to the current DateTzDto
 // instance.
@@ -888,6 +1551,64 @@ func (dtz *DateTzDto) Empty() {
 	return
 }
 
+// EndOf - Returns a new DateTzDto set to the last nanosecond of the local
+// calendar period containing the current date time. The period is specified
+// by input parameter 'unit'. Weeks begin on Monday; to specify a different
+// first day of the week, use method EndOfWeek(). The current DateTzDto is
+// not altered.
+//
+// The returned value is one nanosecond before the start of the following
+// period. See method StartOf() for a description of local period boundaries.
+//
+// Input Parameters:
+// =================
+//
+// unit TimeUnitType - The calendar period. Valid values are:
+//                       TimeUnitDAYS
+//                       TimeUnitWEEKS
+//                       TimeUnitMONTHS
+//                       TimeUnitQUARTERS
+//                       TimeUnitHALFYEARS
+//                       TimeUnitYEARS
+//
+// Example:
+//
+//	dtz = 2018-05-16 14:22:05 -0500 CDT
+//	dtz2, err := dtz.EndOf(TimeUnitQUARTERS)
+//	dtz2 = 2018-06-30 23:59:59.999999999 -0500 CDT
+//
+func (dtz *DateTzDto) EndOf(unit TimeUnitType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.EndOf() "
+
+	dtz2, err := dtz.newPeriodBoundary(unit, time.Monday, true)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// EndOfWeek - Returns a new DateTzDto set to the last nanosecond of the
+// local calendar week containing the current date time. Input parameter
+// 'firstWeekDay' specifies the day on which weeks begin. Example: For
+// weeks beginning on Sunday, the week ends on Saturday at
+// 23:59:59.999999999 local time. The current DateTzDto is not altered.
+//
+func (dtz *DateTzDto) EndOfWeek(firstWeekDay time.Weekday) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.EndOfWeek() "
+
+	dtz2, err := dtz.newPeriodBoundary(TimeUnitWEEKS, firstWeekDay, true)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
 // Equal - Returns true if input DateTzDto is equal
 // in all respects to the current DateTzDto instance.
 func (dtz *DateTzDto) Equal(dtz2 DateTzDto) bool {
@@ -904,6 +1625,137 @@ func (dtz *DateTzDto) Equal(dtz2 DateTzDto) bool {
 	return true
 }
 
+// FloorToUnit - Returns a new DateTzDto containing the latest local
+// boundary of 'step' units of 'unit' which is less than or equal to
+// the current date time. Boundaries are computed using the local day and
+// month boundaries of the current DateTzDto time zone. See method
+// RoundToUnit() for a description of boundary anchors.
+//
+// Example: Bucket events by hour.
+//
+//	dtz = 2018-03-06 20:42:18 -0600 CST
+//	dtz2, err := dtz.FloorToUnit(TimeUnitHOURS, 1)
+//	dtz2 = 2018-03-06 20:00:00 -0600 CST
+//
+func (dtz *DateTzDto) FloorToUnit(unit TimeUnitType, step int) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.FloorToUnit() "
+
+	dtz2, err := dtz.RoundToUnit(unit, step, RoundFLOOR)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// GetAge - Returns the age of the current DateTzDto on the date of input
+// parameter 'referenceDate' as a TimeDto containing years, months and
+// days. The current DateTzDto is treated as a date of birth or the
+// original date of an anniversary.
+//
+// Ages are calendar date computations. 'referenceDate' is converted to the
+// time zone of the current DateTzDto and the time of day of both values is
+// ignored. Years are allocated first, then months and then days, in the
+// same manner as TDurCalcTypeSTDYEARMTH with TimeDurationDto.MonthEndOverflow
+// set to 'leapDay'.
+//
+// Input Parameters
+// ================
+//
+// referenceDate DateTzDto - The date at which the age is computed. Must not
+//                           precede the date of the current DateTzDto.
+//
+// leapDay MonthEndOverflowType - Specifies the anniversary of a February 29th
+//                           date in a year which is not a leap year.
+//
+//                             MonthEndOverflowCLAMP      February 28th
+//                             MonthEndOverflowROLLNEXT   March 1st
+//                             MonthEndOverflowNORMALIZE  March 1st
+//
+//                           The policy applies in the same way to the 29th,
+//                           30th or 31st of any month when months are
+//                           allocated.
+//
+// Returns
+// =======
+//
+// TimeDto - The 'Years', 'Months', 'Weeks', 'WeekDays' and 'DateDays' fields
+//           hold the age. All time components are zero. Example:
+//
+//           Date of birth 2000-02-29, referenceDate 2021-02-28
+//
+//             MonthEndOverflowCLAMP      21-Years 0-Months 0-Days
+//             MonthEndOverflowROLLNEXT   20-Years 11-Months 30-Days
+//
+// error   - If successful, the returned error Type is set equal to 'nil'.
+//
+func (dtz *DateTzDto) GetAge(referenceDate DateTzDto, leapDay MonthEndOverflowType) (TimeDto, error) {
+
+	ePrefix := "DateTzDto.GetAge() "
+
+	birthDate, refDate, err := dtz.anniversaryDates(referenceDate, leapDay)
+
+	if err != nil {
+		return TimeDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	if refDate.Before(birthDate) {
+		return TimeDto{}, fmt.Errorf(ePrefix +
+			"Error: 'referenceDate' precedes the current DateTzDto. referenceDate='%v' DateTime='%v'",
+			referenceDate.DateTime.Format(FmtDateTimeYrMDayFmtStr), dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	years, months, days := calendarYearsMonthsDays(birthDate, refDate, leapDay)
+
+	tDto := TimeDto{}
+
+	tDto.Years = years
+	tDto.Months = months
+
+	err = tDto.allocateWeeksAndDays(days)
+
+	if err != nil {
+		return TimeDto{}, fmt.Errorf(ePrefix + "Error returned by tDto.allocateWeeksAndDays(). Error='%v'", err.Error())
+	}
+
+	return tDto, nil
+}
+
+// GetBusinessDaysBetween - Returns the number of business days between the
+// local calendar date of the current DateTzDto and the local calendar date
+// of input parameter 'dtz2'. Both dates are evaluated in the time zone of
+// the current DateTzDto. Weekend days and holidays are defined by input
+// parameter 'bCal'.
+//
+// If 'dtz2' is later than the current date, business days after the current
+// date up to and including the date of 'dtz2' are counted. If 'dtz2' is
+// earlier, business days from the date of 'dtz2' up to but not including
+// the current date are counted and the result is negative. This is the
+// inverse of method AddBusinessDays():
+//
+//	dtz2, _ := dtz.AddBusinessDays(5, bCal, "")
+//	days, _ := dtz.GetBusinessDaysBetween(dtz2, bCal)
+//	days = 5
+//
+func (dtz *DateTzDto) GetBusinessDaysBetween(dtz2 DateTzDto, bCal BusinessCalendarDto) (int, error) {
+
+	ePrefix := "DateTzDto.GetBusinessDaysBetween() "
+
+	if dtz.DateTime.IsZero() || dtz2.DateTime.IsZero() {
+		return 0, fmt.Errorf(ePrefix + "Error: DateTime is a ZERO value!")
+	}
+
+	days, err := bCal.countBusinessDays(dtz.DateTime, dtz2.DateTime.In(dtz.DateTime.Location()))
+
+	if err != nil {
+		return 0, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return days, nil
+}
+
 // GetTimeDto - Converts the current DateTzDto instance
 // date time information into an instance of TimeDto
 // and returns that TimeDto to the caller.
@@ -987,28 +1839,217 @@ func (dtz *DateTzDto) GetDateTimeYMDAbbrvDowNano() string {
 }
 
 
-// GetDateTimeYrMDayTzFmtStr - Returns a date time string
-// formatted as year-mth-day time and time zone.
-// FmtDateTimeYrMDayFmtStr - Year Month Day Date Format String
-// EXAMPLE: "2006-01-02 15:04:05.000000000 -0700 MST"
-func (dtz *DateTzDto) GetDateTimeYrMDayTzFmtStr() string {
-	return dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr)
-}
+// GetDateTimeYrMDayTzFmtStr - Returns a date time string
+// formatted as year-mth-day time and time zone.
+// FmtDateTimeYrMDayFmtStr - Year Month Day Date Format String
+// EXAMPLE: "2006-01-02 15:04:05.000000000 -0700 MST"
+func (dtz *DateTzDto) GetDateTimeYrMDayTzFmtStr() string {
+	return dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr)
+}
+
+// GetDateTimeTzNanoSecYMDDowText - Outputs date time in string format using
+// the FmtDateTimeTzNanoYMDDow format which incorporates date time to nano seconds
+// and the associated time zone. In this format, the date is expressed as
+// Year-Month-Day (Example: 2017-12-06) followed by the day of the week.
+// EXAMPLE: 2006-01-02 Monday 15:04:05.000000000 -0700 MST
+func (dtz *DateTzDto) GetDateTimeTzNanoSecYMDDowText() string {
+	return dtz.DateTime.Format(FmtDateTimeTzNanoYMDDow)
+}
+
+// GetDateTimeEverything - Receives a time value and formats as
+// a date time string in the format:
+// EXAMPLE: Saturday April 29, 2017 19:54:30.123456489 -0500 CDT
+func (dtz *DateTzDto) GetDateTimeEverything() string {
+	return dtz.DateTime.Format(FmtDateTimeEverything)
+}
+
+// GetDateTimeEverythingLocale - Formats the current date time
+// in the 'FmtDateTimeEverything' format. Month and weekday names are
+// displayed in the language of input parameter 'locale'.
+// EXAMPLE (German): Samstag April 29, 2017 19:54:30.123456489 -0500 CDT
+func (dtz *DateTzDto) GetDateTimeEverythingLocale(locale LocaleDto) string {
+	return locale.FormatDateTime(dtz.DateTime, FmtDateTimeEverything)
+}
+
+// GetDateTimeLocaleStr - Formats the current date time using input
+// parameter 'fmtStr'. If 'fmtStr' is an empty string, the DateTzDto
+// format string, 'DateTimeFmt', is used. Month names, weekday names
+// and AM/PM markers are displayed in the language of input parameter
+// 'locale'.
+func (dtz *DateTzDto) GetDateTimeLocaleStr(fmtStr string, locale LocaleDto) string {
+
+	if fmtStr == "" {
+		fmtStr = dtz.DateTimeFmt
+	}
+
+	if fmtStr == "" {
+		fmtStr = FmtDateTimeYrMDayFmtStr
+	}
+
+	return locale.FormatDateTime(dtz.DateTime, fmtStr)
+}
+
+// GetDayOfYear - Returns the day of the year (1-366) for the
+// DateTime value of the current DateTzDto. Used in ISO 8601
+// ordinal dates. Example: 2026-10-17 returns 290.
+func (dtz *DateTzDto) GetDayOfYear() int {
+	return dtz.DateTime.YearDay()
+}
+
+// GetDaysUntilNextAnniversary - Returns the number of calendar days from
+// the date of input parameter 'referenceDate' to the next anniversary of
+// the current DateTzDto. If 'referenceDate' falls on an anniversary, the
+// return value is zero.
+//
+// See method GetNextAnniversary() for a description of input parameter
+// 'leapDay'.
+//
+func (dtz *DateTzDto) GetDaysUntilNextAnniversary(referenceDate DateTzDto,
+	leapDay MonthEndOverflowType) (int, error) {
+
+	ePrefix := "DateTzDto.GetDaysUntilNextAnniversary() "
+
+	birthDate, refDate, err := dtz.anniversaryDates(referenceDate, leapDay)
+
+	if err != nil {
+		return 0, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	nextDate := nextAnniversaryDate(birthDate, refDate, leapDay)
+
+	return int(nextDate.Sub(refDate) / (24 * time.Hour)), nil
+}
+
+// GetISOWeek - Returns the ISO 8601 week-year and week number (1-53)
+// for the DateTime value of the current DateTzDto. The ISO week-year
+// may differ from the calendar year in early January and late
+// December. Example: 2018-12-31 returns isoYear=2019 week=1.
+func (dtz *DateTzDto) GetISOWeek() (isoYear, week int) {
+	return dtz.DateTime.ISOWeek()
+}
+
+// GetISOWeekDateStr - Returns the ISO 8601 week date for the
+// DateTime value of the current DateTzDto. Example: "2026-W42-6"
+func (dtz *DateTzDto) GetISOWeekDateStr() string {
+	return formatISOWeekDate(dtz.DateTime)
+}
+
+// GetISOWeekDay - Returns the ISO 8601 weekday number for the
+// DateTime value of the current DateTzDto. Monday = 1 through
+// Sunday = 7.
+func (dtz *DateTzDto) GetISOWeekDay() int {
+	return isoWeekDayNumber(dtz.DateTime)
+}
+
+// GetNextAnniversary - Returns the first anniversary of the current
+// DateTzDto which falls on or after the date of input parameter
+// 'referenceDate'. If 'referenceDate' precedes the current DateTzDto,
+// the date of the current DateTzDto is returned.
+//
+// Anniversaries are calendar date computations. 'referenceDate' is
+// converted to the time zone of the current DateTzDto and its time of day
+// is ignored. The returned DateTzDto retains the time zone, wall clock
+// time and format of the current DateTzDto.
+//
+// Input Parameters
+// ================
+//
+// referenceDate DateTzDto - The date from which the next anniversary is
+//                           located.
+//
+// leapDay MonthEndOverflowType - Specifies the anniversary of a February 29th
+//                           date in a year which is not a leap year.
+//
+//                             MonthEndOverflowCLAMP      February 28th
+//                             MonthEndOverflowROLLNEXT   March 1st
+//                             MonthEndOverflowNORMALIZE  March 1st
+//
+func (dtz *DateTzDto) GetNextAnniversary(referenceDate DateTzDto,
+	leapDay MonthEndOverflowType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.GetNextAnniversary() "
+
+	birthDate, refDate, err := dtz.anniversaryDates(referenceDate, leapDay)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	dtz2, err := dtz.newAnniversary(nextAnniversaryDate(birthDate, refDate, leapDay))
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// GetOrdinalDateStr - Returns the ISO 8601 ordinal date for the
+// DateTime value of the current DateTzDto. Example: "2026-290"
+func (dtz *DateTzDto) GetOrdinalDateStr() string {
+	return formatOrdinalDate(dtz.DateTime)
+}
+
+// GetPreviousAnniversary - Returns the latest anniversary of the current
+// DateTzDto which falls on or before the date of input parameter
+// 'referenceDate'. This is the anniversary from which the years returned
+// by method GetAge() are counted. If 'referenceDate' falls on an
+// anniversary, GetPreviousAnniversary() and GetNextAnniversary() return
+// the same date.
+//
+// An error is returned if 'referenceDate' precedes the current DateTzDto.
+//
+// See method GetNextAnniversary() for a description of the input
+// parameters.
+//
+func (dtz *DateTzDto) GetPreviousAnniversary(referenceDate DateTzDto,
+	leapDay MonthEndOverflowType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.GetPreviousAnniversary() "
+
+	birthDate, refDate, err := dtz.anniversaryDates(referenceDate, leapDay)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	if refDate.Before(birthDate) {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"Error: 'referenceDate' precedes the current DateTzDto. referenceDate='%v' DateTime='%v'",
+			referenceDate.DateTime.Format(FmtDateTimeYrMDayFmtStr), dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	years := anniversaryYearsBefore(birthDate, refDate, leapDay)
+
+	dtz2, err := dtz.newAnniversary(addYearsMonthsOverflow(birthDate, years, 0, leapDay))
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// GetRelativeTimeStr - Describes the current DateTzDto relative to input
+// parameter 'reference', usually 'now'. Phrases such as "5 minutes ago",
+// "yesterday at 14:05" or "in 3 weeks" are formatted according to input
+// parameter 'relFmt'. See RelativeTimeFormatDto.
+//
+// Example:
+//
+//	str, err := dtz.GetRelativeTimeStr(nowDtz, RelativeTimeFormatDto{}.New())
+//
+func (dtz *DateTzDto) GetRelativeTimeStr(reference DateTzDto, relFmt RelativeTimeFormatDto) (string, error) {
+
+	ePrefix := "DateTzDto.GetRelativeTimeStr() "
+
+	str, err := relFmt.Format(*dtz, reference)
 
-// GetDateTimeTzNanoSecYMDDowText - Outputs date time in string format using
-// the FmtDateTimeTzNanoYMDDow format which incorporates date time to nano seconds
-// and the associated time zone. In this format, the date is expressed as
-// Year-Month-Day (Example: 2017-12-06) followed by the day of the week.
-// EXAMPLE: 2006-01-02 Monday 15:04:05.000000000 -0700 MST
-func (dtz *DateTzDto) GetDateTimeTzNanoSecYMDDowText() string {
-	return dtz.DateTime.Format(FmtDateTimeTzNanoYMDDow)
-}
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "%v", err.Error())
+	}
 
-// GetDateTimeEverything - Receives a time value and formats as
-// a date time string in the format:
-// EXAMPLE: Saturday April 29, 2017 19:54:30.123456489 -0500 CDT
-func (dtz *DateTzDto) GetDateTimeEverything() string {
-	return dtz.DateTime.Format(FmtDateTimeEverything)
+	return str, nil
 }
 
 // GetTimeStampEverything - Generates and returns a time stamp as
@@ -1029,6 +2070,16 @@ func (dtz *DateTzDto) GetTimeStampYMDAbbrvDowNano() string {
 
 }
 
+// IsBusinessDay - Returns 'true' if the local calendar date of the
+// current DateTzDto is a business day. Weekend days and holidays are
+// defined by input parameter 'bCal'.
+func (dtz *DateTzDto) IsBusinessDay(bCal BusinessCalendarDto) bool {
+
+	year, month, day := dtz.DateTime.Date()
+
+	return bCal.IsBusinessDay(year, month, day)
+}
+
 // IsEmpty - Analyzes the current DateTzDto instance to determine
 // if the instance is in an 'EMPTY' or uninitialized state.
 //
@@ -1684,6 +2735,141 @@ nanosecond int, timeZoneLocation, dateTimeFmtStr string) (DateTzDto, error) {
 	return dtz2, nil
 }
 
+// NewISOWeekDate - Creates a new DateTzDto from ISO 8601 week date
+// components plus time of day in the specified time zone.
+//
+// Input Parameters
+// ================
+//
+// isoYear					int			- ISO 8601 week-year
+// week							int			- ISO week number 1 - 52 or 53. Only week-years
+//														containing 53 weeks accept a week value of 53.
+// weekDay					int			- ISO weekday number 1 (Monday) - 7 (Sunday)
+// hour							int			- hour number  	0 - 23
+// minute						int			- minute number	0 - 59
+// second						int			- second number	0	-	59
+// nanosecond				int			- nanosecond number 0 - 999999999
+// timeZoneLocation	string	- time zone location. Examples: 'Local', "America/Chicago".
+//														If 'timeZoneLocation' is submitted as an empty string,
+//														it will default to "Etc/UTC" = ZULU, GMT, UTC
+//
+// dateTimeFmtStr string		- A date time format string which will be used
+//															to format and display 'dateTime'. If 'dateTimeFmtStr'
+//															is submitted as an 'empty string', the default format
+//															string, FmtDateTimeYrMDayFmtStr, will be applied.
+//
+// Usage
+// =====
+//
+// Example: "2026-W42-5" 08:30 Central Time
+//
+//	dtzDto, err := DateTzDto{}.NewISOWeekDate(2026, 42, 5, 8, 30, 0, 0,
+//										TzIanaUsCentral, FmtDateTimeYrMDayFmtStr)
+//
+//	dtzDto.String() = "2026-10-16 08:30:00.000000000 -0500 CDT"
+//
+func (dtz DateTzDto) NewISOWeekDate(isoYear, week, weekDay, hour, minute, second, nanosecond int,
+	timeZoneLocation, dateTimeFmtStr string) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.NewISOWeekDate() "
+
+	year, month, day, err := isoWeekDateToDate(isoYear, week, weekDay)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	tzl := dtz.preProcessTimeZoneLocation(timeZoneLocation)
+
+	loc, err := time.LoadLocation(tzl)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"Error returned by time.LoadLocation(tzl). INVALID 'timeZoneLocation'! " +
+			"tzl='%v' timeZoneLocation='%v' Error='%v' ",
+			tzl, timeZoneLocation, err.Error())
+	}
+
+	dt := time.Date(year, month, day, hour, minute, second, nanosecond, loc)
+
+	dtz2, err := DateTzDto{}.New(dt, dateTimeFmtStr)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"Error returned by DateTzDto{}.New(dt, dateTimeFmtStr). " +
+			"isoYear='%v' week='%v' weekDay='%v' Error='%v'", isoYear, week, weekDay, err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// NewOrdinalDate - Creates a new DateTzDto from ISO 8601 ordinal date
+// components (year and day of year) plus time of day in the specified
+// time zone.
+//
+// Input Parameters
+// ================
+//
+// year							int			- year number
+// dayOfYear				int			- day of year 1 - 365 or 366 in leap years
+// hour							int			- hour number  	0 - 23
+// minute						int			- minute number	0 - 59
+// second						int			- second number	0	-	59
+// nanosecond				int			- nanosecond number 0 - 999999999
+// timeZoneLocation	string	- time zone location. Examples: 'Local', "America/Chicago".
+//														If 'timeZoneLocation' is submitted as an empty string,
+//														it will default to "Etc/UTC" = ZULU, GMT, UTC
+//
+// dateTimeFmtStr string		- A date time format string which will be used
+//															to format and display 'dateTime'. If 'dateTimeFmtStr'
+//															is submitted as an 'empty string', the default format
+//															string, FmtDateTimeYrMDayFmtStr, will be applied.
+//
+// Usage
+// =====
+//
+// Example: "2026-290"
+//
+//	dtzDto, err := DateTzDto{}.NewOrdinalDate(2026, 290, 0, 0, 0, 0,
+//										TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+//
+//	dtzDto.String() = "2026-10-17 00:00:00.000000000 +0000 UTC"
+//
+func (dtz DateTzDto) NewOrdinalDate(year, dayOfYear, hour, minute, second, nanosecond int,
+	timeZoneLocation, dateTimeFmtStr string) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.NewOrdinalDate() "
+
+	y, month, day, err := ordinalDateToDate(year, dayOfYear)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	tzl := dtz.preProcessTimeZoneLocation(timeZoneLocation)
+
+	loc, err := time.LoadLocation(tzl)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"Error returned by time.LoadLocation(tzl). INVALID 'timeZoneLocation'! " +
+			"tzl='%v' timeZoneLocation='%v' Error='%v' ",
+			tzl, timeZoneLocation, err.Error())
+	}
+
+	dt := time.Date(y, month, day, hour, minute, second, nanosecond, loc)
+
+	dtz2, err := DateTzDto{}.New(dt, dateTimeFmtStr)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"Error returned by DateTzDto{}.New(dt, dateTimeFmtStr). " +
+			"year='%v' dayOfYear='%v' Error='%v'", year, dayOfYear, err.Error())
+	}
+
+	return dtz2, nil
+}
+
 // NewTimeDto - Receives input parameters type TimeDto, 'timeZoneLocation' and 'dateTimeFormatStr'.
 // These parameters are used to construct and return a new DateTzDto instance.
 //
@@ -1753,6 +2939,114 @@ func (dtz DateTzDto) NewTimeDto(tDto TimeDto, timeZoneLocation string, dateTimeF
 	return dtz2, nil
 }
 
+// NextBusinessDay - Returns a new DateTzDto set to the first business day
+// following the local calendar date of the current DateTzDto. The local
+// wall clock time of day is retained. Weekend days and holidays are defined
+// by input parameter 'bCal'.
+//
+func (dtz *DateTzDto) NextBusinessDay(bCal BusinessCalendarDto) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.NextBusinessDay() "
+
+	dtz2, err := dtz.AddBusinessDays(1, bCal, dtz.DateTimeFmt)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// PreviousBusinessDay - Returns a new DateTzDto set to the last business day
+// preceding the local calendar date of the current DateTzDto. The local wall
+// clock time of day is retained. Weekend days and holidays are defined by
+// input parameter 'bCal'.
+//
+func (dtz *DateTzDto) PreviousBusinessDay(bCal BusinessCalendarDto) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.PreviousBusinessDay() "
+
+	dtz2, err := dtz.AddBusinessDays(-1, bCal, dtz.DateTimeFmt)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// RoundToUnit - Rounds the current date time to a local boundary of
+// 'step' units of 'unit' and returns the result as a new DateTzDto.
+// The current DateTzDto is not altered.
+//
+// Rounding is zone-aware. Boundaries are computed from the local wall
+// clock of the current DateTzDto time zone, not from UTC. Days, weeks,
+// months and years begin at local midnight. If local midnight does not
+// exist because of a daylight savings transition, the day begins at the
+// first instant after the transition. When a wall clock boundary occurs
+// twice (clocks moved back), both occurrences are treated as boundaries.
+//
+// Boundaries are anchored as follows:
+//
+//	Nanoseconds through Hours - Local midnight. Example: a step of 15 minutes
+//	                            produces boundaries at :00, :15, :30 and :45.
+//	                            If 'step' does not divide a day evenly, the
+//	                            last period of each day is shortened.
+//	Days                      - 1970-01-01
+//	Weeks                     - Monday. Weeks begin on Monday.
+//	Months through Years      - January of year zero. Quarters begin in
+//	                            January, April, July and October. Half-Years
+//	                            begin in January and July.
+//
+// Distances to the lower and upper boundaries are measured in elapsed
+// time.
+//
+// Input Parameters:
+// =================
+//
+// unit      TimeUnitType     - The time unit. Valid values are TimeUnitNANOSECONDS
+//                              through TimeUnitYEARS, TimeUnitQUARTERS and
+//                              TimeUnitHALFYEARS.
+//
+// step      int              - The number of units in each rounding period. Must
+//                              be greater than zero. Example: 15 minutes.
+//
+// roundMode RoundingModeType - The rounding mode. Examples: RoundHALFUP,
+//                              RoundHALFEVEN, RoundTOWARDZERO. For date times,
+//                              RoundTOWARDZERO is equivalent to RoundFLOOR.
+//
+func (dtz *DateTzDto) RoundToUnit(unit TimeUnitType, step int,
+	roundMode RoundingModeType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.RoundToUnit() "
+
+	if dtz.DateTime.IsZero() {
+		return DateTzDto{},
+			fmt.Errorf(ePrefix + "Error: The current DateTzDto DateTime is a ZERO value!")
+	}
+
+	lower, upper, lowerIdx, err := localUnitBoundaries(dtz.DateTime, unit, int64(step))
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	result, err := selectRoundedBoundary(dtz.DateTime, lower, upper, lowerIdx, roundMode)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	dtz2, err := DateTzDto{}.New(result.In(dtz.DateTime.Location()), dtz.DateTimeFmt)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix +
+			"Error returned by DateTzDto{}.New(result, dtz.DateTimeFmt). Error='%v'", err.Error())
+	}
+
+	return dtz2, nil
+}
+
 // SetDateTimeFmt - Sets the DateTzDto data field 'DateTimeFmt'.
 // This string is used to format the DateTzDto DateTime field
 // when DateTzDto.String() is called.
@@ -2298,6 +3592,67 @@ func (dtz *DateTzDto) SetFromTimeDto(tDto TimeDto, timeZoneLocation string) erro
 	return nil
 }
 
+// StartOf - Returns a new DateTzDto set to the first instant of the local
+// calendar period containing the current date time. The period is specified
+// by input parameter 'unit'. Weeks begin on Monday (ISO 8601); to specify a
+// different first day of the week, use method StartOfWeek(). The current
+// DateTzDto is not altered.
+//
+// Period boundaries are computed in the time zone of the current DateTzDto.
+// Each period begins at local midnight. If local midnight does not exist
+// because clocks were moved forward at midnight, the period begins at the
+// first instant after the transition. Example: On 2018-11-04 clocks in
+// America/Sao_Paulo moved from 00:00 to 01:00. The start of that day is
+// 2018-11-04 01:00:00 -0200.
+//
+// Input Parameters:
+// =================
+//
+// unit TimeUnitType - The calendar period. Valid values are:
+//                       TimeUnitDAYS
+//                       TimeUnitWEEKS
+//                       TimeUnitMONTHS
+//                       TimeUnitQUARTERS
+//                       TimeUnitHALFYEARS
+//                       TimeUnitYEARS
+//
+// Example:
+//
+//	dtz = 2018-05-16 14:22:05 -0500 CDT
+//	dtz2, err := dtz.StartOf(TimeUnitQUARTERS)
+//	dtz2 = 2018-04-01 00:00:00 -0500 CDT
+//
+func (dtz *DateTzDto) StartOf(unit TimeUnitType) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.StartOf() "
+
+	dtz2, err := dtz.newPeriodBoundary(unit, time.Monday, false)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// StartOfWeek - Returns a new DateTzDto set to the first instant of the
+// local calendar week containing the current date time. Input parameter
+// 'firstWeekDay' specifies the day on which weeks begin. Example: time.Sunday.
+// The current DateTzDto is not altered.
+//
+func (dtz *DateTzDto) StartOfWeek(firstWeekDay time.Weekday) (DateTzDto, error) {
+
+	ePrefix := "DateTzDto.StartOfWeek() "
+
+	dtz2, err := dtz.newPeriodBoundary(TimeUnitWEEKS, firstWeekDay, false)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dtz2, nil
+}
+
 // String - This method returns the DateTzDto
 // DateTime field value formatted as a string.
 // If the DateTzDto field DateTimeFmt is an
@@ -2356,3 +3711,149 @@ func (dtz *DateTzDto) preProcessTimeZoneLocation(timeZoneLocation string) string
 	return timeZoneLocation
 }
 
+
+// newPeriodBoundary - Returns a new DateTzDto set to either the first
+// instant (endOfPeriod=false) or the last nanosecond (endOfPeriod=true)
+// of the local calendar period containing the current date time.
+func (dtz *DateTzDto) newPeriodBoundary(unit TimeUnitType, firstWeekDay time.Weekday,
+	endOfPeriod bool) (DateTzDto, error) {
+
+	if dtz.DateTime.IsZero() {
+		return DateTzDto{},
+			fmt.Errorf("Error: The current DateTzDto DateTime is a ZERO value!")
+	}
+
+	start, next, err := localPeriodStart(dtz.DateTime, unit, firstWeekDay)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	result := start
+
+	if endOfPeriod {
+		result = next.Add(-time.Nanosecond)
+	}
+
+	dtz2, err := DateTzDto{}.New(result.In(dtz.DateTime.Location()), dtz.DateTimeFmt)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf("Error returned by DateTzDto{}.New(result, dtz.DateTimeFmt). " +
+			"Error='%v'", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// anniversaryDates - Returns the local calendar date of the current
+// DateTzDto and the local calendar date of 'referenceDate' in the time
+// zone of the current DateTzDto. Both dates are returned as midnight UTC
+// so that the difference between them is a whole number of 24-hour days.
+func (dtz *DateTzDto) anniversaryDates(referenceDate DateTzDto,
+	leapDay MonthEndOverflowType) (time.Time, time.Time, error) {
+
+	if dtz.DateTime.IsZero() || referenceDate.DateTime.IsZero() {
+		return time.Time{}, time.Time{}, errors.New("Error: DateTime is a ZERO value!")
+	}
+
+	err := validateMonthEndOverflow(leapDay)
+
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	year, month, day := dtz.DateTime.Date()
+
+	birthDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	year, month, day = referenceDate.DateTime.In(dtz.DateTime.Location()).Date()
+
+	refDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	return birthDate, refDate, nil
+}
+
+// newAnniversary - Returns a new DateTzDto on the calendar date 'date'
+// with the time zone, wall clock time and format of the current DateTzDto.
+func (dtz *DateTzDto) newAnniversary(date time.Time) (DateTzDto, error) {
+
+	year, month, day := date.Date()
+
+	t := resolveWallClock(year, month, day, wallClockNanosecs(dtz.DateTime), dtz.DateTime.Location())[0]
+
+	dtz2, err := DateTzDto{}.New(t, dtz.DateTimeFmt)
+
+	if err != nil {
+		return DateTzDto{}, fmt.Errorf("Error returned by DateTzDto{}.New(t, dtz.DateTimeFmt). Error='%v'", err.Error())
+	}
+
+	return dtz2, nil
+}
+
+// anniversaryYearsBefore - Returns the number of whole years from
+// 'birthDate' to the latest anniversary on or before 'refDate'.
+// 'refDate' must not precede 'birthDate'.
+func anniversaryYearsBefore(birthDate, refDate time.Time, leapDay MonthEndOverflowType) int {
+
+	years := refDate.Year() - birthDate.Year()
+
+	for years > 0 && addYearsMonthsOverflow(birthDate, years, 0, leapDay).After(refDate) {
+		years--
+	}
+
+	return years
+}
+
+// anniversaryMonthDate - Returns the date which lies 'months' months
+// after the anniversary 'yearDate', which is 'years' years after
+// 'birthDate'. Months are allocated as in
+// TimeDurationDto.addAllocatedMonths().
+func anniversaryMonthDate(birthDate, yearDate time.Time, years, months int,
+	leapDay MonthEndOverflowType) time.Time {
+
+	if leapDay == MonthEndOverflowNORMALIZE {
+		return yearDate.AddDate(0, months, 0)
+	}
+
+	return addYearsMonthsOverflow(birthDate, years, months, leapDay)
+}
+
+// calendarYearsMonthsDays - Allocates the calendar dates from 'birthDate'
+// through 'refDate' to years, months and days. 'refDate' must not precede
+// 'birthDate'. Both dates must be expressed as midnight UTC.
+func calendarYearsMonthsDays(birthDate, refDate time.Time,
+	leapDay MonthEndOverflowType) (years, months, days int) {
+
+	years = anniversaryYearsBefore(birthDate, refDate, leapDay)
+
+	yearDate := addYearsMonthsOverflow(birthDate, years, 0, leapDay)
+
+	for months < 12 && !anniversaryMonthDate(birthDate, yearDate, years, months+1, leapDay).After(refDate) {
+		months++
+	}
+
+	monthDate := anniversaryMonthDate(birthDate, yearDate, years, months, leapDay)
+
+	days = int(refDate.Sub(monthDate) / (24 * time.Hour))
+
+	return years, months, days
+}
+
+// nextAnniversaryDate - Returns the first anniversary of 'birthDate'
+// which falls on or after 'refDate'.
+func nextAnniversaryDate(birthDate, refDate time.Time, leapDay MonthEndOverflowType) time.Time {
+
+	if !refDate.After(birthDate) {
+		return birthDate
+	}
+
+	years := anniversaryYearsBefore(birthDate, refDate, leapDay)
+
+	nextDate := addYearsMonthsOverflow(birthDate, years, 0, leapDay)
+
+	if nextDate.Before(refDate) {
+		nextDate = addYearsMonthsOverflow(birthDate, years+1, 0, leapDay)
+	}
+
+	return nextDate
+}
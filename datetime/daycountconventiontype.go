@@ -0,0 +1,304 @@
+package datetime
+
+import (
+	"math/big"
+	"time"
+)
+
+/*
+ DayCountConventionType
+ ======================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\daycountconventiontype.go
+
+ Overview and Usage
+ ==================
+ 'DayCountConventionType' is an enumeration of the financial day count
+ conventions used to compute interest accrual year fractions between a
+ start date (D1) and an end date (D2). Only the calendar dates are
+ used. Times of day are ignored.
+
+ 30/360 Conventions:
+
+	Year Fraction = (360*(Y2-Y1) + 30*(M2-M1) + (D2-D1)) / 360
+
+	The day numbers D1 and D2 are adjusted before the computation:
+
+	DayCount30360US     - 30/360 US, Bond Basis. Rules are applied in
+	                      order:
+	                      (1) End of Month: If D1 and D2 are both the last
+	                          day of February, D2 = 30.
+	                      (2) End of Month: If D1 is the last day of
+	                          February, D1 = 30.
+	                      (3) If D2 = 31 and D1 is 30 or 31, D2 = 30.
+	                      (4) If D1 = 31, D1 = 30.
+	                      Rules (1) and (2) apply only when the End of
+	                      Month parameter is set.
+
+	DayCount30E360      - 30E/360, Eurobond Basis.
+	                      If D1 = 31, D1 = 30. If D2 = 31, D2 = 30.
+
+	DayCount30E360ISDA  - 30E/360 ISDA.
+	                      If D1 is the last day of the month, D1 = 30.
+	                      If D2 is the last day of the month, D2 = 30,
+	                      unless D2 is the termination date and D2 falls
+	                      in February.
+
+ Actual Conventions:
+
+	DayCountACT360      - Actual days / 360
+
+	DayCountACT365F     - Actual days / 365 (Fixed)
+
+	DayCountACTACTISDA  - Actual days falling in non-leap years / 365
+	                      plus actual days falling in leap years / 366
+
+	DayCountACTACTICMA  - Actual/Actual ICMA (ISMA Rule 251). Each
+	                      notional coupon period contributes
+	                      days in the accrual period / (Frequency * days
+	                      in the notional coupon period). Notional coupon
+	                      periods are generated from a regular coupon date
+	                      in steps of 12/Frequency months. If the regular
+	                      coupon date is the last day of its month, the
+	                      notional coupon dates are month ends. Short and
+	                      long stub periods are supported.
+
+ DayCountNONE is the zero value and is NOT a valid convention.
+
+*/
+
+// DayCountConventionType - Specifies the financial day count convention
+// used to compute year fractions.
+type DayCountConventionType int
+
+// String - Returns a string equivalent to the
+// integer value of DayCountConventionType
+func (dayCount DayCountConventionType) String() string {
+
+	if dayCount < 0 || int(dayCount) >= len(DayCountConventionTypeLabels) {
+		return ""
+	}
+
+	return DayCountConventionTypeLabels[dayCount]
+}
+
+// IsValid - Returns 'true' if the current DayCountConventionType
+// identifies a valid day count convention. 'DayCountNONE' is NOT
+// a valid day count convention.
+func (dayCount DayCountConventionType) IsValid() bool {
+
+	if dayCount <= DayCountNONE || int(dayCount) >= len(DayCountConventionTypeLabels) {
+		return false
+	}
+
+	return true
+}
+
+// Day Count Conventions
+const (
+
+	// DayCountNONE - No day count convention specified.
+	DayCountNONE DayCountConventionType = iota
+
+	// DayCount30360US - 30/360 US, Bond Basis
+	DayCount30360US
+
+	// DayCount30E360 - 30E/360, Eurobond Basis
+	DayCount30E360
+
+	// DayCount30E360ISDA - 30E/360 ISDA
+	DayCount30E360ISDA
+
+	// DayCountACT360 - Actual/360
+	DayCountACT360
+
+	// DayCountACT365F - Actual/365 Fixed
+	DayCountACT365F
+
+	// DayCountACTACTISDA - Actual/Actual ISDA
+	DayCountACTACTISDA
+
+	// DayCountACTACTICMA - Actual/Actual ICMA
+	DayCountACTACTICMA
+)
+
+// DayCountConventionTypeLabels - Text Names associated with
+// DayCountConventionType types.
+var DayCountConventionTypeLabels = [...]string{"None", "30/360 US", "30E/360",
+	"30E/360 ISDA", "ACT/360", "ACT/365F", "ACT/ACT ISDA", "ACT/ACT ICMA"}
+
+// dayCountActualDays - Returns the number of calendar days from civil
+// date 'd1' to civil date 'd2'. Civil dates are expressed as midnight UTC.
+func dayCountActualDays(d1, d2 time.Time) int64 {
+
+	return int64(d2.Sub(d1) / (24 * time.Hour))
+}
+
+// dayCount30360 - Returns the 30/360 day count between civil dates 'd1'
+// and 'd2' after day number adjustment under 30/360 convention
+// 'dayCount'. 'd1' must not be later than 'd2'.
+func dayCount30360(d1, d2 time.Time, dayCount DayCountConventionType,
+	endOfMonth bool, terminationDate time.Time) int64 {
+
+	y1, m1, day1 := d1.Date()
+	y2, m2, day2 := d2.Date()
+
+	switch dayCount {
+
+	case DayCount30360US:
+
+		if endOfMonth && dayCountIsLastDayOfFeb(d1) {
+
+			if dayCountIsLastDayOfFeb(d2) {
+				day2 = 30
+			}
+
+			day1 = 30
+		}
+
+		if day2 == 31 && day1 >= 30 {
+			day2 = 30
+		}
+
+		if day1 == 31 {
+			day1 = 30
+		}
+
+	case DayCount30E360:
+
+		if day1 == 31 {
+			day1 = 30
+		}
+
+		if day2 == 31 {
+			day2 = 30
+		}
+
+	case DayCount30E360ISDA:
+
+		if dayCountIsLastDayOfMonth(d1) {
+			day1 = 30
+		}
+
+		if dayCountIsLastDayOfMonth(d2) &&
+			!(m2 == time.February && d2.Equal(terminationDate)) {
+			day2 = 30
+		}
+	}
+
+	return int64(360*(y2-y1) + 30*(int(m2)-int(m1)) + (day2 - day1))
+}
+
+// dayCountActActISDA - Returns the Actual/Actual ISDA year fraction
+// between civil dates 'd1' and 'd2'. 'd1' must not be later than 'd2'.
+func dayCountActActISDA(d1, d2 time.Time) *big.Rat {
+
+	fraction := new(big.Rat)
+
+	for start := d1; start.Before(d2); {
+
+		end := time.Date(start.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		if end.After(d2) {
+			end = d2
+		}
+
+		daysInYear := int64(365)
+
+		if dayCountIsLeapYear(start.Year()) {
+			daysInYear = 366
+		}
+
+		fraction.Add(fraction, big.NewRat(dayCountActualDays(start, end), daysInYear))
+
+		start = end
+	}
+
+	return fraction
+}
+
+// dayCountActActICMA - Returns the Actual/Actual ICMA year fraction
+// between civil dates 'd1' and 'd2'. Notional coupon periods are generated
+// from regular coupon date 'couponDate' in steps of 12/'frequency' months.
+// 'd1' must not be later than 'd2'.
+func dayCountActActICMA(d1, d2, couponDate time.Time, frequency int) *big.Rat {
+
+	months := 12 / frequency
+
+	isEndOfMonth := dayCountIsLastDayOfMonth(couponDate)
+
+	// Locate the notional coupon period containing 'd1'.
+	k := ((d1.Year()-couponDate.Year())*12 + int(d1.Month()) - int(couponDate.Month())) / months
+
+	for dayCountCouponDate(couponDate, k*months, isEndOfMonth).After(d1) {
+		k--
+	}
+
+	for !dayCountCouponDate(couponDate, (k+1)*months, isEndOfMonth).After(d1) {
+		k++
+	}
+
+	fraction := new(big.Rat)
+
+	for start := dayCountCouponDate(couponDate, k*months, isEndOfMonth); start.Before(d2); k++ {
+
+		end := dayCountCouponDate(couponDate, (k+1)*months, isEndOfMonth)
+
+		accrualStart := start
+
+		if d1.After(accrualStart) {
+			accrualStart = d1
+		}
+
+		accrualEnd := end
+
+		if d2.Before(accrualEnd) {
+			accrualEnd = d2
+		}
+
+		fraction.Add(fraction, big.NewRat(dayCountActualDays(accrualStart, accrualEnd),
+			int64(frequency)*dayCountActualDays(start, end)))
+
+		start = end
+	}
+
+	return fraction
+}
+
+// dayCountCouponDate - Returns the notional coupon date located 'months'
+// months from regular coupon date 'couponDate'. If 'isEndOfMonth' is
+// 'true', the last day of the target month is returned. Otherwise, the
+// day of the month is limited to the last day of the target month.
+func dayCountCouponDate(couponDate time.Time, months int, isEndOfMonth bool) time.Time {
+
+	if isEndOfMonth {
+		return time.Date(couponDate.Year(), couponDate.Month()+time.Month(months)+1, 1,
+			0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	}
+
+	return addYearsMonthsOverflow(couponDate, 0, months, MonthEndOverflowCLAMP)
+}
+
+// dayCountIsLastDayOfFeb - Returns 'true' if civil date 't' is the last
+// day of February.
+func dayCountIsLastDayOfFeb(t time.Time) bool {
+
+	return t.Month() == time.February && dayCountIsLastDayOfMonth(t)
+}
+
+// dayCountIsLastDayOfMonth - Returns 'true' if civil date 't' is the last
+// day of its month.
+func dayCountIsLastDayOfMonth(t time.Time) bool {
+
+	return t.AddDate(0, 0, 1).Day() == 1
+}
+
+// dayCountIsLeapYear - Returns 'true' if 'year' is a Gregorian leap year.
+func dayCountIsLeapYear(year int) bool {
+
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
@@ -0,0 +1,258 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+/*
+ DayCountDto
+ ===========
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\daycountdto.go
+
+ Overview and Usage
+ ==================
+ The 'DayCountDto' Type computes the day count and the exact year
+ fraction between two DateTzDto values under a financial day count
+ convention. The supported conventions are described in source file
+ 'daycountconventiontype.go'.
+
+ Year fractions are stored as exact rational values. Method
+ GetYearFraction() returns the year fraction as a DecimalDto rounded
+ to a specified precision. Unlike 'TDurCalcTypeGregorianYrs', which
+ divides elapsed time by the 365.2425 day average Gregorian Year, the
+ day count conventions operate on calendar dates only.
+
+ The end date is converted to the time zone of the start date before
+ calendar dates are extracted. If the end date precedes the start date,
+ the day count and year fraction are negative.
+
+ Some conventions require additional parameters which are supplied in
+ a 'DayCountParamsDto':
+
+	DayCount30360US     - EndOfMonth: Applies the February end of month
+	                      rules. Set this value if the instrument pays
+	                      on the last day of the month.
+
+	DayCount30E360ISDA  - TerminationDate: The maturity date of the
+	                      instrument. If omitted, the February end date
+	                      rule is never suppressed.
+
+	DayCountACTACTICMA  - CouponFrequency: The number of regular coupon
+	                      payments per year. Valid values are 1, 2, 3, 4,
+	                      6 and 12.
+	                      CouponReferenceDate: Any regular coupon date of
+	                      the instrument. If omitted, the end date is
+	                      treated as a regular coupon date.
+
+ Example:
+
+	dayCnt, err := DayCountDto{}.New(startDtz, endDtz,
+	                 DayCountACTACTISDA, DayCountParamsDto{})
+
+	yearFrac, err := dayCnt.GetYearFraction(12, RoundHALFUP)
+
+	2003-11-01 to 2004-05-01   yearFrac.String() = "0.497724380567"
+
+*/
+
+// DayCountParamsDto - Additional parameters required by the
+// 30/360 US, 30E/360 ISDA and Actual/Actual ICMA day count
+// conventions. Parameters which do not apply to the selected
+// convention are ignored.
+type DayCountParamsDto struct {
+	EndOfMonth          bool      // 30/360 US: Apply the February end of month rules
+	TerminationDate     DateTzDto // 30E/360 ISDA: The maturity date of the instrument
+	CouponFrequency     int       // ACT/ACT ICMA: Regular coupon payments per year
+	CouponReferenceDate DateTzDto // ACT/ACT ICMA: A regular coupon date. Defaults to the end date.
+}
+
+// DayCountDto - The day count and year fraction between two dates
+// under a financial day count convention.
+type DayCountDto struct {
+	Convention   DayCountConventionType // The day count convention
+	StartDate    DateTzDto              // The start date of the accrual period
+	EndDate      DateTzDto              // The end date expressed in the time zone of StartDate
+	DayCount     int64                  // Days counted under the convention. 30/360 conventions count 30 day months.
+	ActualDays   int64                  // Actual calendar days from StartDate to EndDate
+	yearFraction *big.Rat               // Exact year fraction
+}
+
+// CopyOut - Returns a deep copy of the current DayCountDto.
+func (dayCnt *DayCountDto) CopyOut() DayCountDto {
+
+	dayCnt2 := *dayCnt
+
+	dayCnt2.StartDate = dayCnt.StartDate.CopyOut()
+	dayCnt2.EndDate = dayCnt.EndDate.CopyOut()
+	dayCnt2.yearFraction = dayCnt.GetYearFractionRat()
+
+	return dayCnt2
+}
+
+// GetYearFraction - Returns the year fraction rounded to 'precision'
+// digits to the right of the decimal point using rounding mode
+// 'roundMode'.
+//
+// Example:
+//
+//	 Convention = DayCountACT360  2026-01-15 to 2026-07-15 (181 days)
+//
+//	 dec, err := dayCnt.GetYearFraction(6, RoundHALFUP)
+//
+//	 dec.String() = "0.502778"
+//
+func (dayCnt *DayCountDto) GetYearFraction(precision uint, roundMode RoundingModeType) (DecimalDto, error) {
+
+	ePrefix := "DayCountDto.GetYearFraction() "
+
+	dec, err := DecimalDto{}.NewRat(dayCnt.GetYearFractionRat(), precision, roundMode)
+
+	if err != nil {
+		return DecimalDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dec, nil
+}
+
+// GetYearFractionRat - Returns the exact year fraction as a *big.Rat.
+func (dayCnt *DayCountDto) GetYearFractionRat() *big.Rat {
+
+	if dayCnt.yearFraction == nil {
+		return big.NewRat(0, 1)
+	}
+
+	return new(big.Rat).Set(dayCnt.yearFraction)
+}
+
+// New - Computes the day count and year fraction from 'startDate' to
+// 'endDate' under day count convention 'convention'.
+//
+// Input Parameters
+// ================
+//
+// startDate DateTzDto                - The start date of the accrual period.
+//
+// endDate   DateTzDto                - The end date of the accrual period. The
+//                                      end date is converted to the time zone
+//                                      of 'startDate'.
+//
+// convention DayCountConventionType  - The day count convention. Example:
+//                                      DayCount30360US, DayCountACTACTISDA
+//
+// params DayCountParamsDto           - Parameters required by DayCount30360US,
+//                                      DayCount30E360ISDA and DayCountACTACTICMA.
+//                                      Submit an empty DayCountParamsDto for
+//                                      other conventions.
+//
+func (dayCnt DayCountDto) New(
+	startDate,
+	endDate DateTzDto,
+	convention DayCountConventionType,
+	params DayCountParamsDto) (DayCountDto, error) {
+
+	ePrefix := "DayCountDto.New() "
+
+	if !convention.IsValid() {
+		return DayCountDto{},
+			fmt.Errorf(ePrefix + "Error: Invalid day count convention. convention='%v'", int(convention))
+	}
+
+	if startDate.DateTime.IsZero() {
+		return DayCountDto{}, errors.New(ePrefix + "Error: Input parameter 'startDate' has a Zero value!")
+	}
+
+	if endDate.DateTime.IsZero() {
+		return DayCountDto{}, errors.New(ePrefix + "Error: Input parameter 'endDate' has a Zero value!")
+	}
+
+	loc := startDate.DateTime.Location()
+
+	d1 := dayCountCivilDate(startDate.DateTime, loc)
+	d2 := dayCountCivilDate(endDate.DateTime, loc)
+
+	sign := int64(1)
+
+	if d2.Before(d1) {
+		d1, d2 = d2, d1
+		sign = -1
+	}
+
+	dayCnt2 := DayCountDto{}
+
+	dayCnt2.Convention = convention
+	dayCnt2.ActualDays = dayCountActualDays(d1, d2)
+	dayCnt2.DayCount = dayCnt2.ActualDays
+
+	switch convention {
+
+	case DayCount30360US, DayCount30E360, DayCount30E360ISDA:
+
+		terminationDate := time.Time{}
+
+		if !params.TerminationDate.DateTime.IsZero() {
+			terminationDate = dayCountCivilDate(params.TerminationDate.DateTime, loc)
+		}
+
+		dayCnt2.DayCount = dayCount30360(d1, d2, convention, params.EndOfMonth, terminationDate)
+		dayCnt2.yearFraction = big.NewRat(dayCnt2.DayCount, 360)
+
+	case DayCountACT360:
+		dayCnt2.yearFraction = big.NewRat(dayCnt2.ActualDays, 360)
+
+	case DayCountACT365F:
+		dayCnt2.yearFraction = big.NewRat(dayCnt2.ActualDays, 365)
+
+	case DayCountACTACTISDA:
+		dayCnt2.yearFraction = dayCountActActISDA(d1, d2)
+
+	case DayCountACTACTICMA:
+
+		if params.CouponFrequency < 1 || 12%params.CouponFrequency != 0 {
+			return DayCountDto{}, fmt.Errorf(ePrefix +
+				"Error: Input parameter 'params.CouponFrequency' is INVALID! " +
+				"Valid values are 1, 2, 3, 4, 6 and 12. CouponFrequency='%v'", params.CouponFrequency)
+		}
+
+		couponDate := dayCountCivilDate(endDate.DateTime, loc)
+
+		if !params.CouponReferenceDate.DateTime.IsZero() {
+			couponDate = dayCountCivilDate(params.CouponReferenceDate.DateTime, loc)
+		}
+
+		dayCnt2.yearFraction = dayCountActActICMA(d1, d2, couponDate, params.CouponFrequency)
+	}
+
+	dayCnt2.DayCount *= sign
+	dayCnt2.ActualDays *= sign
+	dayCnt2.yearFraction.Mul(dayCnt2.yearFraction, big.NewRat(sign, 1))
+
+	var err error
+
+	dayCnt2.StartDate = startDate.CopyOut()
+
+	dayCnt2.EndDate, err = DateTzDto{}.New(endDate.DateTime.In(loc), endDate.DateTimeFmt)
+
+	if err != nil {
+		return DayCountDto{},
+			fmt.Errorf(ePrefix + "Error returned by DateTzDto{}.New(endDate). Error='%v'", err.Error())
+	}
+
+	return dayCnt2, nil
+}
+
+// dayCountCivilDate - Returns the calendar date of 't' in location 'loc'
+// expressed as midnight UTC.
+func dayCountCivilDate(t time.Time, loc *time.Location) time.Time {
+
+	year, month, day := t.In(loc).Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
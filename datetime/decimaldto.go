@@ -0,0 +1,370 @@
+package datetime
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+/*
+ DecimalDto
+ ==========
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\decimaldto.go
+
+ Overview and Usage
+ ==================
+ The 'DecimalDto' Type stores an exact, fixed precision decimal
+ value. The value is stored as a signed integer containing all
+ numeric digits plus a precision specifying the number of digits
+ to the right of the decimal point. Example: 12.3450 is stored as
+ 123450 with a precision of 4.
+
+ 'DecimalDto' is used to express time durations as fractional
+ quantities of a time unit (Example: 26.5000 hours) without the
+ loss of precision associated with float64 values.
+
+ Rounding is controlled by the 'RoundingModeType' enumeration
+ which is also defined in this source file.
+
+*/
+
+// RoundingModeType - Specifies how a value is rounded to
+// a given precision or step.
+type RoundingModeType int
+
+// String - Returns a string equivalent to the
+// integer value of RoundingModeType
+func (rMode RoundingModeType) String() string {
+
+	return RoundingModeTypeLabels[rMode]
+}
+
+// Rounding Modes
+const (
+
+	// RoundHALFUP - Round to the nearest value. Ties are rounded
+	// away from zero. Example: 2.5 -> 3  -2.5 -> -3
+	RoundHALFUP RoundingModeType = iota
+
+	// RoundHALFEVEN - Round to the nearest value. Ties are rounded
+	// to the nearest even value (Banker's Rounding).
+	// Example: 2.5 -> 2  3.5 -> 4
+	RoundHALFEVEN
+
+	// RoundHALFDOWN - Round to the nearest value. Ties are rounded
+	// toward zero. Example: 2.5 -> 2  -2.5 -> -2
+	RoundHALFDOWN
+
+	// RoundTOWARDZERO - Discard the fractional part (truncate).
+	// Example: 2.7 -> 2  -2.7 -> -2
+	RoundTOWARDZERO
+
+	// RoundAWAYFROMZERO - Round away from zero.
+	// Example: 2.1 -> 3  -2.1 -> -3
+	RoundAWAYFROMZERO
+
+	// RoundFLOOR - Round toward negative infinity.
+	// Example: 2.7 -> 2  -2.1 -> -3
+	RoundFLOOR
+
+	// RoundCEILING - Round toward positive infinity.
+	// Example: 2.1 -> 3  -2.7 -> -2
+	RoundCEILING
+)
+
+// RoundingModeTypeLabels - Text Names associated with
+// RoundingModeType types.
+var RoundingModeTypeLabels = [...]string{"HalfUp", "HalfEven", "HalfDown",
+	"TowardZero", "AwayFromZero", "Floor", "Ceiling"}
+
+// DecimalDto - An exact fixed precision decimal value.
+type DecimalDto struct {
+	signedAllDigits *big.Int // All digits including fractional digits
+	precision       uint     // Number of digits to the right of the decimal point
+}
+
+// CopyOut - Returns a deep copy of the current DecimalDto
+// instance.
+func (dec *DecimalDto) CopyOut() DecimalDto {
+
+	dec2 := DecimalDto{}
+
+	dec2.signedAllDigits = big.NewInt(0)
+
+	if dec.signedAllDigits != nil {
+		dec2.signedAllDigits.Set(dec.signedAllDigits)
+	}
+
+	dec2.precision = dec.precision
+
+	return dec2
+}
+
+// Equal - Returns 'true' if the current DecimalDto and input
+// parameter 'dec2' have the same numeric value. Precision is
+// ignored. 1.50 is equal to 1.5.
+func (dec *DecimalDto) Equal(dec2 DecimalDto) bool {
+
+	return dec.GetRational().Cmp(dec2.GetRational()) == 0
+}
+
+// GetFloat64 - Returns the current value as a float64. Note that
+// float64 values may NOT exactly represent the decimal value.
+func (dec *DecimalDto) GetFloat64() float64 {
+
+	f64, _ := dec.GetRational().Float64()
+
+	return f64
+}
+
+// GetPrecision - Returns the number of digits to the right of
+// the decimal point.
+func (dec *DecimalDto) GetPrecision() uint {
+	return dec.precision
+}
+
+// GetRational - Returns the current value as a *big.Rat.
+func (dec *DecimalDto) GetRational() *big.Rat {
+
+	if dec.signedAllDigits == nil {
+		return big.NewRat(0, 1)
+	}
+
+	return new(big.Rat).SetFrac(dec.signedAllDigits, decimalScaleFactor(dec.precision))
+}
+
+// GetSignedAllDigits - Returns a signed integer containing all
+// digits of the current value. Example: 12.345 returns 12345.
+func (dec *DecimalDto) GetSignedAllDigits() *big.Int {
+
+	if dec.signedAllDigits == nil {
+		return big.NewInt(0)
+	}
+
+	return new(big.Int).Set(dec.signedAllDigits)
+}
+
+// GetSign - Returns -1 if the current value is negative, zero if
+// the value is zero and +1 if the value is positive.
+func (dec *DecimalDto) GetSign() int {
+
+	if dec.signedAllDigits == nil {
+		return 0
+	}
+
+	return dec.signedAllDigits.Sign()
+}
+
+// IsZero - Returns 'true' if the current value is zero.
+func (dec *DecimalDto) IsZero() bool {
+	return dec.GetSign() == 0
+}
+
+// NewFrac - Creates a new DecimalDto from the fraction 'numerator'
+// divided by 'denominator'. The result is rounded to 'precision'
+// fractional digits using the rounding mode 'roundMode'.
+//
+// Input Parameters:
+// =================
+//
+// numerator   *big.Int         - The numerator of the fraction
+//
+// denominator *big.Int         - The denominator of the fraction. Must
+//                                NOT be zero.
+//
+// precision   uint             - The number of digits to the right of
+//                                the decimal point
+//
+// roundMode   RoundingModeType - The rounding mode applied to digits
+//                                beyond 'precision'.
+//
+func (dec DecimalDto) NewFrac(
+	numerator, denominator *big.Int,
+	precision uint,
+	roundMode RoundingModeType) (DecimalDto, error) {
+
+	ePrefix := "DecimalDto.NewFrac() "
+
+	if denominator == nil || denominator.Sign() == 0 {
+		return DecimalDto{}, fmt.Errorf(ePrefix + "Error: Input parameter 'denominator' is zero!")
+	}
+
+	if numerator == nil {
+		numerator = big.NewInt(0)
+	}
+
+	scaledNum := new(big.Int).Mul(numerator, decimalScaleFactor(precision))
+
+	digits, err := roundBigIntQuotient(scaledNum, denominator, roundMode)
+
+	if err != nil {
+		return DecimalDto{}, fmt.Errorf(ePrefix+"%v", err.Error())
+	}
+
+	dec2 := DecimalDto{}
+	dec2.signedAllDigits = digits
+	dec2.precision = precision
+
+	return dec2, nil
+}
+
+// NewInt64 - Creates a new DecimalDto from an integer value
+// and a precision. Example: NewInt64(12345, 3) = 12.345
+func (dec DecimalDto) NewInt64(signedAllDigits int64, precision uint) DecimalDto {
+
+	dec2 := DecimalDto{}
+	dec2.signedAllDigits = big.NewInt(signedAllDigits)
+	dec2.precision = precision
+
+	return dec2
+}
+
+// NewRat - Creates a new DecimalDto from a *big.Rat value. The
+// result is rounded to 'precision' fractional digits using the
+// rounding mode 'roundMode'.
+func (dec DecimalDto) NewRat(
+	rat *big.Rat,
+	precision uint,
+	roundMode RoundingModeType) (DecimalDto, error) {
+
+	ePrefix := "DecimalDto.NewRat() "
+
+	if rat == nil {
+		return DecimalDto{}, fmt.Errorf(ePrefix + "Error: Input parameter 'rat' is nil!")
+	}
+
+	dec2, err := DecimalDto{}.NewFrac(rat.Num(), rat.Denom(), precision, roundMode)
+
+	if err != nil {
+		return DecimalDto{}, fmt.Errorf(ePrefix+"%v", err.Error())
+	}
+
+	return dec2, nil
+}
+
+// SetPrecision - Changes the precision of the current DecimalDto.
+// If the new precision is less than the existing precision, the
+// value is rounded using the rounding mode, 'roundMode'.
+func (dec *DecimalDto) SetPrecision(precision uint, roundMode RoundingModeType) error {
+
+	ePrefix := "DecimalDto.SetPrecision() "
+
+	dec2, err := DecimalDto{}.NewRat(dec.GetRational(), precision, roundMode)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"%v", err.Error())
+	}
+
+	dec.signedAllDigits = dec2.signedAllDigits
+	dec.precision = dec2.precision
+
+	return nil
+}
+
+// String - Returns the current value formatted as a number
+// string. Trailing fractional zeros are retained in accordance
+// with the precision. Example: "-26.5000"
+func (dec DecimalDto) String() string {
+
+	digits := dec.GetSignedAllDigits()
+
+	sign := ""
+
+	if digits.Sign() < 0 {
+		sign = "-"
+		digits.Neg(digits)
+	}
+
+	str := digits.String()
+
+	if dec.precision == 0 {
+		return sign + str
+	}
+
+	prec := int(dec.precision)
+
+	if len(str) <= prec {
+		str = strings.Repeat("0", prec-len(str)+1) + str
+	}
+
+	return sign + str[:len(str)-prec] + "." + str[len(str)-prec:]
+}
+
+// decimalScaleFactor - Returns 10 raised to the power of
+// 'precision'.
+func decimalScaleFactor(precision uint) *big.Int {
+
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+}
+
+// roundBigIntQuotient - Divides 'numerator' by 'denominator' and
+// rounds the quotient to an integer using rounding mode 'roundMode'.
+func roundBigIntQuotient(numerator, denominator *big.Int, roundMode RoundingModeType) (*big.Int, error) {
+
+	if denominator.Sign() == 0 {
+		return nil, fmt.Errorf("Error: Division by zero!")
+	}
+
+	num := new(big.Int).Set(numerator)
+	den := new(big.Int).Set(denominator)
+
+	if den.Sign() < 0 {
+		num.Neg(num)
+		den.Neg(den)
+	}
+
+	// Truncated division: quo is rounded toward zero and
+	// rem carries the sign of num.
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	if rem.Sign() == 0 {
+		return quo, nil
+	}
+
+	sign := num.Sign()
+
+	awayFromZero := false
+
+	// Compare 2 x |remainder| with denominator
+	twiceRem := new(big.Int).Abs(rem)
+	twiceRem.Lsh(twiceRem, 1)
+	cmpHalf := twiceRem.Cmp(den)
+
+	switch roundMode {
+
+	case RoundHALFUP:
+		awayFromZero = cmpHalf >= 0
+
+	case RoundHALFDOWN:
+		awayFromZero = cmpHalf > 0
+
+	case RoundHALFEVEN:
+		awayFromZero = cmpHalf > 0 || (cmpHalf == 0 && quo.Bit(0) == 1)
+
+	case RoundTOWARDZERO:
+		awayFromZero = false
+
+	case RoundAWAYFROMZERO:
+		awayFromZero = true
+
+	case RoundFLOOR:
+		awayFromZero = sign < 0
+
+	case RoundCEILING:
+		awayFromZero = sign > 0
+
+	default:
+		return nil, fmt.Errorf("Error: Invalid rounding mode. roundMode='%v'", int(roundMode))
+	}
+
+	if awayFromZero {
+		quo.Add(quo, big.NewInt(int64(sign)))
+	}
+
+	return quo, nil
+}
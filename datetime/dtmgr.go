@@ -137,6 +137,22 @@ func (dt DtMgr) GetDateTimeCustomFmt(t time.Time, fmt string) string {
 }
 
 
+// GetDateTimeCustomFmtLocale - Returns time string formatted
+// according to passed in format string. Month names, weekday names
+// and AM/PM markers are displayed in the language of input parameter
+// 'locale'.
+func (dt DtMgr) GetDateTimeCustomFmtLocale(t time.Time, fmt string, locale LocaleDto) string {
+	return locale.FormatDateTime(t, fmt)
+}
+
+// GetDateTimeEverythingLocale - Receives a time value and formats as
+// a date time string in the 'FmtDateTimeEverything' format. Month and
+// weekday names are displayed in the language of input parameter 'locale'.
+// EXAMPLE (French): samedi avril 29, 2017 19:54:30.123456489 -0500 CDT
+func (dt DtMgr) GetDateTimeEverythingLocale(t time.Time, locale LocaleDto) string {
+	return locale.FormatDateTime(t, FmtDateTimeEverything)
+}
+
 // GetTimeStampEverything - Generates and returns a time stamp as
 // type string. The current time is computed using time.Now() for the
 // 'Local' timezone on the host machine. The time stamp is formatted
@@ -0,0 +1,1034 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+ DurationFormatDto
+ =================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\durationformatdto.go
+
+ Overview and Usage
+ ==================
+ The 'DurationFormatDto' Type is used to format the time components
+ of a 'TimeDurationDto' as text. Output is driven by a template string
+ containing placeholders for individual time elements such as Years,
+ Months, Days, Hours etc. and for cumulative totals such as cumulative
+ days or cumulative minutes.
+
+ Placeholders
+ ============
+ Placeholders are enclosed in braces: '{Hours}'. Each placeholder is
+ replaced with the numeric value of the time element followed by
+ 'UnitSeparator' and the unit label. Example: '{Hours}' -> '13-Hours'.
+
+ Placeholder Modifiers:
+	'!'  - The element is always displayed, regardless of zero
+	       suppression. Example: '{Nanoseconds!}'
+
+	'#'  - Display the number only. The unit label is omitted.
+	       Example: '{Minutes#}'
+
+	':n' - Pad the number to a minimum width of 'n' characters
+	       using 'PadChar'. Example: '{Seconds#:2}' -> '07'
+
+ Modifiers may be combined: '{Minutes!#:2}'. Literal braces are
+ written as '{{' and '}}'.
+
+ Time Element Placeholders
+ =========================
+	{Years}             {HalfYears}       {Quarters}
+	{Months}            {Weeks}           {WeekDays}
+	{DateDays}          {Hours}           {Minutes}
+	{Seconds}           {Milliseconds}    {Microseconds}
+	{Nanoseconds}       {SubSecNanoseconds}
+
+ {HalfYears} and {Quarters} are only populated when 'LargestUnit' is
+ TimeUnitHALFYEARS or TimeUnitQUARTERS respectively.
+
+ Cumulative Total Placeholders
+ =============================
+	{CumMonths}         {CumWeeks}        {CumDays}
+	{CumHours}          {CumMinutes}      {CumSeconds}
+	{CumMilliseconds}   {CumMicroseconds} {CumNanoseconds}
+
+ Separators
+ ==========
+ Literal text appearing between two placeholders is treated as a
+ separator. A separator is only output if the placeholder which
+ precedes it is displayed and at least one following placeholder is
+ also displayed. Literal text before the first placeholder and after
+ the last placeholder is always output.
+
+*/
+
+// TDurZeroSuppressType - Specifies how time elements with a
+// zero value are suppressed by a DurationFormatDto.
+type TDurZeroSuppressType int
+
+// String - Returns a string equivalent to the
+// integer value of TDurZeroSuppressType
+func (zSuppress TDurZeroSuppressType) String() string {
+
+	return TDurZeroSuppressTypeLabels[zSuppress]
+}
+
+// Zero Suppression Types used by DurationFormatDto
+const (
+
+	// TDurZeroSuppressNONE - All time elements are displayed,
+	// including those with a zero value.
+	TDurZeroSuppressNONE TDurZeroSuppressType = iota
+
+	// TDurZeroSuppressLEADING - Time elements with a zero value
+	// are suppressed until the first displayed element is
+	// encountered. Example: '2-Hours 0-Minutes 5-Seconds'
+	TDurZeroSuppressLEADING
+
+	// TDurZeroSuppressTRAILING - Time elements with a zero value
+	// are suppressed after the last displayed element.
+	// Example: '0-Hours 3-Minutes'
+	TDurZeroSuppressTRAILING
+
+	// TDurZeroSuppressLEADINGTRAILING - Combines
+	// TDurZeroSuppressLEADING and TDurZeroSuppressTRAILING.
+	TDurZeroSuppressLEADINGTRAILING
+
+	// TDurZeroSuppressALL - All time elements with a zero
+	// value are suppressed. Example: '2-Hours 5-Seconds'
+	TDurZeroSuppressALL
+)
+
+// TDurZeroSuppressTypeLabels - Text Names associated with
+// TDurZeroSuppressType types.
+var TDurZeroSuppressTypeLabels = [...]string{"None", "Leading", "Trailing",
+	"LeadingTrailing", "All"}
+
+// PluralRuleType - Specifies the rule used to select the
+// singular or plural form of a unit label.
+type PluralRuleType int
+
+// String - Returns a string equivalent to the
+// integer value of PluralRuleType
+func (pRule PluralRuleType) String() string {
+
+	return PluralRuleTypeLabels[pRule]
+}
+
+// Plural Rule Types
+const (
+
+	// PluralRuleONESINGULAR - The singular form is used for
+	// a value of one. All other values use the plural form.
+	// Example: '1-Hour' '0-Hours' '2-Hours'
+	PluralRuleONESINGULAR PluralRuleType = iota
+
+	// PluralRuleALWAYSPLURAL - The plural form is always used.
+	// Example: '1-Hours'
+	PluralRuleALWAYSPLURAL
+
+	// PluralRuleZEROONESINGULAR - The singular form is used for
+	// values of zero and one. Used by French and Portuguese.
+	// Example: '0 heure' '1 heure' '2 heures'
+	PluralRuleZEROONESINGULAR
+
+	// PluralRuleINVARIANT - Unit labels have no plural form. The
+	// singular form is always used. Used by Japanese.
+	PluralRuleINVARIANT
+)
+
+// PluralRuleTypeLabels - Text Names associated with
+// PluralRuleType types.
+var PluralRuleTypeLabels = [...]string{"OneSingular", "AlwaysPlural", "ZeroOneSingular", "Invariant"}
+
+// DurationUnitLabelDto - Holds the singular and plural forms of
+// a unit label displayed by a DurationFormatDto.
+type DurationUnitLabelDto struct {
+	Singular string
+	Plural   string
+}
+
+// DurationFormatDto - Controls the formatting of TimeDurationDto
+// time elements. See the source file header above for a
+// description of the template syntax.
+type DurationFormatDto struct {
+	Template        string                          // Format template containing element placeholders
+	UnitSeparator   string                          // Text placed between an element value and its unit label
+	ZeroSuppression TDurZeroSuppressType            // Controls suppression of zero value elements
+	PluralRule      PluralRuleType                  // Controls selection of singular and plural unit labels
+	LargestUnit     TimeUnitType                    // Largest unit used to allocate duration. TimeUnitNONE = TimeUnitYEARS
+	SmallestUnit    TimeUnitType                    // Elements smaller than this unit are not displayed. TimeUnitNONE = TimeUnitNANOSECONDS
+	GregorianYears  bool                            // If 'true' and LargestUnit is Years, years are calculated as Gregorian Years
+	PadChar         rune                            // Character used to pad element values. Zero value = '0'
+	ZeroDurationStr string                          // If not empty, this string is returned for a zero duration
+	UnitLabels      map[string]DurationUnitLabelDto // Unit labels keyed by placeholder name. Missing entries use English defaults
+}
+
+// durFmtElementSpec - Describes a placeholder which may appear in a
+// DurationFormatDto template.
+type durFmtElementSpec struct {
+	unit TimeUnitType
+}
+
+// durFmtElements - Valid placeholder names.
+var durFmtElements = map[string]durFmtElementSpec{
+	"Years":             {TimeUnitYEARS},
+	"HalfYears":         {TimeUnitHALFYEARS},
+	"Quarters":          {TimeUnitQUARTERS},
+	"Months":            {TimeUnitMONTHS},
+	"Weeks":             {TimeUnitWEEKS},
+	"WeekDays":          {TimeUnitDAYS},
+	"DateDays":          {TimeUnitDAYS},
+	"Hours":             {TimeUnitHOURS},
+	"Minutes":           {TimeUnitMINUTES},
+	"Seconds":           {TimeUnitSECONDS},
+	"Milliseconds":      {TimeUnitMILLISECONDS},
+	"Microseconds":      {TimeUnitMICROSECONDS},
+	"Nanoseconds":       {TimeUnitNANOSECONDS},
+	"SubSecNanoseconds": {TimeUnitNANOSECONDS},
+	"CumMonths":         {TimeUnitMONTHS},
+	"CumWeeks":          {TimeUnitWEEKS},
+	"CumDays":           {TimeUnitDAYS},
+	"CumHours":          {TimeUnitHOURS},
+	"CumMinutes":        {TimeUnitMINUTES},
+	"CumSeconds":        {TimeUnitSECONDS},
+	"CumMilliseconds":   {TimeUnitMILLISECONDS},
+	"CumMicroseconds":   {TimeUnitMICROSECONDS},
+	"CumNanoseconds":    {TimeUnitNANOSECONDS},
+}
+
+// durFmtDefaultLabels - Default English unit labels keyed by
+// placeholder name.
+var durFmtDefaultLabels = map[string]DurationUnitLabelDto{
+	"Years":             {"Year", "Years"},
+	"HalfYears":         {"Half-Year", "Half-Years"},
+	"Quarters":          {"Quarter", "Quarters"},
+	"Months":            {"Month", "Months"},
+	"Weeks":             {"Week", "Weeks"},
+	"WeekDays":          {"WeekDay", "WeekDays"},
+	"DateDays":          {"Day", "Days"},
+	"Hours":             {"Hour", "Hours"},
+	"Minutes":           {"Minute", "Minutes"},
+	"Seconds":           {"Second", "Seconds"},
+	"Milliseconds":      {"Millisecond", "Milliseconds"},
+	"Microseconds":      {"Microsecond", "Microseconds"},
+	"Nanoseconds":       {"Nanosecond", "Nanoseconds"},
+	"SubSecNanoseconds": {"Nanosecond", "Nanoseconds"},
+	"CumMonths":         {"Month", "Months"},
+	"CumWeeks":          {"Week", "Weeks"},
+	"CumDays":           {"Day", "Days"},
+	"CumHours":          {"Hour", "Hours"},
+	"CumMinutes":        {"Minute", "Minutes"},
+	"CumSeconds":        {"Second", "Seconds"},
+	"CumMilliseconds":   {"Millisecond", "Milliseconds"},
+	"CumMicroseconds":   {"Microsecond", "Microseconds"},
+	"CumNanoseconds":    {"Nanosecond", "Nanoseconds"},
+}
+
+// durFmtToken - A parsed segment of a DurationFormatDto template.
+// A token is either literal text or a placeholder.
+type durFmtToken struct {
+	isLiteral bool
+	text      string
+	name      string
+	always    bool
+	numOnly   bool
+	width     int
+}
+
+// CopyOut - Returns a deep copy of the current DurationFormatDto
+// instance.
+func (durFmt *DurationFormatDto) CopyOut() DurationFormatDto {
+
+	d2Fmt := *durFmt
+
+	d2Fmt.UnitLabels = nil
+
+	if durFmt.UnitLabels != nil {
+
+		d2Fmt.UnitLabels = make(map[string]DurationUnitLabelDto, len(durFmt.UnitLabels))
+
+		for k, v := range durFmt.UnitLabels {
+			d2Fmt.UnitLabels[k] = v
+		}
+
+	}
+
+	return d2Fmt
+}
+
+// Empty - Sets all data fields of the current DurationFormatDto
+// to their zero values.
+func (durFmt *DurationFormatDto) Empty() {
+	durFmt.Template = ""
+	durFmt.UnitSeparator = ""
+	durFmt.ZeroSuppression = TDurZeroSuppressNONE
+	durFmt.PluralRule = PluralRuleONESINGULAR
+	durFmt.LargestUnit = TimeUnitNONE
+	durFmt.SmallestUnit = TimeUnitNONE
+	durFmt.GregorianYears = false
+	durFmt.PadChar = 0
+	durFmt.ZeroDurationStr = ""
+	durFmt.UnitLabels = nil
+}
+
+// IsValid - Validates the current DurationFormatDto. If the template
+// contains unknown placeholders or the largest and smallest units are
+// invalid, an error is returned.
+func (durFmt *DurationFormatDto) IsValid() error {
+
+	ePrefix := "DurationFormatDto.IsValid() "
+
+	_, err := durFmt.parseTemplate()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"%v", err.Error())
+	}
+
+	_, err = durFmt.getCalcType()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"%v", err.Error())
+	}
+
+	if durFmt.SmallestUnit != TimeUnitNONE && !durFmt.SmallestUnit.IsValid() {
+		return fmt.Errorf(ePrefix+"Error: Invalid SmallestUnit. SmallestUnit='%v'", int(durFmt.SmallestUnit))
+	}
+
+	return nil
+}
+
+// New - Creates and returns a new DurationFormatDto using the
+// template string passed as an input parameter. The unit separator
+// is set to '-', leading zero elements are suppressed and unit labels
+// are displayed in singular or plural form.
+//
+// Input Parameters:
+// =================
+//
+// template string - The format template. See the source file
+//                   header for a description of placeholders.
+//
+// Example:
+//
+//	durFmt, err := DurationFormatDto{}.New("{DateDays} {Hours} {Minutes!}")
+//
+func (durFmt DurationFormatDto) New(template string) (DurationFormatDto, error) {
+
+	ePrefix := "DurationFormatDto.New() "
+
+	d2Fmt := DurationFormatDto{}
+
+	d2Fmt.Template = template
+	d2Fmt.UnitSeparator = "-"
+	d2Fmt.ZeroSuppression = TDurZeroSuppressLEADING
+	d2Fmt.PluralRule = PluralRuleONESINGULAR
+
+	_, err := d2Fmt.parseTemplate()
+
+	if err != nil {
+		return DurationFormatDto{}, fmt.Errorf(ePrefix+"%v", err.Error())
+	}
+
+	return d2Fmt, nil
+}
+
+// NewCumDaysTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetCumDaysTimeStr().
+//
+// Example: 97-Days 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewCumDaysTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{DateDays!} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitDAYS)
+}
+
+// NewCumHalfYearsTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetCumHalfYearsTimeStr().
+//
+// Example: 2-Half-Years 5-Months 5-Days 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewCumHalfYearsTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{HalfYears!} {Months!} {DateDays!} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitHALFYEARS)
+}
+
+// NewCumHoursTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetCumHoursTimeStr().
+//
+// Example: 152-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewCumHoursTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitHOURS)
+}
+
+// NewCumMinutesFmt - Returns the predefined format used by
+// TimeDurationDto.GetCumMinutesStr().
+//
+// Example: 527-Minutes 37-Seconds 18-Milliseconds 256-Microseconds 852-Nanoseconds
+func (durFmt DurationFormatDto) NewCumMinutesFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitMINUTES)
+}
+
+// NewCumMonthsDaysTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetCumMonthsDaysTimeStr().
+//
+// Example: 39-Months 2-Days 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewCumMonthsDaysTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Months!} {DateDays!} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitMONTHS)
+}
+
+// NewCumQuartersTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetCumQuartersTimeStr().
+//
+// Example: 5-Quarters 2-Months 5-Days 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewCumQuartersTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Quarters!} {Months!} {DateDays!} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitQUARTERS)
+}
+
+// NewCumSecondsTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetCumSecondsTimeStr().
+//
+// Example: 62-Seconds 0-Milliseconds 0-Microseconds 0-Nanoseconds
+func (durFmt DurationFormatDto) NewCumSecondsTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitSECONDS)
+}
+
+// NewCumWeeksDaysTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetCumWeeksDaysTimeStr().
+//
+// Example: 126-Weeks 1-WeekDays 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewCumWeeksDaysTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Weeks!} {WeekDays!} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitWEEKS)
+}
+
+// NewElapsedMinutesFmt - Returns the predefined format used by
+// TimeDurationDto.GetElapsedMinutesStr().
+//
+// Example: 0-Minutes 0-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewElapsedMinutesFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Years} {Months} {DateDays} {Hours} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitYEARS)
+}
+
+// NewElapsedTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetElapsedTimeStr().
+//
+// Example: 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewElapsedTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Years} {Months} {DateDays} {Hours} {Minutes} {Seconds} {Milliseconds} {Microseconds} {Nanoseconds!}",
+		TimeUnitYEARS)
+}
+
+// NewGregorianYearFmt - Returns the predefined format used by
+// TimeDurationDto.GetGregorianYearDurationStr().
+//
+// Example: 3-Gregorian Years 2-Months 11-Days 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewGregorianYearFmt() DurationFormatDto {
+
+	d2Fmt := durFmt.newLegacyFmt(
+		"{Years!} {Months!} {DateDays!} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitYEARS)
+
+	d2Fmt.GregorianYears = true
+
+	d2Fmt.UnitLabels = map[string]DurationUnitLabelDto{
+		"Years": {"Gregorian Year", "Gregorian Years"},
+	}
+
+	return d2Fmt
+}
+
+// NewYearMthDaysTimeAbbrvFmt - Returns the predefined format used by
+// TimeDurationDto.GetYearMthDaysTimeAbbrvStr().
+//
+// Example: 0-Hours 0-Minutes 0-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewYearMthDaysTimeAbbrvFmt() DurationFormatDto {
+
+	return durFmt.NewYearMthDaysTimeFmt()
+}
+
+// NewYearMthDaysTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetYearMthDaysTimeStr().
+//
+// Example: 12-Years 3-Months 2-Days 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewYearMthDaysTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Years} {Months} {DateDays} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitYEARS)
+}
+
+// NewYearsMthsWeeksTimeAbbrvFmt - Returns the predefined format used by
+// TimeDurationDto.GetYearsMthsWeeksTimeAbbrvStr().
+//
+// Example: 0-Hours 0-Minutes 0-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewYearsMthsWeeksTimeAbbrvFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Years} {Months} {Weeks} {WeekDays} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitYEARS)
+}
+
+// NewYearsMthsWeeksTimeFmt - Returns the predefined format used by
+// TimeDurationDto.GetYearsMthsWeeksTimeStr().
+//
+// Example: 12-Years 3-Months 2-Weeks 1-WeekDays 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+func (durFmt DurationFormatDto) NewYearsMthsWeeksTimeFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Years} {Months} {Weeks!} {WeekDays!} {Hours!} {Minutes!} {Seconds!} {Milliseconds!} {Microseconds!} {Nanoseconds!}",
+		TimeUnitYEARS)
+}
+
+// NewYrMthWkDayHrMinSecNanosecsFmt - Returns the predefined format used by
+// TimeDurationDto.GetYrMthWkDayHrMinSecNanosecsStr().
+//
+// Example: 3-Years 2-Months 3-Weeks 2-WeekDays 13-Hours 26-Minutes 46-Seconds 864197832-Nanoseconds
+func (durFmt DurationFormatDto) NewYrMthWkDayHrMinSecNanosecsFmt() DurationFormatDto {
+
+	return durFmt.newLegacyFmt(
+		"{Years!} {Months!} {Weeks!} {WeekDays!} {Hours!} {Minutes!} {Seconds!} {SubSecNanoseconds!}",
+		TimeUnitYEARS)
+}
+
+// newLegacyFmt - Creates a DurationFormatDto configured to reproduce
+// the display strings historically generated by TimeDurationDto. Unit
+// labels are always plural and a zero duration is displayed as
+// '0-Nanoseconds'.
+func (durFmt DurationFormatDto) newLegacyFmt(template string, largestUnit TimeUnitType) DurationFormatDto {
+
+	d2Fmt := DurationFormatDto{}
+
+	d2Fmt.Template = template
+	d2Fmt.UnitSeparator = "-"
+	d2Fmt.ZeroSuppression = TDurZeroSuppressLEADING
+	d2Fmt.PluralRule = PluralRuleALWAYSPLURAL
+	d2Fmt.LargestUnit = largestUnit
+	d2Fmt.ZeroDurationStr = "0-Nanoseconds"
+
+	return d2Fmt
+}
+
+// SetLocale - Configures the current DurationFormatDto to display
+// unit labels in the language of input parameter 'locale'. The unit
+// labels, plural rule and unit separator are replaced with those of
+// the locale. If 'ZeroDurationStr' is populated, it is regenerated
+// using the locale nanoseconds label.
+func (durFmt *DurationFormatDto) SetLocale(locale LocaleDto) {
+
+	locale2 := locale.CopyOut()
+
+	durFmt.UnitLabels = locale2.DurationUnitLabels
+	durFmt.PluralRule = locale2.PluralRule
+	durFmt.UnitSeparator = locale2.UnitSeparator
+
+	if durFmt.ZeroDurationStr != "" {
+		durFmt.ZeroDurationStr = "0" + durFmt.UnitSeparator + durFmt.getUnitLabel("Nanoseconds", 0)
+	}
+
+}
+
+// Format - Formats the time duration contained in input parameter
+// 'tDur' according to the current DurationFormatDto. The time
+// duration is re-allocated using the calculation type implied by
+// 'LargestUnit'. The input TimeDurationDto is not altered.
+func (durFmt *DurationFormatDto) Format(tDur TimeDurationDto) (string, error) {
+
+	ePrefix := "DurationFormatDto.Format() "
+
+	if int64(tDur.TimeDuration) == 0 && durFmt.ZeroDurationStr != "" {
+		return durFmt.ZeroDurationStr, nil
+	}
+
+	tokens, err := durFmt.parseTemplate()
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix+"%v", err.Error())
+	}
+
+	calcType, err := durFmt.getCalcType()
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix+"%v", err.Error())
+	}
+
+	t2Dur := tDur.CopyOut()
+
+	if t2Dur.CalcType != calcType || calcType == TDurCalcTypeGregorianYrs {
+
+		err = t2Dur.ReCalcTimeDurationAllocation(calcType)
+
+		if err != nil {
+			return "", fmt.Errorf(ePrefix+
+				"Error returned by t2Dur.ReCalcTimeDurationAllocation(calcType). "+
+				"calcType='%v' Error='%v'", calcType.String(), err.Error())
+		}
+	}
+
+	smallestRank := TimeUnitNANOSECONDS.rank()
+
+	if durFmt.SmallestUnit != TimeUnitNONE {
+		smallestRank = durFmt.SmallestUnit.rank()
+	}
+
+	// Collect placeholder values and determine which
+	// placeholders are displayed.
+	phIdx := make([]int, 0, len(tokens))
+	values := make([]int64, len(tokens))
+	display := make([]bool, len(tokens))
+	dropped := make([]bool, len(tokens))
+
+	for i := 0; i < len(tokens); i++ {
+
+		if tokens[i].isLiteral {
+			continue
+		}
+
+		spec := durFmtElements[tokens[i].name]
+
+		if spec.unit.rank() < smallestRank {
+			dropped[i] = true
+			continue
+		}
+
+		values[i], err = durFmt.getElementValue(tokens[i].name, &t2Dur)
+
+		if err != nil {
+			return "", fmt.Errorf(ePrefix+"%v", err.Error())
+		}
+
+		display[i] = true
+		phIdx = append(phIdx, i)
+	}
+
+	durFmt.applyZeroSuppression(tokens, phIdx, values, display)
+
+	b := strings.Builder{}
+
+	for i := 0; i < len(tokens); i++ {
+
+		if !tokens[i].isLiteral {
+
+			if display[i] {
+				b.WriteString(durFmt.formatElement(tokens[i], values[i]))
+			}
+
+			continue
+		}
+
+		// Literal Text
+		prevPh := -1
+
+		for j := i - 1; j >= 0; j-- {
+			if !tokens[j].isLiteral {
+				prevPh = j
+				break
+			}
+		}
+
+		nextShown := false
+		hasNextPh := false
+
+		for j := i + 1; j < len(tokens); j++ {
+			if !tokens[j].isLiteral {
+				hasNextPh = true
+
+				if display[j] {
+					nextShown = true
+					break
+				}
+			}
+		}
+
+		if prevPh == -1 || !hasNextPh {
+			// Prefix or suffix text is always output
+			b.WriteString(tokens[i].text)
+			continue
+		}
+
+		if display[prevPh] && nextShown {
+			b.WriteString(tokens[i].text)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// applyZeroSuppression - Marks zero value placeholders which
+// are suppressed under the current zero suppression rule.
+func (durFmt *DurationFormatDto) applyZeroSuppression(
+	tokens []durFmtToken, phIdx []int, values []int64, display []bool) {
+
+	isSuppressible := func(idx int) bool {
+		return values[idx] == 0 && !tokens[idx].always
+	}
+
+	switch durFmt.ZeroSuppression {
+
+	case TDurZeroSuppressALL:
+
+		for _, idx := range phIdx {
+			if isSuppressible(idx) {
+				display[idx] = false
+			}
+		}
+
+	case TDurZeroSuppressLEADING, TDurZeroSuppressTRAILING, TDurZeroSuppressLEADINGTRAILING:
+
+		if durFmt.ZeroSuppression != TDurZeroSuppressTRAILING {
+
+			for _, idx := range phIdx {
+
+				if !isSuppressible(idx) {
+					break
+				}
+
+				display[idx] = false
+			}
+		}
+
+		if durFmt.ZeroSuppression != TDurZeroSuppressLEADING {
+
+			for k := len(phIdx) - 1; k >= 0; k-- {
+
+				if !isSuppressible(phIdx[k]) {
+					break
+				}
+
+				display[phIdx[k]] = false
+			}
+		}
+	}
+
+}
+
+// formatElement - Formats a single placeholder value including
+// padding and unit label.
+func (durFmt *DurationFormatDto) formatElement(token durFmtToken, value int64) string {
+
+	numStr := strconv.FormatInt(value, 10)
+
+	if token.width > 0 && len(numStr) < token.width {
+
+		padChar := durFmt.PadChar
+
+		if padChar == 0 {
+			padChar = '0'
+		}
+
+		sign := ""
+
+		if value < 0 && padChar == '0' {
+			sign = "-"
+			numStr = numStr[1:]
+		}
+
+		numStr = sign + strings.Repeat(string(padChar), token.width-len(numStr)-len(sign)) + numStr
+	}
+
+	if token.numOnly {
+		return numStr
+	}
+
+	return numStr + durFmt.UnitSeparator + durFmt.getUnitLabel(token.name, value)
+}
+
+// getCalcType - Returns the time duration calculation type
+// associated with the 'LargestUnit' setting.
+func (durFmt *DurationFormatDto) getCalcType() (TDurCalcType, error) {
+
+	switch durFmt.LargestUnit {
+
+	case TimeUnitNONE, TimeUnitYEARS:
+
+		if durFmt.GregorianYears {
+			return TDurCalcTypeGregorianYrs, nil
+		}
+
+		return TDurCalcTypeSTDYEARMTH, nil
+
+	case TimeUnitHALFYEARS:
+		return TDurCalcTypeCUMHALFYEARS, nil
+
+	case TimeUnitQUARTERS:
+		return TDurCalcTypeCUMQUARTERS, nil
+
+	case TimeUnitMONTHS:
+		return TDurCalcTypeCUMMONTHS, nil
+
+	case TimeUnitWEEKS:
+		return TDurCalcTypeCUMWEEKS, nil
+
+	case TimeUnitDAYS:
+		return TDurCalcTypeCUMDAYS, nil
+
+	case TimeUnitHOURS:
+		return TDurCalcTypeCUMHOURS, nil
+
+	case TimeUnitMINUTES:
+		return TDurCalcTypeCUMMINUTES, nil
+
+	case TimeUnitSECONDS:
+		return TDurCalcTypeCUMSECONDS, nil
+	}
+
+	return TDurCalcTypeSTDYEARMTH,
+		fmt.Errorf("Error: LargestUnit is not supported. LargestUnit='%v'", durFmt.LargestUnit.String())
+}
+
+// getElementValue - Returns the value associated with a
+// placeholder name.
+func (durFmt *DurationFormatDto) getElementValue(name string, t2Dur *TimeDurationDto) (int64, error) {
+
+	totNanosecs := int64(t2Dur.TimeDuration)
+
+	switch name {
+	case "Years":
+		return t2Dur.Years, nil
+	case "HalfYears":
+		return t2Dur.HalfYears, nil
+	case "Quarters":
+		return t2Dur.Quarters, nil
+	case "Months":
+		return t2Dur.Months, nil
+	case "Weeks":
+		return t2Dur.Weeks, nil
+	case "WeekDays":
+		return t2Dur.WeekDays, nil
+	case "DateDays":
+		return t2Dur.DateDays, nil
+	case "Hours":
+		return t2Dur.Hours, nil
+	case "Minutes":
+		return t2Dur.Minutes, nil
+	case "Seconds":
+		return t2Dur.Seconds, nil
+	case "Milliseconds":
+		return t2Dur.Milliseconds, nil
+	case "Microseconds":
+		return t2Dur.Microseconds, nil
+	case "Nanoseconds":
+		return t2Dur.Nanoseconds, nil
+	case "SubSecNanoseconds":
+		return t2Dur.TotSubSecNanoseconds, nil
+	case "CumMonths":
+
+		if totNanosecs == 0 {
+			return 0, nil
+		}
+
+		t3Dur, err := t2Dur.GetCumMonthsCalcDto()
+
+		if err != nil {
+			return 0, fmt.Errorf("Error returned by GetCumMonthsCalcDto(). Error='%v'", err.Error())
+		}
+
+		return t3Dur.Months, nil
+
+	case "CumWeeks":
+		return totNanosecs / WeekNanoSeconds, nil
+	case "CumDays":
+		return totNanosecs / DayNanoSeconds, nil
+	case "CumHours":
+		return totNanosecs / HourNanoSeconds, nil
+	case "CumMinutes":
+		return totNanosecs / MinuteNanoSeconds, nil
+	case "CumSeconds":
+		return totNanosecs / SecondNanoseconds, nil
+	case "CumMilliseconds":
+		return totNanosecs / MilliSecondNanoseconds, nil
+	case "CumMicroseconds":
+		return totNanosecs / MicroSecondNanoseconds, nil
+	case "CumNanoseconds":
+		return totNanosecs, nil
+	}
+
+	return 0, fmt.Errorf("Error: Invalid placeholder name. name='%v'", name)
+}
+
+// getUnitLabel - Returns the singular or plural unit label for
+// a placeholder name and value.
+func (durFmt *DurationFormatDto) getUnitLabel(name string, value int64) string {
+
+	label, ok := durFmt.UnitLabels[name]
+
+	if !ok {
+		label = durFmtDefaultLabels[name]
+	}
+
+	if durFmt.PluralRule.isSingular(value) {
+		return label.Singular
+	}
+
+	return label.Plural
+}
+
+// isSingular - Returns 'true' if the singular form of a
+// unit label should be used for 'value'.
+func (pRule PluralRuleType) isSingular(value int64) bool {
+
+	switch pRule {
+	case PluralRuleONESINGULAR:
+		return value == 1 || value == -1
+	case PluralRuleZEROONESINGULAR:
+		return value >= -1 && value <= 1
+	case PluralRuleINVARIANT:
+		return true
+	}
+
+	return false
+}
+
+// parseTemplate - Parses the current template string into a
+// series of literal and placeholder tokens.
+func (durFmt *DurationFormatDto) parseTemplate() ([]durFmtToken, error) {
+
+	tokens := make([]durFmtToken, 0, 20)
+
+	runes := []rune(durFmt.Template)
+	lenRunes := len(runes)
+	literal := strings.Builder{}
+
+	flushLiteral := func() {
+		if literal.Len() > 0 {
+			tokens = append(tokens, durFmtToken{isLiteral: true, text: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < lenRunes; i++ {
+
+		if runes[i] == '}' {
+
+			if i+1 < lenRunes && runes[i+1] == '}' {
+				literal.WriteRune('}')
+				i++
+				continue
+			}
+
+			return nil, fmt.Errorf("Error: Unmatched '}' in template at index %v. Template='%v'",
+				i, durFmt.Template)
+		}
+
+		if runes[i] != '{' {
+			literal.WriteRune(runes[i])
+			continue
+		}
+
+		if i+1 < lenRunes && runes[i+1] == '{' {
+			literal.WriteRune('{')
+			i++
+			continue
+		}
+
+		closeIdx := -1
+
+		for j := i + 1; j < lenRunes; j++ {
+			if runes[j] == '}' {
+				closeIdx = j
+				break
+			}
+		}
+
+		if closeIdx == -1 {
+			return nil, fmt.Errorf("Error: Unclosed placeholder in template at index %v. Template='%v'",
+				i, durFmt.Template)
+		}
+
+		token, err := durFmt.parsePlaceholder(string(runes[i+1 : closeIdx]))
+
+		if err != nil {
+			return nil, err
+		}
+
+		flushLiteral()
+
+		tokens = append(tokens, token)
+
+		i = closeIdx
+	}
+
+	flushLiteral()
+
+	return tokens, nil
+}
+
+// parsePlaceholder - Parses the text enclosed in braces and
+// returns a placeholder token.
+func (durFmt *DurationFormatDto) parsePlaceholder(phText string) (durFmtToken, error) {
+
+	token := durFmtToken{}
+
+	name := phText
+
+	if idx := strings.Index(name, ":"); idx > -1 {
+
+		width, err := strconv.Atoi(strings.TrimSpace(name[idx+1:]))
+
+		if err != nil || width < 0 {
+			return durFmtToken{},
+				fmt.Errorf("Error: Invalid placeholder width. Placeholder='{%v}'", phText)
+		}
+
+		token.width = width
+		name = name[:idx]
+	}
+
+	for len(name) > 0 {
+
+		last := name[len(name)-1]
+
+		if last == '!' {
+			token.always = true
+		} else if last == '#' {
+			token.numOnly = true
+		} else {
+			break
+		}
+
+		name = name[:len(name)-1]
+	}
+
+	name = strings.TrimSpace(name)
+
+	if _, ok := durFmtElements[name]; !ok {
+		return durFmtToken{},
+			fmt.Errorf("Error: Invalid placeholder name. Placeholder='{%v}'", phText)
+	}
+
+	token.name = name
+
+	return token, nil
+}
@@ -0,0 +1,772 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+ FiscalCalendarDto
+ =================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\fiscalcalendardto.go
+
+ Overview and Usage
+ ==================
+ The 'FiscalCalendarDto' Type maps date times to fiscal years, half-years,
+ quarters, periods and weeks and computes the boundaries of fiscal periods.
+ Two styles of fiscal calendar are supported:
+
+	(1) Calendar Month Fiscal Years - The fiscal year begins on the first
+	    day of 'StartMonth'. Each of the 12 fiscal periods is a calendar
+	    month. Example: A fiscal year starting in October.
+
+	        fCal, err := FiscalCalendarDto{}.New(time.October, FiscalYearNamedByENDYEAR)
+
+	(2) 52/53 Week Fiscal Years - The fiscal year ends on a given weekday
+	    near the end of the month preceding 'StartMonth'. Each quarter
+	    contains 13 weeks divided into three periods according to the
+	    week pattern 4-4-5, 4-5-4 or 5-4-4. In years containing 53 weeks,
+	    the extra week is added to the last period of the year.
+
+	    Example: The US National Retail Federation 4-5-4 calendar. The
+	    fiscal year ends on the Saturday nearest the end of January.
+
+	        fCal, err := FiscalCalendarDto{}.NewWeekPattern(
+	                        time.February,
+	                        FiscalWeekPattern454,
+	                        FiscalYearEndNEARESTDAYOFWEEK,
+	                        time.Saturday,
+	                        FiscalYearNamedBySTARTYEAR)
+
+ Fiscal weeks begin on the first day of the fiscal year. In calendar month
+ fiscal years, the last week of the year may contain fewer than 7 days.
+
+ Fiscal periods are selected using the 'TimeUnitType' enumeration:
+
+	TimeUnitWEEKS     - Fiscal week. 1 - 52 or 53
+	TimeUnitMONTHS    - Fiscal period. 1 - 12
+	TimeUnitQUARTERS  - Fiscal quarter. 1 - 4
+	TimeUnitHALFYEARS - Fiscal half-year. 1 - 2
+	TimeUnitYEARS     - Fiscal year. The period number is ignored.
+
+ Period boundaries are computed in a specified time zone. Each period begins
+ at local midnight on its first day. If local midnight does not exist, the
+ period begins at the first instant after the daylight savings transition.
+ Period end boundaries are exclusive; the end of one period is equal to the
+ start of the next.
+
+*/
+
+// FiscalWeekPatternType - Specifies the allocation of weeks to fiscal
+// periods within each 13 week quarter.
+type FiscalWeekPatternType int
+
+// String - Returns a string equivalent to the
+// integer value of FiscalWeekPatternType
+func (fPattern FiscalWeekPatternType) String() string {
+
+	return FiscalWeekPatternTypeLabels[fPattern]
+}
+
+// Fiscal Week Patterns
+const (
+
+	// FiscalWeekPatternNONE - Fiscal periods are calendar months
+	FiscalWeekPatternNONE FiscalWeekPatternType = iota
+
+	// FiscalWeekPattern445 - Periods of 4, 4 and 5 weeks in each quarter
+	FiscalWeekPattern445
+
+	// FiscalWeekPattern454 - Periods of 4, 5 and 4 weeks in each quarter
+	FiscalWeekPattern454
+
+	// FiscalWeekPattern544 - Periods of 5, 4 and 4 weeks in each quarter
+	FiscalWeekPattern544
+)
+
+// FiscalWeekPatternTypeLabels - Text Names associated with
+// FiscalWeekPatternType types.
+var FiscalWeekPatternTypeLabels = [...]string{"CalendarMonths", "4-4-5", "4-5-4", "5-4-4"}
+
+// FiscalYearEndRuleType - Specifies how the last day of a 52/53 week
+// fiscal year is selected.
+type FiscalYearEndRuleType int
+
+// String - Returns a string equivalent to the
+// integer value of FiscalYearEndRuleType
+func (fRule FiscalYearEndRuleType) String() string {
+
+	return FiscalYearEndRuleTypeLabels[fRule]
+}
+
+// Fiscal Year End Rules
+const (
+
+	// FiscalYearEndLASTDAYOFWEEK - The fiscal year ends on the last
+	// occurrence of the year end weekday in the year end month.
+	// Example: The last Saturday of September.
+	FiscalYearEndLASTDAYOFWEEK FiscalYearEndRuleType = iota
+
+	// FiscalYearEndNEARESTDAYOFWEEK - The fiscal year ends on the
+	// occurrence of the year end weekday nearest to the last day
+	// of the year end month. The year may end up to 3 days after
+	// the end of the month. Example: The Saturday nearest January 31st.
+	FiscalYearEndNEARESTDAYOFWEEK
+)
+
+// FiscalYearEndRuleTypeLabels - Text Names associated with
+// FiscalYearEndRuleType types.
+var FiscalYearEndRuleTypeLabels = [...]string{"LastDayOfWeek", "NearestDayOfWeek"}
+
+// FiscalYearNamingType - Specifies whether a fiscal year is named
+// for the calendar year in which it begins or ends.
+type FiscalYearNamingType int
+
+// String - Returns a string equivalent to the
+// integer value of FiscalYearNamingType
+func (fNaming FiscalYearNamingType) String() string {
+
+	return FiscalYearNamingTypeLabels[fNaming]
+}
+
+// Fiscal Year Naming Conventions
+const (
+
+	// FiscalYearNamedByENDYEAR - The fiscal year is named for the
+	// calendar year in which it ends. Example: Fiscal year 2019
+	// begins on October 1st, 2018.
+	FiscalYearNamedByENDYEAR FiscalYearNamingType = iota
+
+	// FiscalYearNamedBySTARTYEAR - The fiscal year is named for the
+	// calendar year in which it begins. Example: Fiscal year 2018
+	// begins on February 4th, 2018.
+	FiscalYearNamedBySTARTYEAR
+)
+
+// FiscalYearNamingTypeLabels - Text Names associated with
+// FiscalYearNamingType types.
+var FiscalYearNamingTypeLabels = [...]string{"NamedByEndYear", "NamedByStartYear"}
+
+// FiscalDateDto - Contains the fiscal calendar position of a date.
+type FiscalDateDto struct {
+	FiscalYear int // Fiscal Year
+	HalfYear   int // Fiscal Half-Year 1 - 2
+	Quarter    int // Fiscal Quarter 1 - 4
+	Period     int // Fiscal Period 1 - 12
+	Week       int // Fiscal Week 1 - 53
+	DayOfYear  int // Day of the fiscal year. The first day is 1.
+}
+
+// FiscalCalendarDto - Defines a fiscal calendar.
+type FiscalCalendarDto struct {
+	StartMonth     time.Month            // The month in which the fiscal year begins. For
+	                                     //   52/53 week years, the fiscal year ends near the
+	                                     //   end of the preceding month.
+	WeekPattern    FiscalWeekPatternType // FiscalWeekPatternNONE = calendar month periods
+	YearEndRule    FiscalYearEndRuleType // 52/53 week years only. Selects the year end date.
+	YearEndWeekDay time.Weekday          // 52/53 week years only. The weekday on which the
+	                                     //   fiscal year ends. Example: time.Saturday
+	YearNaming     FiscalYearNamingType  // Specifies the calendar year for which fiscal years
+	                                     //   are named.
+}
+
+// CopyOut - Returns a deep copy of the current FiscalCalendarDto.
+func (fCal *FiscalCalendarDto) CopyOut() FiscalCalendarDto {
+
+	fCal2 := FiscalCalendarDto{}
+
+	fCal2.StartMonth = fCal.StartMonth
+	fCal2.WeekPattern = fCal.WeekPattern
+	fCal2.YearEndRule = fCal.YearEndRule
+	fCal2.YearEndWeekDay = fCal.YearEndWeekDay
+	fCal2.YearNaming = fCal.YearNaming
+
+	return fCal2
+}
+
+// GetFiscalDate - Returns the fiscal year, half-year, quarter, period,
+// week and day of year for the local calendar date of input parameter
+// 'dtz'. The date is evaluated in the time zone of 'dtz'.
+//
+// Example:
+//
+//	fCal, _ := FiscalCalendarDto{}.New(time.October, FiscalYearNamedByENDYEAR)
+//	dtz = 2018-11-15 10:00:00 -0600 CST
+//	fDate, err := fCal.GetFiscalDate(dtz)
+//
+//	fDate.FiscalYear = 2019
+//	fDate.Quarter = 1
+//	fDate.Period = 2
+//
+func (fCal *FiscalCalendarDto) GetFiscalDate(dtz DateTzDto) (FiscalDateDto, error) {
+
+	ePrefix := "FiscalCalendarDto.GetFiscalDate() "
+
+	err := fCal.IsValid()
+
+	if err != nil {
+		return FiscalDateDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	if dtz.DateTime.IsZero() {
+		return FiscalDateDto{},
+			fmt.Errorf(ePrefix + "Error: Input parameter 'dtz' DateTime is a ZERO value!")
+	}
+
+	dayNum := civilDayNumber(dtz.DateTime)
+
+	startYear := dtz.DateTime.Year() + 1
+
+	for fCal.yearStartDayNumber(startYear) > dayNum {
+		startYear--
+	}
+
+	yearStart := fCal.yearStartDayNumber(startYear)
+
+	fDate := FiscalDateDto{}
+
+	fDate.FiscalYear = fCal.fiscalYearFromStartYear(startYear)
+
+	fDate.DayOfYear = int(dayNum-yearStart) + 1
+
+	fDate.Week = int((dayNum-yearStart)/7) + 1
+
+	for period := 12; period >= 1; period-- {
+
+		if fCal.periodStartDayNumber(startYear, period) <= dayNum {
+			fDate.Period = period
+			break
+		}
+	}
+
+	fDate.Quarter = (fDate.Period-1)/3 + 1
+
+	fDate.HalfYear = (fDate.Period-1)/6 + 1
+
+	return fDate, nil
+}
+
+// GetBoundaries - Returns the starting and ending date times of a fiscal
+// week, period, quarter, half-year or year in the specified time zone. The
+// ending date time is exclusive and is equal to the starting date time of
+// the following fiscal period.
+//
+// Input Parameters:
+// =================
+//
+// fiscalYear       int          - The fiscal year
+//
+// unit             TimeUnitType - The fiscal period type. Valid values are:
+//                                   TimeUnitWEEKS     - Week 1 - 52 or 53
+//                                   TimeUnitMONTHS    - Period 1 - 12
+//                                   TimeUnitQUARTERS  - Quarter 1 - 4
+//                                   TimeUnitHALFYEARS - Half-Year 1 - 2
+//                                   TimeUnitYEARS     - Year. 'number' is ignored.
+//
+// number           int          - The number of the week, period, quarter or
+//                                 half-year within the fiscal year.
+//
+// timeZoneLocation string       - The time zone in which boundaries are computed.
+//                                 Example: "America/Chicago". If 'timeZoneLocation'
+//                                 is submitted as an empty string, it will default
+//                                 to "Etc/UTC".
+//
+// dateTimeFmtStr   string       - A date time format string applied to the returned
+//                                 DateTzDto values. If 'dateTimeFmtStr' is submitted
+//                                 as an empty string, FmtDateTimeYrMDayFmtStr is used.
+//
+// Example:
+//
+//	fCal, _ := FiscalCalendarDto{}.New(time.October, FiscalYearNamedByENDYEAR)
+//	startDtz, endDtz, err := fCal.GetBoundaries(2019, TimeUnitQUARTERS, 2,
+//	                             TzIanaUsCentral, FmtDateTimeYrMDayFmtStr)
+//
+//	startDtz = 2019-01-01 00:00:00.000000000 -0600 CST
+//	endDtz   = 2019-04-01 00:00:00.000000000 -0500 CDT
+//
+func (fCal *FiscalCalendarDto) GetBoundaries(
+	fiscalYear int,
+	unit TimeUnitType,
+	number int,
+	timeZoneLocation,
+	dateTimeFmtStr string) (startDtz, endDtz DateTzDto, err error) {
+
+	ePrefix := "FiscalCalendarDto.GetBoundaries() "
+
+	err = fCal.IsValid()
+
+	if err != nil {
+		err = fmt.Errorf(ePrefix + "%v", err.Error())
+		return
+	}
+
+	tzl := timeZoneLocation
+
+	if len(tzl) == 0 {
+		tzl = TzIanaUTC
+	}
+
+	loc, err2 := time.LoadLocation(tzl)
+
+	if err2 != nil {
+		err = fmt.Errorf(ePrefix +
+			"Error: 'timeZoneLocation' input parameter is INVALID! " +
+			"timeZoneLocation='%v' Error='%v'", timeZoneLocation, err2.Error())
+		return
+	}
+
+	startDtz, endDtz, err2 = fCal.getBoundaries(fiscalYear, unit, number, loc, dateTimeFmtStr)
+
+	if err2 != nil {
+		err = fmt.Errorf(ePrefix + "%v", err2.Error())
+		return
+	}
+
+	return
+}
+
+// GetBoundariesForDate - Returns the starting and ending date times of
+// the fiscal week, period, quarter, half-year or year containing input
+// parameter 'dtz'. Boundaries are computed in the time zone of 'dtz' and
+// formatted with the 'dtz' date time format. The ending date time is
+// exclusive and is equal to the starting date time of the following
+// fiscal period.
+//
+// See method GetBoundaries() for valid values of 'unit'.
+//
+func (fCal *FiscalCalendarDto) GetBoundariesForDate(
+	dtz DateTzDto,
+	unit TimeUnitType) (startDtz, endDtz DateTzDto, err error) {
+
+	ePrefix := "FiscalCalendarDto.GetBoundariesForDate() "
+
+	fDate, err2 := fCal.GetFiscalDate(dtz)
+
+	if err2 != nil {
+		err = fmt.Errorf(ePrefix + "%v", err2.Error())
+		return
+	}
+
+	number := 1
+
+	switch unit {
+	case TimeUnitWEEKS:
+		number = fDate.Week
+	case TimeUnitMONTHS:
+		number = fDate.Period
+	case TimeUnitQUARTERS:
+		number = fDate.Quarter
+	case TimeUnitHALFYEARS:
+		number = fDate.HalfYear
+	}
+
+	startDtz, endDtz, err2 = fCal.getBoundaries(fDate.FiscalYear, unit, number,
+		dtz.DateTime.Location(), dtz.DateTimeFmt)
+
+	if err2 != nil {
+		err = fmt.Errorf(ePrefix + "%v", err2.Error())
+		return
+	}
+
+	return
+}
+
+// GetTimeDuration - Returns a TimeDurationDto spanning an entire fiscal
+// week, period, quarter, half-year or year. The duration begins at the
+// start of the fiscal period and ends at the start of the following
+// fiscal period.
+//
+// Input parameters 'fiscalYear', 'unit', 'number', 'timeZoneLocation' and
+// 'dateTimeFmtStr' are described in method GetBoundaries(). Input parameter
+// 'tDurCalcType' specifies the time duration allocation. Example:
+// TDurCalcTypeCUMDAYS.
+//
+// Example:
+//
+//	fCal, _ := FiscalCalendarDto{}.New(time.October, FiscalYearNamedByENDYEAR)
+//	tDur, err := fCal.GetTimeDuration(2019, TimeUnitQUARTERS, 1, TDurCalcTypeCUMDAYS,
+//	                TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+//
+//	tDur.GetCumDaysTimeStr() = "92-Days 0-Hours 0-Minutes 0-Seconds 0-Milliseconds 0-Microseconds 0-Nanoseconds"
+//
+func (fCal *FiscalCalendarDto) GetTimeDuration(
+	fiscalYear int,
+	unit TimeUnitType,
+	number int,
+	tDurCalcType TDurCalcType,
+	timeZoneLocation,
+	dateTimeFmtStr string) (TimeDurationDto, error) {
+
+	ePrefix := "FiscalCalendarDto.GetTimeDuration() "
+
+	startDtz, endDtz, err := fCal.GetBoundaries(fiscalYear, unit, number, timeZoneLocation, dateTimeFmtStr)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(
+		startDtz.DateTime,
+		endDtz.DateTime,
+		tDurCalcType,
+		startDtz.TimeZone.LocationName,
+		dateTimeFmtStr)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix +
+			"Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+	}
+
+	return tDur, nil
+}
+
+// GetWeeksInYear - Returns the number of fiscal weeks in a fiscal
+// year. 52/53 week fiscal years return 52 or 53. Calendar month
+// fiscal years return 53, or 54 in leap years, because the last
+// fiscal week may be a partial week.
+func (fCal *FiscalCalendarDto) GetWeeksInYear(fiscalYear int) int {
+
+	startYear := fCal.startYearFromFiscalYear(fiscalYear)
+
+	days := fCal.yearStartDayNumber(startYear+1) - fCal.yearStartDayNumber(startYear)
+
+	return int((days + 6) / 7)
+}
+
+// IsValid - Returns an error if the current FiscalCalendarDto
+// is invalid.
+func (fCal *FiscalCalendarDto) IsValid() error {
+
+	ePrefix := "FiscalCalendarDto.IsValid() "
+
+	if fCal.StartMonth < time.January || fCal.StartMonth > time.December {
+		return fmt.Errorf(ePrefix + "Error: Invalid StartMonth. StartMonth='%v'", int(fCal.StartMonth))
+	}
+
+	if fCal.WeekPattern < FiscalWeekPatternNONE || fCal.WeekPattern > FiscalWeekPattern544 {
+		return fmt.Errorf(ePrefix + "Error: Invalid WeekPattern. WeekPattern='%v'", int(fCal.WeekPattern))
+	}
+
+	if fCal.YearNaming < FiscalYearNamedByENDYEAR || fCal.YearNaming > FiscalYearNamedBySTARTYEAR {
+		return fmt.Errorf(ePrefix + "Error: Invalid YearNaming. YearNaming='%v'", int(fCal.YearNaming))
+	}
+
+	if fCal.WeekPattern == FiscalWeekPatternNONE {
+		return nil
+	}
+
+	if fCal.YearEndRule < FiscalYearEndLASTDAYOFWEEK || fCal.YearEndRule > FiscalYearEndNEARESTDAYOFWEEK {
+		return fmt.Errorf(ePrefix + "Error: Invalid YearEndRule. YearEndRule='%v'", int(fCal.YearEndRule))
+	}
+
+	if fCal.YearEndWeekDay < time.Sunday || fCal.YearEndWeekDay > time.Saturday {
+		return fmt.Errorf(ePrefix + "Error: Invalid YearEndWeekDay. YearEndWeekDay='%v'",
+			int(fCal.YearEndWeekDay))
+	}
+
+	return nil
+}
+
+// New - Creates a new FiscalCalendarDto in which each fiscal period is a
+// calendar month and the fiscal year begins on the first day of
+// 'startMonth'.
+//
+// Input Parameters:
+// =================
+//
+// startMonth time.Month           - The month in which the fiscal year begins.
+//                                   Example: time.October
+//
+// yearNaming FiscalYearNamingType - Specifies whether fiscal years are named for
+//                                   the calendar year in which they begin or end.
+//
+// Example:
+//
+//	US Federal Government fiscal year. Fiscal year 2019 begins on October 1st, 2018.
+//
+//	fCal, err := FiscalCalendarDto{}.New(time.October, FiscalYearNamedByENDYEAR)
+//
+func (fCal FiscalCalendarDto) New(
+	startMonth time.Month,
+	yearNaming FiscalYearNamingType) (FiscalCalendarDto, error) {
+
+	ePrefix := "FiscalCalendarDto.New() "
+
+	fCal2 := FiscalCalendarDto{}
+
+	fCal2.StartMonth = startMonth
+	fCal2.WeekPattern = FiscalWeekPatternNONE
+	fCal2.YearEndRule = FiscalYearEndLASTDAYOFWEEK
+	fCal2.YearEndWeekDay = time.Saturday
+	fCal2.YearNaming = yearNaming
+
+	err := fCal2.IsValid()
+
+	if err != nil {
+		return FiscalCalendarDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return fCal2, nil
+}
+
+// NewWeekPattern - Creates a new FiscalCalendarDto for a 52/53 week fiscal
+// year. The fiscal year ends on 'yearEndWeekDay' near the end of the month
+// preceding 'startMonth' and the weeks of each quarter are allocated to
+// periods using 'weekPattern'.
+//
+// Input Parameters:
+// =================
+//
+// startMonth     time.Month            - The month in which the fiscal year nominally
+//                                        begins. The previous fiscal year ends in, or
+//                                        shortly after, the preceding month.
+//
+// weekPattern    FiscalWeekPatternType - FiscalWeekPattern445, FiscalWeekPattern454
+//                                        or FiscalWeekPattern544
+//
+// yearEndRule    FiscalYearEndRuleType - FiscalYearEndLASTDAYOFWEEK or
+//                                        FiscalYearEndNEARESTDAYOFWEEK
+//
+// yearEndWeekDay time.Weekday          - The weekday on which fiscal years end.
+//                                        Example: time.Saturday
+//
+// yearNaming     FiscalYearNamingType  - Specifies whether fiscal years are named for
+//                                        the calendar year in which they begin or end.
+//
+// Example:
+//
+//	Fiscal year ending on the last Saturday of September, 4-4-5 periods.
+//
+//	fCal, err := FiscalCalendarDto{}.NewWeekPattern(time.October, FiscalWeekPattern445,
+//	                FiscalYearEndLASTDAYOFWEEK, time.Saturday, FiscalYearNamedByENDYEAR)
+//
+func (fCal FiscalCalendarDto) NewWeekPattern(
+	startMonth time.Month,
+	weekPattern FiscalWeekPatternType,
+	yearEndRule FiscalYearEndRuleType,
+	yearEndWeekDay time.Weekday,
+	yearNaming FiscalYearNamingType) (FiscalCalendarDto, error) {
+
+	ePrefix := "FiscalCalendarDto.NewWeekPattern() "
+
+	if weekPattern == FiscalWeekPatternNONE {
+		return FiscalCalendarDto{},
+			fmt.Errorf(ePrefix + "Error: 'weekPattern' must specify a 52/53 week pattern. " +
+				"Use FiscalCalendarDto{}.New() for calendar month fiscal years.")
+	}
+
+	fCal2 := FiscalCalendarDto{}
+
+	fCal2.StartMonth = startMonth
+	fCal2.WeekPattern = weekPattern
+	fCal2.YearEndRule = yearEndRule
+	fCal2.YearEndWeekDay = yearEndWeekDay
+	fCal2.YearNaming = yearNaming
+
+	err := fCal2.IsValid()
+
+	if err != nil {
+		return FiscalCalendarDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return fCal2, nil
+}
+
+// boundaryDayNumbers - Returns the civil day numbers of the first day
+// of a fiscal period and the first day of the following fiscal period.
+func (fCal *FiscalCalendarDto) boundaryDayNumbers(
+	fiscalYear int,
+	unit TimeUnitType,
+	number int) (startDay, endDay int64, err error) {
+
+	startYear := fCal.startYearFromFiscalYear(fiscalYear)
+
+	switch unit {
+
+	case TimeUnitWEEKS:
+
+		if number < 1 || number > fCal.GetWeeksInYear(fiscalYear) {
+			err = fmt.Errorf("Error: Fiscal week is out of range. fiscalYear='%v' week='%v'",
+				fiscalYear, number)
+			return
+		}
+
+		yearStart := fCal.yearStartDayNumber(startYear)
+		nextYearStart := fCal.yearStartDayNumber(startYear + 1)
+
+		startDay = yearStart + int64(number-1)*7
+		endDay = startDay + 7
+
+		if endDay > nextYearStart {
+			endDay = nextYearStart
+		}
+
+		return
+
+	case TimeUnitMONTHS, TimeUnitQUARTERS, TimeUnitHALFYEARS:
+
+		periods := unit.calendarMonths()
+
+		if number < 1 || number > 12/periods {
+			err = fmt.Errorf("Error: Fiscal %v number is out of range. number='%v'",
+				unit.String(), number)
+			return
+		}
+
+		startDay = fCal.periodStartDayNumber(startYear, (number-1)*periods+1)
+		endDay = fCal.periodStartDayNumber(startYear, number*periods+1)
+
+		return
+
+	case TimeUnitYEARS:
+
+		startDay = fCal.yearStartDayNumber(startYear)
+		endDay = fCal.yearStartDayNumber(startYear + 1)
+
+		return
+	}
+
+	err = fmt.Errorf("Error: Time unit is not a fiscal period. unit='%v'", unit.String())
+
+	return
+}
+
+// fiscalYearFromStartYear - Returns the fiscal year name for the
+// fiscal year which nominally begins in calendar year 'startYear'.
+func (fCal *FiscalCalendarDto) fiscalYearFromStartYear(startYear int) int {
+
+	if fCal.YearNaming == FiscalYearNamedByENDYEAR && fCal.StartMonth != time.January {
+		return startYear + 1
+	}
+
+	return startYear
+}
+
+// getBoundaries - Returns the starting and ending date times of a fiscal
+// period in location 'loc'. See method GetBoundaries().
+func (fCal *FiscalCalendarDto) getBoundaries(
+	fiscalYear int,
+	unit TimeUnitType,
+	number int,
+	loc *time.Location,
+	dateTimeFmtStr string) (startDtz, endDtz DateTzDto, err error) {
+
+	startDay, endDay, err := fCal.boundaryDayNumbers(fiscalYear, unit, number)
+
+	if err != nil {
+		return
+	}
+
+	y, m, d := civilDate(startDay)
+
+	startDtz, err = DateTzDto{}.New(startOfLocalDay(y, m, d, loc), dateTimeFmtStr)
+
+	if err != nil {
+		err = fmt.Errorf("Error returned by DateTzDto{}.New(start). Error='%v'", err.Error())
+		return
+	}
+
+	y, m, d = civilDate(endDay)
+
+	endDtz, err = DateTzDto{}.New(startOfLocalDay(y, m, d, loc), dateTimeFmtStr)
+
+	if err != nil {
+		err = fmt.Errorf("Error returned by DateTzDto{}.New(end). Error='%v'", err.Error())
+		return
+	}
+
+	return
+}
+
+// periodStartDayNumber - Returns the civil day number of the first day
+// of fiscal period 'period' (1-13) for the fiscal year which nominally
+// begins in calendar year 'startYear'. Period 13 is the first day of
+// the following fiscal year.
+func (fCal *FiscalCalendarDto) periodStartDayNumber(startYear, period int) int64 {
+
+	if period >= 13 {
+		return fCal.yearStartDayNumber(startYear + 1)
+	}
+
+	if fCal.WeekPattern == FiscalWeekPatternNONE {
+
+		return civilDayNumber(time.Date(startYear, fCal.StartMonth+time.Month(period-1), 1,
+			0, 0, 0, 0, time.UTC))
+	}
+
+	var pattern [3]int64
+
+	switch fCal.WeekPattern {
+	case FiscalWeekPattern445:
+		pattern = [3]int64{4, 4, 5}
+	case FiscalWeekPattern454:
+		pattern = [3]int64{4, 5, 4}
+	default:
+		pattern = [3]int64{5, 4, 4}
+	}
+
+	weeks := int64(0)
+
+	for p := 1; p < period; p++ {
+		weeks += pattern[(p-1)%3]
+	}
+
+	return fCal.yearStartDayNumber(startYear) + weeks*7
+}
+
+// startYearFromFiscalYear - Returns the calendar year in which fiscal
+// year 'fiscalYear' nominally begins.
+func (fCal *FiscalCalendarDto) startYearFromFiscalYear(fiscalYear int) int {
+
+	if fCal.YearNaming == FiscalYearNamedByENDYEAR && fCal.StartMonth != time.January {
+		return fiscalYear - 1
+	}
+
+	return fiscalYear
+}
+
+// yearEndDayNumber - Returns the civil day number of the last day of
+// a 52/53 week fiscal year ending near the end of 'month' in calendar
+// year 'year'.
+func (fCal *FiscalCalendarDto) yearEndDayNumber(year int, month time.Month) int64 {
+
+	// Last day of 'month'
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+
+	lastDayNum := civilDayNumber(lastDay)
+
+	if fCal.YearEndRule == FiscalYearEndNEARESTDAYOFWEEK {
+
+		daysAhead := (int64(fCal.YearEndWeekDay) - int64(lastDay.Weekday()) + 7) % 7
+
+		if daysAhead <= 3 {
+			return lastDayNum + daysAhead
+		}
+
+		return lastDayNum + daysAhead - 7
+	}
+
+	daysBack := (int64(lastDay.Weekday()) - int64(fCal.YearEndWeekDay) + 7) % 7
+
+	return lastDayNum - daysBack
+}
+
+// yearStartDayNumber - Returns the civil day number of the first day
+// of the fiscal year which nominally begins in calendar year 'startYear'.
+func (fCal *FiscalCalendarDto) yearStartDayNumber(startYear int) int64 {
+
+	if fCal.WeekPattern == FiscalWeekPatternNONE {
+		return civilDayNumber(time.Date(startYear, fCal.StartMonth, 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	// The previous fiscal year ends in the month preceding StartMonth.
+	// time.Date() normalizes month zero to December of the prior year.
+	priorEnd := time.Date(startYear, fCal.StartMonth-1, 1, 0, 0, 0, 0, time.UTC)
+
+	return fCal.yearEndDayNumber(priorEnd.Year(), priorEnd.Month()) + 1
+}
@@ -486,6 +486,14 @@ func (dtf *FormatDateTimeUtility) Empty() {
 // the time string to a valid time.Time value, this method will run the date time
 // string against 1.4-million possible date time string formats in an effort to
 // successfully convert the date time string into a valid time.Time value.
+//
+// ISO 8601 week dates (Example: "2026-W42-5") and ordinal dates (Example:
+// "2026-290") are recognized before any format is applied. These may be
+// followed by 'T' or a space and a time of day. Example: "2026-W42-5T08:30:00Z".
+// See source file 'isodateutility.go' for the supported forms.
+//
+// Relative expressions such as "tomorrow at noon" or "in 90 minutes" are
+// not parsed by this method. See Type RelativeDateExpressionDto.
 func (dtf *FormatDateTimeUtility) ParseDateTimeString(dateTimeStr string, probableFormat string) (time.Time, error) {
 
 	if dateTimeStr == "" {
@@ -494,7 +502,31 @@ func (dtf *FormatDateTimeUtility) ParseDateTimeString(dateTimeStr string, probab
 
 	dtf.Empty()
 
-	xtimeStr := dtf.replaceMultipleStrSequence(dateTimeStr, dtf.FormatSearchReplaceStrs.PreTrimSearchStrs)
+	// ISO 8601 week dates ("2026-W42-5") and ordinal dates ("2026-290")
+	// are not generated by the format maps. Test for these forms first.
+	isoTimeStr := strings.TrimSpace(dateTimeStr)
+
+	t, isoFormName, isIsoForm, err := parseISOWeekOrdinalDateStr(isoTimeStr)
+
+	if isIsoForm {
+
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		dtf.SelectedFormat = isoFormName
+		dtf.SelectedFormatSource = "ISO 8601"
+		dtf.SelectedMapIdx = -1
+		dtf.DateTimeOut = t
+		dtf.OriginalDateTimeStringIn = dateTimeStr
+		dtf.FormattedDateTimeStringIn = isoTimeStr
+		dtf.TotalNoOfDictSearches = 1
+		dtf.DictSearches = append(dtf.DictSearches, [][]int{{0, 1}})
+
+		return t, nil
+	}
+
+	xtimeStr :=dtf.replaceMultipleStrSequence(dateTimeStr, dtf.FormatSearchReplaceStrs.PreTrimSearchStrs)
 	xtimeStr = dtf.replaceDateSuffixStThRd(xtimeStr)
 	xtimeStr = dtf.reformatSingleTimeString(xtimeStr, dtf.FormatSearchReplaceStrs.TimeFmtRegEx)
 	xtimeStr = dtf.replaceAMPM(xtimeStr)
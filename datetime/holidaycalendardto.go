@@ -0,0 +1,921 @@
+package datetime
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+ HolidayCalendarDto
+ ==================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\holidaycalendardto.go
+
+ Overview and Usage
+ ==================
+ The 'HolidayCalendarDto' Type computes holidays from a list of rules.
+ 'HolidayCalendarDto' implements the 'HolidayCalendar' interface and may
+ be used with 'BusinessCalendarDto' in business day calculations.
+
+ Rule Types ('HolidayRuleType'):
+
+	HolidayRuleFIXEDDATE      - The same month and day each year.
+	                            Example: December 25th
+	HolidayRuleNTHWEEKDAY     - The nth or last weekday of a month.
+	                            Example: The 4th Thursday of November
+	HolidayRuleEASTER         - A number of days before or after Western
+	                            (Gregorian) Easter Sunday. Example: Good Friday
+	HolidayRuleORTHODOXEASTER - A number of days before or after Orthodox
+	                            (Julian computus) Easter Sunday.
+	HolidayRuleONEOFF         - A single date in a single year. Used for
+	                            special holidays and for holidays based on
+	                            lunar calendars which are published annually.
+
+ Observed Policies ('HolidayObservedType'):
+
+	HolidayObservedNONE           - The holiday is observed on its actual date.
+	HolidayObservedNEARESTWEEKDAY - Saturday holidays are observed on Friday.
+	                                Sunday holidays are observed on Monday.
+	HolidayObservedNEXTWEEKDAY    - Saturday and Sunday holidays are observed on
+	                                the following Monday. If Monday is already a
+	                                holiday, the next free weekday is used.
+	HolidayObservedSUNDAYTOMONDAY - Sunday holidays are observed on the following
+	                                Monday. If Monday is already a holiday, the
+	                                next free weekday is used.
+
+ Observed policies assume a Saturday and Sunday weekend.
+
+ Predefined calendars are provided for the United States (federal), the
+ United Kingdom (England and Wales bank holidays), Germany (national plus
+ optional state holidays) and India (national gazetted holidays with fixed
+ or Easter based dates). Calendars are composed with 'NewComposite()'.
+
+	usCal := HolidayCalendarDto{}.NewUS()
+	dtz, _ := DateTzDto{}.NewDateTimeElements(2018, 7, 4, 0, 0, 0, 0, TzIanaUsCentral, "")
+	isHoliday := usCal.IsHolidayDate(dtz)  // true
+
+ Calendars may also be loaded from JSON or YAML style rule files. See
+ source file 'holidayrulefileutility.go'.
+
+*/
+
+// HolidayRuleType - Specifies how the date of a holiday is computed.
+type HolidayRuleType int
+
+// String - Returns a string equivalent to the
+// integer value of HolidayRuleType
+func (hRule HolidayRuleType) String() string {
+
+	return HolidayRuleTypeLabels[hRule]
+}
+
+// Holiday Rule Types
+const (
+
+	// HolidayRuleFIXEDDATE - The same month and day each year
+	HolidayRuleFIXEDDATE HolidayRuleType = iota
+
+	// HolidayRuleNTHWEEKDAY - The nth or last weekday of a month
+	HolidayRuleNTHWEEKDAY
+
+	// HolidayRuleEASTER - Days relative to Western (Gregorian) Easter Sunday
+	HolidayRuleEASTER
+
+	// HolidayRuleORTHODOXEASTER - Days relative to Orthodox Easter Sunday
+	HolidayRuleORTHODOXEASTER
+
+	// HolidayRuleONEOFF - A single date in a single year
+	HolidayRuleONEOFF
+)
+
+// HolidayRuleTypeLabels - Text Names associated with HolidayRuleType types.
+var HolidayRuleTypeLabels = [...]string{"Fixed", "NthWeekday", "Easter", "OrthodoxEaster", "OneOff"}
+
+// HolidayObservedType - Specifies the day on which a holiday falling
+// on a weekend is observed.
+type HolidayObservedType int
+
+// String - Returns a string equivalent to the
+// integer value of HolidayObservedType
+func (hObserved HolidayObservedType) String() string {
+
+	return HolidayObservedTypeLabels[hObserved]
+}
+
+// Holiday Observed Policies
+const (
+
+	// HolidayObservedNONE - Observed on the actual date
+	HolidayObservedNONE HolidayObservedType = iota
+
+	// HolidayObservedNEARESTWEEKDAY - Saturday to Friday, Sunday to Monday
+	HolidayObservedNEARESTWEEKDAY
+
+	// HolidayObservedNEXTWEEKDAY - Saturday and Sunday to the next free weekday
+	HolidayObservedNEXTWEEKDAY
+
+	// HolidayObservedSUNDAYTOMONDAY - Sunday to the next free weekday
+	HolidayObservedSUNDAYTOMONDAY
+)
+
+// HolidayObservedTypeLabels - Text Names associated with
+// HolidayObservedType types.
+var HolidayObservedTypeLabels = [...]string{"None", "NearestWeekday", "NextWeekday", "SundayToMonday"}
+
+// HolidayRuleDto - Defines a single holiday rule.
+type HolidayRuleDto struct {
+	Name      string              // Holiday name. Example: "Christmas Day"
+	RuleType  HolidayRuleType     // Specifies how the holiday date is computed
+	Month     int                 // Month 1-12. Fixed, NthWeekday and OneOff rules.
+	Day       int                 // Day of month. Fixed and OneOff rules. NthWeekday
+	                              //   rules: optional anchor day. If non-zero, counting
+	                              //   starts on this day instead of the first or last
+	                              //   day of the month.
+	WeekDay   time.Weekday        // Day of week. NthWeekday rules.
+	Nth       int                 // 1-5 = nth weekday of month. -1 = last, -2 = second to
+	                              //   last. NthWeekday rules.
+	Offset    int                 // Days added to the computed date. Easter rules:
+	                              //   Good Friday = -2. May be used with any rule type.
+	Year      int                 // The year of a OneOff rule
+	StartYear int                 // First year in which the rule applies. Zero = no limit.
+	EndYear   int                 // Last year in which the rule applies. Zero = no limit.
+	Observed  HolidayObservedType // Observed policy for weekend holidays
+}
+
+// GetDate - Returns the actual date of the holiday in 'year'. The
+// boolean return value is 'false' if the holiday does not occur in
+// 'year'.
+func (hRule *HolidayRuleDto) GetDate(year int) (int, time.Month, int, bool) {
+
+	if hRule.StartYear != 0 && year < hRule.StartYear {
+		return 0, 0, 0, false
+	}
+
+	if hRule.EndYear != 0 && year > hRule.EndYear {
+		return 0, 0, 0, false
+	}
+
+	var d time.Time
+
+	switch hRule.RuleType {
+
+	case HolidayRuleFIXEDDATE:
+
+		d = time.Date(year, time.Month(hRule.Month), hRule.Day, 0, 0, 0, 0, time.UTC)
+
+		if d.Day() != hRule.Day {
+			// Example: February 29th in a non-leap year
+			return 0, 0, 0, false
+		}
+
+	case HolidayRuleNTHWEEKDAY:
+
+		if hRule.Nth > 0 {
+
+			firstDay := 1
+
+			if hRule.Day > 0 {
+				firstDay = hRule.Day
+			}
+
+			first := time.Date(year, time.Month(hRule.Month), firstDay, 0, 0, 0, 0, time.UTC)
+
+			daysAhead := (int(hRule.WeekDay) - int(first.Weekday()) + 7) % 7
+
+			d = first.AddDate(0, 0, daysAhead+(hRule.Nth-1)*7)
+
+		} else {
+
+			last := time.Date(year, time.Month(hRule.Month)+1, 0, 0, 0, 0, 0, time.UTC)
+
+			if hRule.Day > 0 && hRule.Day < last.Day() {
+				last = time.Date(year, time.Month(hRule.Month), hRule.Day, 0, 0, 0, 0, time.UTC)
+			}
+
+			daysBack := (int(last.Weekday()) - int(hRule.WeekDay) + 7) % 7
+
+			d = last.AddDate(0, 0, -daysBack+(hRule.Nth+1)*7)
+		}
+
+		if d.Month() != time.Month(hRule.Month) {
+			return 0, 0, 0, false
+		}
+
+	case HolidayRuleEASTER:
+
+		m, day := gregorianEasterDate(year)
+		d = time.Date(year, m, day, 0, 0, 0, 0, time.UTC)
+
+	case HolidayRuleORTHODOXEASTER:
+
+		m, day := orthodoxEasterDate(year)
+		d = time.Date(year, m, day, 0, 0, 0, 0, time.UTC)
+
+	case HolidayRuleONEOFF:
+
+		if year != hRule.Year {
+			return 0, 0, 0, false
+		}
+
+		d = time.Date(year, time.Month(hRule.Month), hRule.Day, 0, 0, 0, 0, time.UTC)
+
+	default:
+		return 0, 0, 0, false
+	}
+
+	if hRule.Offset != 0 {
+		d = d.AddDate(0, 0, hRule.Offset)
+	}
+
+	return d.Year(), d.Month(), d.Day(), true
+}
+
+// IsValid - Returns an error if the current HolidayRuleDto is invalid.
+func (hRule *HolidayRuleDto) IsValid() error {
+
+	ePrefix := "HolidayRuleDto.IsValid() "
+
+	if len(hRule.Name) == 0 {
+		return fmt.Errorf(ePrefix + "Error: Holiday rule Name is empty!")
+	}
+
+	if hRule.RuleType < HolidayRuleFIXEDDATE || hRule.RuleType > HolidayRuleONEOFF {
+		return fmt.Errorf(ePrefix + "Error: Invalid RuleType. Name='%v' RuleType='%v'",
+			hRule.Name, int(hRule.RuleType))
+	}
+
+	if hRule.Observed < HolidayObservedNONE || hRule.Observed > HolidayObservedSUNDAYTOMONDAY {
+		return fmt.Errorf(ePrefix + "Error: Invalid Observed policy. Name='%v' Observed='%v'",
+			hRule.Name, int(hRule.Observed))
+	}
+
+	switch hRule.RuleType {
+
+	case HolidayRuleFIXEDDATE, HolidayRuleONEOFF, HolidayRuleNTHWEEKDAY:
+
+		if hRule.Month < 1 || hRule.Month > 12 {
+			return fmt.Errorf(ePrefix + "Error: Invalid Month. Name='%v' Month='%v'",
+				hRule.Name, hRule.Month)
+		}
+	}
+
+	switch hRule.RuleType {
+
+	case HolidayRuleFIXEDDATE, HolidayRuleONEOFF:
+
+		if hRule.Day < 1 || hRule.Day > 31 {
+			return fmt.Errorf(ePrefix + "Error: Invalid Day. Name='%v' Day='%v'", hRule.Name, hRule.Day)
+		}
+
+	case HolidayRuleNTHWEEKDAY:
+
+		if hRule.Day < 0 || hRule.Day > 31 {
+			return fmt.Errorf(ePrefix + "Error: Invalid Day. Name='%v' Day='%v'", hRule.Name, hRule.Day)
+		}
+
+		if hRule.Nth == 0 || hRule.Nth > 5 || hRule.Nth < -5 {
+			return fmt.Errorf(ePrefix + "Error: Invalid Nth. Name='%v' Nth='%v'", hRule.Name, hRule.Nth)
+		}
+
+		if hRule.WeekDay < time.Sunday || hRule.WeekDay > time.Saturday {
+			return fmt.Errorf(ePrefix + "Error: Invalid WeekDay. Name='%v' WeekDay='%v'",
+				hRule.Name, int(hRule.WeekDay))
+		}
+	}
+
+	if hRule.RuleType == HolidayRuleONEOFF && hRule.Year == 0 {
+		return fmt.Errorf(ePrefix + "Error: OneOff rule requires a Year. Name='%v'", hRule.Name)
+	}
+
+	return nil
+}
+
+// HolidayDto - Describes a single occurrence of a holiday.
+type HolidayDto struct {
+	Name         string    // Holiday name
+	Date         DateTzDto // The actual date of the holiday at local midnight
+	ObservedDate DateTzDto // The date on which the holiday is observed at local
+	                       //   midnight. Equal to Date if not moved.
+}
+
+// HolidayCalendarDto - A named collection of holiday rules.
+//
+// Holiday dates are computed once per year and cached. The cache is
+// created by HolidayCalendarDto{}.New() and the other constructors, and it
+// is shared by copies of the calendar value. The cache is discarded when
+// the rules change. A HolidayCalendarDto created as a struct literal
+// computes holiday dates on every call.
+type HolidayCalendarDto struct {
+	Name  string           // Calendar name. Example: "United States"
+	Rules []HolidayRuleDto // Holiday rules
+	cache *holidayDateCache
+}
+
+// holidayDateCache - Holiday dates computed for each year. 'rules' holds
+// the rules from which the dates were computed.
+type holidayDateCache struct {
+	lock  sync.Mutex
+	rules []HolidayRuleDto
+	years map[int]holidayYearDates
+}
+
+// holidayYearDates - The holidays whose actual dates fall in a year.
+// 'days' holds the actual and observed day numbers of those holidays.
+type holidayYearDates struct {
+	dates []holidayDate
+	days  map[int64]bool
+}
+
+// holidayDate - Internal representation of a holiday occurrence
+// using civil day numbers.
+type holidayDate struct {
+	name        string
+	ruleIdx     int
+	dayNum      int64
+	observedDay int64
+}
+
+// AddRule - Validates and adds a holiday rule to the current
+// HolidayCalendarDto.
+func (hCal *HolidayCalendarDto) AddRule(rule HolidayRuleDto) error {
+
+	ePrefix := "HolidayCalendarDto.AddRule() "
+
+	err := rule.IsValid()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	hCal.Rules = append(hCal.Rules, rule)
+
+	return nil
+}
+
+// CopyOut - Returns a deep copy of the current HolidayCalendarDto.
+func (hCal *HolidayCalendarDto) CopyOut() HolidayCalendarDto {
+
+	hCal2 := HolidayCalendarDto{}
+
+	hCal2.Name = hCal.Name
+
+	hCal2.Rules = make([]HolidayRuleDto, len(hCal.Rules))
+
+	copy(hCal2.Rules, hCal.Rules)
+
+	hCal2.cache = &holidayDateCache{}
+
+	return hCal2
+}
+
+// GetHoliday - Returns the holiday whose actual or observed date is equal
+// to the local calendar date of input parameter 'dtz'. The returned holiday
+// dates are expressed in the time zone of 'dtz'. The boolean return value
+// is 'false' if the date is not a holiday.
+func (hCal *HolidayCalendarDto) GetHoliday(dtz DateTzDto) (HolidayDto, bool) {
+
+	dayNum := civilDayNumber(dtz.DateTime)
+
+	year := dtz.DateTime.Year()
+
+	for y := year - 1; y <= year+1; y++ {
+
+		for _, hDate := range hCal.getHolidayYearDates(y).dates {
+
+			if hDate.dayNum != dayNum && hDate.observedDay != dayNum {
+				continue
+			}
+
+			hDto, err := hCal.newHolidayDto(hDate, dtz.DateTime.Location(), dtz.DateTimeFmt)
+
+			if err != nil {
+				return HolidayDto{}, false
+			}
+
+			return hDto, true
+		}
+	}
+
+	return HolidayDto{}, false
+}
+
+// GetHolidays - Returns all holidays whose actual dates fall in 'year',
+// sorted by observed date. Holiday dates are expressed at local midnight
+// in the time zone 'timeZoneLocation'. If 'timeZoneLocation' is an empty
+// string, it defaults to "Etc/UTC".
+//
+// Example:
+//
+//	usCal := HolidayCalendarDto{}.NewUS()
+//	holidays, err := usCal.GetHolidays(2018, TzIanaUsCentral)
+//
+func (hCal *HolidayCalendarDto) GetHolidays(year int, timeZoneLocation string) ([]HolidayDto, error) {
+
+	ePrefix := "HolidayCalendarDto.GetHolidays() "
+
+	tzl := timeZoneLocation
+
+	if len(tzl) == 0 {
+		tzl = TzIanaUTC
+	}
+
+	loc, err := time.LoadLocation(tzl)
+
+	if err != nil {
+		return nil, fmt.Errorf(ePrefix +
+			"Error: 'timeZoneLocation' input parameter is INVALID! " +
+			"timeZoneLocation='%v' Error='%v'", timeZoneLocation, err.Error())
+	}
+
+	holidays := make([]HolidayDto, 0, len(hCal.Rules))
+
+	for _, hDate := range hCal.getHolidayYearDates(year).dates {
+
+		hDto, err := hCal.newHolidayDto(hDate, loc, FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			return nil, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		holidays = append(holidays, hDto)
+	}
+
+	return holidays, nil
+}
+
+// IsHoliday - Returns 'true' if the calendar date 'year', 'month', 'day'
+// is the actual or observed date of a holiday. Implements the
+// 'HolidayCalendar' interface.
+func (hCal HolidayCalendarDto) IsHoliday(year int, month time.Month, day int) bool {
+
+	d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	dayNum := civilDayNumber(d)
+
+	for y := d.Year() - 1; y <= d.Year()+1; y++ {
+
+		if hCal.getHolidayYearDates(y).days[dayNum] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsHolidayDate - Returns 'true' if the local calendar date of input
+// parameter 'dtz' is the actual or observed date of a holiday.
+func (hCal *HolidayCalendarDto) IsHolidayDate(dtz DateTzDto) bool {
+
+	year, month, day := dtz.DateTime.Date()
+
+	return hCal.IsHoliday(year, month, day)
+}
+
+// New - Creates a new, empty HolidayCalendarDto. Rules are added
+// with method AddRule().
+func (hCal HolidayCalendarDto) New(name string) HolidayCalendarDto {
+
+	hCal2 := HolidayCalendarDto{}
+
+	hCal2.Name = name
+
+	hCal2.Rules = make([]HolidayRuleDto, 0, 10)
+
+	hCal2.cache = &holidayDateCache{}
+
+	return hCal2
+}
+
+// NewComposite - Creates a new HolidayCalendarDto containing the rules of
+// all input calendars. Calendars are processed in order. If a later
+// calendar contains a rule with the same name as an earlier calendar, the
+// later rule replaces the earlier rule. This allows regional calendars to
+// override national rules.
+//
+// Example:
+//
+//	cal := HolidayCalendarDto{}.NewComposite("Acme Berlin",
+//	              HolidayCalendarDto{}.NewDE(), companyCal)
+//
+func (hCal HolidayCalendarDto) NewComposite(name string, calendars ...HolidayCalendarDto) HolidayCalendarDto {
+
+	hCal2 := HolidayCalendarDto{}.New(name)
+
+	ruleIdx := make(map[string]int)
+
+	for _, cal := range calendars {
+
+		for _, rule := range cal.Rules {
+
+			if idx, ok := ruleIdx[rule.Name]; ok {
+				hCal2.Rules[idx] = rule
+				continue
+			}
+
+			ruleIdx[rule.Name] = len(hCal2.Rules)
+
+			hCal2.Rules = append(hCal2.Rules, rule)
+		}
+	}
+
+	return hCal2
+}
+
+// NewDE - Creates a HolidayCalendarDto containing the national public
+// holidays of Germany. Use NewDEState() to add the holidays of a
+// German state.
+func (hCal HolidayCalendarDto) NewDE() HolidayCalendarDto {
+
+	hCal2 := HolidayCalendarDto{}.New("Germany")
+
+	hCal2.Rules = append(hCal2.Rules,
+		HolidayRuleDto{Name: "Neujahr", RuleType: HolidayRuleFIXEDDATE, Month: 1, Day: 1},
+		HolidayRuleDto{Name: "Karfreitag", RuleType: HolidayRuleEASTER, Offset: -2},
+		HolidayRuleDto{Name: "Ostermontag", RuleType: HolidayRuleEASTER, Offset: 1},
+		HolidayRuleDto{Name: "Tag der Arbeit", RuleType: HolidayRuleFIXEDDATE, Month: 5, Day: 1},
+		HolidayRuleDto{Name: "Christi Himmelfahrt", RuleType: HolidayRuleEASTER, Offset: 39},
+		HolidayRuleDto{Name: "Pfingstmontag", RuleType: HolidayRuleEASTER, Offset: 50},
+		HolidayRuleDto{Name: "Tag der Deutschen Einheit", RuleType: HolidayRuleFIXEDDATE,
+			Month: 10, Day: 3, StartYear: 1990},
+		HolidayRuleDto{Name: "1. Weihnachtstag", RuleType: HolidayRuleFIXEDDATE, Month: 12, Day: 25},
+		HolidayRuleDto{Name: "2. Weihnachtstag", RuleType: HolidayRuleFIXEDDATE, Month: 12, Day: 26},
+	)
+
+	return hCal2
+}
+
+// NewDEState - Creates a HolidayCalendarDto containing the national public
+// holidays of Germany plus the public holidays of the German state identified
+// by 'stateCode'. State codes are the two letter ISO 3166-2:DE subdivision
+// codes. Example: "BY" = Bavaria. Holidays observed only in parts of a state
+// are not included.
+func (hCal HolidayCalendarDto) NewDEState(stateCode string) (HolidayCalendarDto, error) {
+
+	ePrefix := "HolidayCalendarDto.NewDEState() "
+
+	code := strings.ToUpper(strings.TrimSpace(stateCode))
+
+	stateRules := map[string][]HolidayRuleDto{}
+
+	epiphany := HolidayRuleDto{Name: "Heilige Drei Koenige", RuleType: HolidayRuleFIXEDDATE, Month: 1, Day: 6}
+	womensDay := HolidayRuleDto{Name: "Internationaler Frauentag", RuleType: HolidayRuleFIXEDDATE,
+		Month: 3, Day: 8, StartYear: 2019}
+	corpusChristi := HolidayRuleDto{Name: "Fronleichnam", RuleType: HolidayRuleEASTER, Offset: 60}
+	assumption := HolidayRuleDto{Name: "Mariae Himmelfahrt", RuleType: HolidayRuleFIXEDDATE, Month: 8, Day: 15}
+	childrensDay := HolidayRuleDto{Name: "Weltkindertag", RuleType: HolidayRuleFIXEDDATE,
+		Month: 9, Day: 20, StartYear: 2019}
+	reformation := HolidayRuleDto{Name: "Reformationstag", RuleType: HolidayRuleFIXEDDATE, Month: 10, Day: 31}
+	reformationFrom2018 := reformation
+	reformationFrom2018.StartYear = 2018
+	allSaints := HolidayRuleDto{Name: "Allerheiligen", RuleType: HolidayRuleFIXEDDATE, Month: 11, Day: 1}
+	repentance := HolidayRuleDto{Name: "Buss- und Bettag", RuleType: HolidayRuleNTHWEEKDAY,
+		Month: 11, Day: 16, WeekDay: time.Wednesday, Nth: 1}
+
+	stateRules["BW"] = []HolidayRuleDto{epiphany, corpusChristi, allSaints}
+	stateRules["BY"] = []HolidayRuleDto{epiphany, corpusChristi, assumption, allSaints}
+	stateRules["BE"] = []HolidayRuleDto{womensDay}
+	stateRules["BB"] = []HolidayRuleDto{reformation}
+	stateRules["HB"] = []HolidayRuleDto{reformationFrom2018}
+	stateRules["HH"] = []HolidayRuleDto{reformationFrom2018}
+	stateRules["HE"] = []HolidayRuleDto{corpusChristi}
+	stateRules["MV"] = []HolidayRuleDto{reformation}
+	stateRules["NI"] = []HolidayRuleDto{reformationFrom2018}
+	stateRules["NW"] = []HolidayRuleDto{corpusChristi, allSaints}
+	stateRules["RP"] = []HolidayRuleDto{corpusChristi, allSaints}
+	stateRules["SL"] = []HolidayRuleDto{corpusChristi, assumption, allSaints}
+	stateRules["SN"] = []HolidayRuleDto{reformation, repentance}
+	stateRules["ST"] = []HolidayRuleDto{epiphany, reformation}
+	stateRules["SH"] = []HolidayRuleDto{reformationFrom2018}
+	stateRules["TH"] = []HolidayRuleDto{childrensDay, reformation}
+
+	rules, ok := stateRules[code]
+
+	if !ok {
+		return HolidayCalendarDto{},
+			fmt.Errorf(ePrefix + "Error: Unknown German state code. stateCode='%v'", stateCode)
+	}
+
+	stateCal := HolidayCalendarDto{}.New("Germany-" + code)
+
+	stateCal.Rules = append(stateCal.Rules, rules...)
+
+	return HolidayCalendarDto{}.NewComposite("Germany-"+code, HolidayCalendarDto{}.NewDE(), stateCal), nil
+}
+
+// NewIN - Creates a HolidayCalendarDto containing the national holidays
+// of India together with the gazetted holidays whose dates follow the
+// Gregorian calendar. Holidays determined by lunar calendars (Example:
+// Diwali) vary each year and should be added as HolidayRuleONEOFF rules
+// from the annual government list.
+func (hCal HolidayCalendarDto) NewIN() HolidayCalendarDto {
+
+	hCal2 := HolidayCalendarDto{}.New("India")
+
+	hCal2.Rules = append(hCal2.Rules,
+		HolidayRuleDto{Name: "Republic Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 1, Day: 26, StartYear: 1950},
+		HolidayRuleDto{Name: "Good Friday", RuleType: HolidayRuleEASTER, Offset: -2},
+		HolidayRuleDto{Name: "Independence Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 8, Day: 15, StartYear: 1947},
+		HolidayRuleDto{Name: "Gandhi Jayanti", RuleType: HolidayRuleFIXEDDATE, Month: 10, Day: 2},
+		HolidayRuleDto{Name: "Christmas Day", RuleType: HolidayRuleFIXEDDATE, Month: 12, Day: 25},
+	)
+
+	return hCal2
+}
+
+// NewUK - Creates a HolidayCalendarDto containing the bank holidays of
+// England and Wales. Bank holidays falling on a weekend are observed on
+// the next free weekday (substitute day). One-time changes announced by
+// the government (Example: 2020-05-08) are not included and may be
+// added as HolidayRuleONEOFF rules.
+func (hCal HolidayCalendarDto) NewUK() HolidayCalendarDto {
+
+	hCal2 := HolidayCalendarDto{}.New("United Kingdom")
+
+	hCal2.Rules = append(hCal2.Rules,
+		HolidayRuleDto{Name: "New Year's Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 1, Day: 1, Observed: HolidayObservedNEXTWEEKDAY},
+		HolidayRuleDto{Name: "Good Friday", RuleType: HolidayRuleEASTER, Offset: -2},
+		HolidayRuleDto{Name: "Easter Monday", RuleType: HolidayRuleEASTER, Offset: 1},
+		HolidayRuleDto{Name: "Early May Bank Holiday", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 5, WeekDay: time.Monday, Nth: 1},
+		HolidayRuleDto{Name: "Spring Bank Holiday", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 5, WeekDay: time.Monday, Nth: -1},
+		HolidayRuleDto{Name: "Summer Bank Holiday", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 8, WeekDay: time.Monday, Nth: -1},
+		HolidayRuleDto{Name: "Christmas Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 12, Day: 25, Observed: HolidayObservedNEXTWEEKDAY},
+		HolidayRuleDto{Name: "Boxing Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 12, Day: 26, Observed: HolidayObservedNEXTWEEKDAY},
+	)
+
+	return hCal2
+}
+
+// NewUS - Creates a HolidayCalendarDto containing United States federal
+// holidays. Holidays falling on Saturday are observed on Friday. Holidays
+// falling on Sunday are observed on Monday.
+func (hCal HolidayCalendarDto) NewUS() HolidayCalendarDto {
+
+	hCal2 := HolidayCalendarDto{}.New("United States")
+
+	hCal2.Rules = append(hCal2.Rules,
+		HolidayRuleDto{Name: "New Year's Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 1, Day: 1, Observed: HolidayObservedNEARESTWEEKDAY},
+		HolidayRuleDto{Name: "Martin Luther King Jr. Day", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 1, WeekDay: time.Monday, Nth: 3, StartYear: 1986},
+		HolidayRuleDto{Name: "Washington's Birthday", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 2, WeekDay: time.Monday, Nth: 3},
+		HolidayRuleDto{Name: "Memorial Day", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 5, WeekDay: time.Monday, Nth: -1},
+		HolidayRuleDto{Name: "Juneteenth National Independence Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 6, Day: 19, StartYear: 2021, Observed: HolidayObservedNEARESTWEEKDAY},
+		HolidayRuleDto{Name: "Independence Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 7, Day: 4, Observed: HolidayObservedNEARESTWEEKDAY},
+		HolidayRuleDto{Name: "Labor Day", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 9, WeekDay: time.Monday, Nth: 1},
+		HolidayRuleDto{Name: "Columbus Day", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 10, WeekDay: time.Monday, Nth: 2},
+		HolidayRuleDto{Name: "Veterans Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 11, Day: 11, Observed: HolidayObservedNEARESTWEEKDAY},
+		HolidayRuleDto{Name: "Thanksgiving Day", RuleType: HolidayRuleNTHWEEKDAY,
+			Month: 11, WeekDay: time.Thursday, Nth: 4},
+		HolidayRuleDto{Name: "Christmas Day", RuleType: HolidayRuleFIXEDDATE,
+			Month: 12, Day: 25, Observed: HolidayObservedNEARESTWEEKDAY},
+	)
+
+	return hCal2
+}
+
+// getHolidayDates - Computes the actual and observed dates of all
+// holidays whose actual dates fall in 'year'. Results are sorted by
+// observed date.
+func (hCal *HolidayCalendarDto) getHolidayDates(year int) []holidayDate {
+
+	hDates := make([]holidayDate, 0, len(hCal.Rules))
+
+	actualDays := make(map[int64]bool)
+
+	for i := 0; i < len(hCal.Rules); i++ {
+
+		y, m, d, ok := hCal.Rules[i].GetDate(year)
+
+		if !ok {
+			continue
+		}
+
+		dayNum := civilDayNumber(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+
+		actualDays[dayNum] = true
+
+		hDates = append(hDates,
+			holidayDate{name: hCal.Rules[i].Name, ruleIdx: i, dayNum: dayNum, observedDay: dayNum})
+	}
+
+	// Assign observed dates in rule order. A substitute day may not
+	// fall on another holiday or on a previously assigned substitute day.
+	takenDays := make(map[int64]bool)
+
+	for k, v := range actualDays {
+		takenDays[k] = v
+	}
+
+	for i := 0; i < len(hDates); i++ {
+
+		hDates[i].observedDay =
+			observedDayNumber(hDates[i].dayNum, hCal.Rules[hDates[i].ruleIdx].Observed, takenDays)
+
+		takenDays[hDates[i].observedDay] = true
+	}
+
+	sort.SliceStable(hDates, func(i, j int) bool {
+		return hDates[i].observedDay < hDates[j].observedDay
+	})
+
+	return hDates
+}
+
+// getHolidayYearDates - Returns the holidays whose actual dates fall in
+// 'year'. Results are taken from the cache if the rules have not changed
+// since they were computed. The returned values must not be modified.
+func (hCal *HolidayCalendarDto) getHolidayYearDates(year int) holidayYearDates {
+
+	if hCal.cache == nil {
+		return newHolidayYearDates(hCal.getHolidayDates(year))
+	}
+
+	hCal.cache.lock.Lock()
+	defer hCal.cache.lock.Unlock()
+
+	isCurrent := len(hCal.cache.rules) == len(hCal.Rules)
+
+	for i := 0; isCurrent && i < len(hCal.Rules); i++ {
+		isCurrent = hCal.cache.rules[i] == hCal.Rules[i]
+	}
+
+	if !isCurrent || hCal.cache.years == nil {
+		hCal.cache.rules = make([]HolidayRuleDto, len(hCal.Rules))
+		copy(hCal.cache.rules, hCal.Rules)
+		hCal.cache.years = make(map[int]holidayYearDates)
+	}
+
+	yearDates, ok := hCal.cache.years[year]
+
+	if !ok {
+		yearDates = newHolidayYearDates(hCal.getHolidayDates(year))
+		hCal.cache.years[year] = yearDates
+	}
+
+	return yearDates
+}
+
+// newHolidayYearDates - Creates a holidayYearDates from 'hDates'.
+func newHolidayYearDates(hDates []holidayDate) holidayYearDates {
+
+	yearDates := holidayYearDates{dates: hDates, days: make(map[int64]bool, 2*len(hDates))}
+
+	for _, hDate := range hDates {
+		yearDates.days[hDate.dayNum] = true
+		yearDates.days[hDate.observedDay] = true
+	}
+
+	return yearDates
+}
+
+// newHolidayDto - Creates a HolidayDto from a holidayDate in location 'loc'.
+func (hCal *HolidayCalendarDto) newHolidayDto(
+	hDate holidayDate,
+	loc *time.Location,
+	dateTimeFmtStr string) (HolidayDto, error) {
+
+	hDto := HolidayDto{}
+
+	hDto.Name = hDate.name
+
+	y, m, d := civilDate(hDate.dayNum)
+
+	dtz, err := DateTzDto{}.New(startOfLocalDay(y, m, d, loc), dateTimeFmtStr)
+
+	if err != nil {
+		return HolidayDto{}, err
+	}
+
+	hDto.Date = dtz
+
+	y, m, d = civilDate(hDate.observedDay)
+
+	dtz, err = DateTzDto{}.New(startOfLocalDay(y, m, d, loc), dateTimeFmtStr)
+
+	if err != nil {
+		return HolidayDto{}, err
+	}
+
+	hDto.ObservedDate = dtz
+
+	return hDto, nil
+}
+
+// observedDayNumber - Applies observed policy 'observed' to the holiday
+// falling on civil day number 'dayNum'. 'takenDays' contains the day
+// numbers which may not be used as substitute days.
+func observedDayNumber(dayNum int64, observed HolidayObservedType, takenDays map[int64]bool) int64 {
+
+	y, m, d := civilDate(dayNum)
+
+	weekDay := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Weekday()
+
+	nextFreeWeekday := func(start int64) int64 {
+
+		for dn := start; ; dn++ {
+
+			wd := time.Weekday(floorModInt64(dn+4, 7)) // 1970-01-01 was a Thursday
+
+			if wd == time.Saturday || wd == time.Sunday || takenDays[dn] {
+				continue
+			}
+
+			return dn
+		}
+	}
+
+	switch observed {
+
+	case HolidayObservedNEARESTWEEKDAY:
+
+		if weekDay == time.Saturday {
+			return dayNum - 1
+		}
+
+		if weekDay == time.Sunday {
+			return dayNum + 1
+		}
+
+	case HolidayObservedNEXTWEEKDAY:
+
+		if weekDay == time.Saturday || weekDay == time.Sunday {
+			return nextFreeWeekday(dayNum + 1)
+		}
+
+	case HolidayObservedSUNDAYTOMONDAY:
+
+		if weekDay == time.Sunday {
+			return nextFreeWeekday(dayNum + 1)
+		}
+	}
+
+	return dayNum
+}
+
+// gregorianEasterDate - Returns the month and day of Western Easter
+// Sunday in 'year' using the anonymous Gregorian computus (Meeus/Jones/
+// Butcher algorithm).
+func gregorianEasterDate(year int) (time.Month, int) {
+
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+
+	month := (h + l - 7*m + 114) / 31
+	day := ((h + l - 7*m + 114) % 31) + 1
+
+	return time.Month(month), day
+}
+
+// orthodoxEasterDate - Returns the month and day of Orthodox Easter
+// Sunday in 'year', expressed as a Gregorian calendar date. The Julian
+// computus (Meeus algorithm) is converted to the Gregorian calendar.
+func orthodoxEasterDate(year int) (time.Month, int) {
+
+	a := year % 4
+	b := year % 7
+	c := year % 19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+
+	julianMonth := (d + e + 114) / 31
+	julianDay := ((d + e + 114) % 31) + 1
+
+	// Difference in days between the Julian and Gregorian calendars
+	centuryDiff := year/100 - year/400 - 2
+
+	gDate := time.Date(year, time.Month(julianMonth), julianDay+centuryDiff, 0, 0, 0, 0, time.UTC)
+
+	return gDate.Month(), gDate.Day()
+}
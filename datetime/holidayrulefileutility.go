@@ -0,0 +1,530 @@
+package datetime
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ Holiday Rule File Utility
+ =========================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\holidayrulefileutility.go
+
+ Overview and Usage
+ ==================
+ This source file contains the methods used to load a 'HolidayCalendarDto'
+ from a JSON or YAML style rule file.
+
+ Rule Fields:
+
+	name      - Holiday name. Required.
+	type      - fixed, nthWeekday, easter, orthodoxEaster or oneOff. Required.
+	month     - Month 1-12
+	day       - Day of month
+	weekday   - Weekday name. Example: Monday
+	nth       - 1-5 or -1 (last) through -5
+	offset    - Days added to the computed date. Example: Good Friday = -2
+	year      - The year of a oneOff rule
+	startYear - First year in which the rule applies
+	endYear   - Last year in which the rule applies
+	observed  - none, nearestWeekday, nextWeekday or sundayToMonday
+
+ Type names, weekday names and observed policy names are not case
+ sensitive.
+
+ The optional 'include' field lists predefined calendars whose rules are
+ added before the rules in the file: US, UK, DE, DE-<state code> and IN.
+ Rules in the file replace included rules having the same name.
+
+ JSON Example:
+
+	{
+	  "name": "Acme Corp",
+	  "include": ["US"],
+	  "rules": [
+	    {"name": "Day After Thanksgiving", "type": "nthWeekday", "month": 11,
+	     "weekday": "Thursday", "nth": 4, "offset": 1},
+	    {"name": "Christmas Eve", "type": "fixed", "month": 12, "day": 24,
+	     "observed": "nearestWeekday"}
+	  ]
+	}
+
+ YAML Example:
+
+ Only the subset of YAML shown below is supported. Comments begin with
+ '#' at the start of a line or following white space. Values may be
+ enclosed in single or double quotes. A '#' within a quoted value is
+ part of the value.
+
+	name: Acme Corp
+	include: [US]
+	rules:
+	  - name: Day After Thanksgiving
+	    type: nthWeekday
+	    month: 11
+	    weekday: Thursday
+	    nth: 4
+	    offset: 1
+	  - name: Christmas Eve
+	    type: fixed
+	    month: 12
+	    day: 24
+	    observed: nearestWeekday
+
+ Rule list items may also begin in column zero:
+
+	rules:
+	- name: Christmas Eve
+	  type: fixed
+
+*/
+
+// holidayRuleFileDto - The contents of a holiday rule file.
+type holidayRuleFileDto struct {
+	Name    string                 `json:"name"`
+	Include []string               `json:"include"`
+	Rules   []holidayRuleRecordDto `json:"rules"`
+}
+
+// holidayRuleRecordDto - A single rule as it appears in a rule file.
+type holidayRuleRecordDto struct {
+	Name      string `json:"name"`
+	Type      string `json:"type"`
+	Month     int    `json:"month"`
+	Day       int    `json:"day"`
+	WeekDay   string `json:"weekday"`
+	Nth       int    `json:"nth"`
+	Offset    int    `json:"offset"`
+	Year      int    `json:"year"`
+	StartYear int    `json:"startYear"`
+	EndYear   int    `json:"endYear"`
+	Observed  string `json:"observed"`
+}
+
+// NewFromFile - Creates a new HolidayCalendarDto from a rule file. Files
+// with the extension ".json" are parsed as JSON. Files with the extension
+// ".yaml" or ".yml" are parsed as YAML.
+func (hCal HolidayCalendarDto) NewFromFile(pathFileName string) (HolidayCalendarDto, error) {
+
+	ePrefix := "HolidayCalendarDto.NewFromFile() "
+
+	data, err := ioutil.ReadFile(pathFileName)
+
+	if err != nil {
+		return HolidayCalendarDto{},
+			fmt.Errorf(ePrefix + "Error returned by ioutil.ReadFile(pathFileName). "+
+				"pathFileName='%v' Error='%v'", pathFileName, err.Error())
+	}
+
+	ext := strings.ToLower(filepath.Ext(pathFileName))
+
+	switch ext {
+
+	case ".json":
+		return HolidayCalendarDto{}.NewFromJSON(data)
+
+	case ".yaml", ".yml":
+		return HolidayCalendarDto{}.NewFromYAML(data)
+	}
+
+	return HolidayCalendarDto{},
+		fmt.Errorf(ePrefix + "Error: Unsupported file extension. pathFileName='%v'", pathFileName)
+}
+
+// NewFromJSON - Creates a new HolidayCalendarDto from JSON rule data.
+func (hCal HolidayCalendarDto) NewFromJSON(data []byte) (HolidayCalendarDto, error) {
+
+	ePrefix := "HolidayCalendarDto.NewFromJSON() "
+
+	ruleFile := holidayRuleFileDto{}
+
+	err := json.Unmarshal(data, &ruleFile)
+
+	if err != nil {
+		return HolidayCalendarDto{},
+			fmt.Errorf(ePrefix + "Error returned by json.Unmarshal(data). Error='%v'", err.Error())
+	}
+
+	hCal2, err := ruleFile.newHolidayCalendar()
+
+	if err != nil {
+		return HolidayCalendarDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return hCal2, nil
+}
+
+// NewFromYAML - Creates a new HolidayCalendarDto from YAML rule data. Only
+// the subset of YAML described in source file 'holidayrulefileutility.go'
+// is supported.
+func (hCal HolidayCalendarDto) NewFromYAML(data []byte) (HolidayCalendarDto, error) {
+
+	ePrefix := "HolidayCalendarDto.NewFromYAML() "
+
+	ruleFile, err := parseHolidayRuleYAML(string(data))
+
+	if err != nil {
+		return HolidayCalendarDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	hCal2, err := ruleFile.newHolidayCalendar()
+
+	if err != nil {
+		return HolidayCalendarDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return hCal2, nil
+}
+
+// newHolidayCalendar - Converts the rule file to a HolidayCalendarDto.
+func (ruleFile *holidayRuleFileDto) newHolidayCalendar() (HolidayCalendarDto, error) {
+
+	calendars := make([]HolidayCalendarDto, 0, len(ruleFile.Include)+1)
+
+	for _, include := range ruleFile.Include {
+
+		cal, err := predefinedHolidayCalendar(include)
+
+		if err != nil {
+			return HolidayCalendarDto{}, err
+		}
+
+		calendars = append(calendars, cal)
+	}
+
+	fileCal := HolidayCalendarDto{}.New(ruleFile.Name)
+
+	for i, record := range ruleFile.Rules {
+
+		rule, err := record.toHolidayRule()
+
+		if err != nil {
+			return HolidayCalendarDto{}, fmt.Errorf("Rule Index='%v' %v", i, err.Error())
+		}
+
+		err = fileCal.AddRule(rule)
+
+		if err != nil {
+			return HolidayCalendarDto{}, fmt.Errorf("Rule Index='%v' %v", i, err.Error())
+		}
+	}
+
+	calendars = append(calendars, fileCal)
+
+	return HolidayCalendarDto{}.NewComposite(ruleFile.Name, calendars...), nil
+}
+
+// setField - Assigns string 'value' to the field named 'key'. Used
+// by the YAML parser.
+func (record *holidayRuleRecordDto) setField(key, value string) error {
+
+	var err error
+
+	intField := func(field *int) {
+		*field, err = strconv.Atoi(value)
+	}
+
+	switch strings.ToLower(key) {
+
+	case "name":
+		record.Name = value
+	case "type":
+		record.Type = value
+	case "month":
+		intField(&record.Month)
+	case "day":
+		intField(&record.Day)
+	case "weekday":
+		record.WeekDay = value
+	case "nth":
+		intField(&record.Nth)
+	case "offset":
+		intField(&record.Offset)
+	case "year":
+		intField(&record.Year)
+	case "startyear":
+		intField(&record.StartYear)
+	case "endyear":
+		intField(&record.EndYear)
+	case "observed":
+		record.Observed = value
+	default:
+		return fmt.Errorf("Error: Unknown rule field. key='%v'", key)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error: Invalid integer value. key='%v' value='%v'", key, value)
+	}
+
+	return nil
+}
+
+// toHolidayRule - Converts a rule file record to a HolidayRuleDto.
+func (record *holidayRuleRecordDto) toHolidayRule() (HolidayRuleDto, error) {
+
+	rule := HolidayRuleDto{}
+
+	rule.Name = record.Name
+
+	found := false
+
+	for i, label := range HolidayRuleTypeLabels {
+
+		if strings.EqualFold(label, record.Type) {
+			rule.RuleType = HolidayRuleType(i)
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return HolidayRuleDto{},
+			fmt.Errorf("Error: Invalid rule type. name='%v' type='%v'", record.Name, record.Type)
+	}
+
+	if len(record.Observed) > 0 {
+
+		found = false
+
+		for i, label := range HolidayObservedTypeLabels {
+
+			if strings.EqualFold(label, record.Observed) {
+				rule.Observed = HolidayObservedType(i)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return HolidayRuleDto{},
+				fmt.Errorf("Error: Invalid observed policy. name='%v' observed='%v'",
+					record.Name, record.Observed)
+		}
+	}
+
+	if len(record.WeekDay) > 0 {
+
+		found = false
+
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+
+			if strings.EqualFold(wd.String(), record.WeekDay) {
+				rule.WeekDay = wd
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return HolidayRuleDto{},
+				fmt.Errorf("Error: Invalid weekday. name='%v' weekday='%v'", record.Name, record.WeekDay)
+		}
+	}
+
+	rule.Month = record.Month
+	rule.Day = record.Day
+	rule.Nth = record.Nth
+	rule.Offset = record.Offset
+	rule.Year = record.Year
+	rule.StartYear = record.StartYear
+	rule.EndYear = record.EndYear
+
+	return rule, nil
+}
+
+// parseHolidayRuleYAML - Parses the YAML subset described in the
+// overview of this source file.
+func parseHolidayRuleYAML(text string) (holidayRuleFileDto, error) {
+
+	ruleFile := holidayRuleFileDto{}
+
+	var record *holidayRuleRecordDto
+
+	inRules := false
+
+	for lineNo, line := range strings.Split(text, "\n") {
+
+		line = stripYAMLComment(line)
+
+		trimmed := strings.TrimSpace(line)
+
+		if len(trimmed) == 0 {
+			continue
+		}
+
+		isListItem := trimmed == "-" || strings.HasPrefix(trimmed, "- ") ||
+			strings.HasPrefix(trimmed, "-\t")
+
+		// List items may begin in column zero (compact sequence style).
+		isTopLevel := line[0] != ' ' && line[0] != '\t' && !(inRules && isListItem)
+
+		if inRules && isListItem {
+
+			ruleFile.Rules = append(ruleFile.Rules, holidayRuleRecordDto{})
+			record = &ruleFile.Rules[len(ruleFile.Rules)-1]
+
+			trimmed = strings.TrimSpace(trimmed[1:])
+
+			if len(trimmed) == 0 {
+				continue
+			}
+		}
+
+		colon := strings.Index(trimmed, ":")
+
+		if colon < 0 {
+			return holidayRuleFileDto{},
+				fmt.Errorf("Error: Expected 'key: value'. Line='%v' Text='%v'", lineNo+1, trimmed)
+		}
+
+		key := strings.TrimSpace(trimmed[:colon])
+		value := unquoteYAMLValue(trimmed[colon+1:])
+
+		if isTopLevel {
+
+			inRules = false
+			record = nil
+
+			switch strings.ToLower(key) {
+
+			case "name":
+				ruleFile.Name = value
+
+			case "include":
+
+				value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+
+				for _, include := range strings.Split(value, ",") {
+
+					include = unquoteYAMLValue(include)
+
+					if len(include) > 0 {
+						ruleFile.Include = append(ruleFile.Include, include)
+					}
+				}
+
+			case "rules":
+				inRules = true
+
+			default:
+				return holidayRuleFileDto{},
+					fmt.Errorf("Error: Unknown key. Line='%v' key='%v'", lineNo+1, key)
+			}
+
+			continue
+		}
+
+		if record == nil {
+			return holidayRuleFileDto{},
+				fmt.Errorf("Error: Rule field outside of a rule list item. Line='%v' Text='%v'",
+					lineNo+1, trimmed)
+		}
+
+		err := record.setField(key, value)
+
+		if err != nil {
+			return holidayRuleFileDto{}, fmt.Errorf("Line='%v' %v", lineNo+1, err.Error())
+		}
+	}
+
+	return ruleFile, nil
+}
+
+// predefinedHolidayCalendar - Returns the predefined calendar identified
+// by 'calName'. Valid names are US, UK, DE, DE-<state code> and IN.
+func predefinedHolidayCalendar(calName string) (HolidayCalendarDto, error) {
+
+	name := strings.ToUpper(strings.TrimSpace(calName))
+
+	switch name {
+
+	case "US":
+		return HolidayCalendarDto{}.NewUS(), nil
+
+	case "UK", "GB":
+		return HolidayCalendarDto{}.NewUK(), nil
+
+	case "DE":
+		return HolidayCalendarDto{}.NewDE(), nil
+
+	case "IN":
+		return HolidayCalendarDto{}.NewIN(), nil
+	}
+
+	if strings.HasPrefix(name, "DE-") {
+		return HolidayCalendarDto{}.NewDEState(name[3:])
+	}
+
+	return HolidayCalendarDto{}, fmt.Errorf("Error: Unknown predefined calendar. include='%v'", calName)
+}
+
+// stripYAMLComment - Removes a trailing comment from a YAML line. A
+// comment begins with '#' at the start of the line or following white
+// space. A '#' enclosed in a quoted value does not begin a comment.
+// Quoted values begin with a single or double quote following ':',
+// '-', '[' or ',' or at the start of the line.
+func stripYAMLComment(line string) string {
+
+	var quote byte
+
+	for i := 0; i < len(line); i++ {
+
+		c := line[i]
+
+		if quote != 0 {
+
+			if quote == '"' && c == '\\' {
+				// Skip the escaped character
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		if c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			return line[:i]
+		}
+
+		if c != '"' && c != '\'' {
+			continue
+		}
+
+		prev := strings.TrimRight(line[:i], " \t")
+
+		if len(prev) == 0 || strings.ContainsAny(prev[len(prev)-1:], ":-[,") {
+			quote = c
+		}
+	}
+
+	return line
+}
+
+// unquoteYAMLValue - Trims white space and removes matching single or
+// double quotes from a YAML value.
+func unquoteYAMLValue(value string) string {
+
+	value = strings.TrimSpace(value)
+
+	if len(value) >= 2 {
+
+		first := value[0]
+
+		if (first == '"' || first == '\'') && value[len(value)-1] == first {
+			return value[1 : len(value)-1]
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,668 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ ICalendarDto
+ ============
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\icalendardto.go
+
+ Overview and Usage
+ ==================
+ The 'ICalendarDto' Type reads and writes iCalendar (RFC 5545) VEVENT
+ data. Each event is stored as an 'ICalEventDto'.
+
+ Import:
+
+	DTSTART/DTEND with TZID  - Mapped to a DateTzDto in the TZID time zone.
+	                           If the TZID is not an IANA time zone name,
+	                           the X-LIC-LOCATION property of the matching
+	                           VTIMEZONE component is used.
+	DTSTART/DTEND in UTC     - Mapped to a DateTzDto in time zone UTC.
+	Floating DTSTART/DTEND   - Mapped to a DateTzDto in the default time
+	                           zone passed to NewFromICalendar(). The event
+	                           is flagged with 'IsFloating'.
+	VALUE=DATE               - All day events. The event is flagged with
+	                           'IsAllDay'.
+	DURATION                 - Mapped to a TimeDurationDto. Weeks and days
+	                           are nominal (wall clock) days. Hours, minutes
+	                           and seconds are exact.
+
+ Export:
+
+ GetICalendarStr() generates one VTIMEZONE component for each IANA time
+ zone used by the events. VTIMEZONE components are computed from the time
+ zone's actual transition history for the years covered by the events.
+ A recurring event limited by COUNT or UNTIL covers the years through its
+ last occurrence. An unlimited RRULE covers iCalOpenRRuleYears years after
+ the later of its DTSTART year and the current year.
+
+ Example:
+
+	iCal, err := ICalendarDto{}.NewFromICalendar(icsText, TzIanaUsCentral, "")
+
+	for _, event := range iCal.Events {
+		fmt.Println(event.Summary, event.Start.String())
+	}
+
+	icsText, err = iCal.GetICalendarStr()
+
+*/
+
+// iCalOpenRRuleYears - The number of years covered by the VTIMEZONE
+// components of an event whose RRULE has neither COUNT nor UNTIL.
+const iCalOpenRRuleYears = 10
+
+// ICalEventDto - An iCalendar VEVENT
+type ICalEventDto struct {
+	UID         string          // Unique identifier of the event
+	Summary     string          // Event summary (title)
+	Description string          // Event description
+	Location    string          // Event location
+	Start       DateTzDto       // DTSTART
+	End         DateTzDto       // DTEND. Computed from DURATION if DTEND is absent
+	Duration    TimeDurationDto // Duration of the event from Start to End
+	IsAllDay    bool            // 'true' if DTSTART is a DATE value
+	IsFloating  bool            // 'true' if DTSTART is a floating local time
+	RRule       string          // Optional RRULE value. Example: "FREQ=WEEKLY;COUNT=10"
+	DtStamp     time.Time       // DTSTAMP. If zero, the current time is exported
+}
+
+// New - Creates a new ICalEventDto from a start and end date time.
+// The Duration is computed from 'start' and 'end'.
+func (evt ICalEventDto) New(uid, summary string, start, end DateTzDto) (ICalEventDto, error) {
+
+	ePrefix := "ICalEventDto.New() "
+
+	if start.DateTime.IsZero() || end.DateTime.IsZero() {
+		return ICalEventDto{}, errors.New(ePrefix + "Error: 'start' and 'end' must be initialized!")
+	}
+
+	if end.DateTime.Before(start.DateTime) {
+		return ICalEventDto{}, fmt.Errorf(ePrefix +
+			"Error: 'end' is earlier than 'start'. start='%v' end='%v'", start.String(), end.String())
+	}
+
+	tDur, err := TimeDurationDto{}.NewStartEndDateTzDto(start, end, start.DateTimeFmt)
+
+	if err != nil {
+		return ICalEventDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	evt2 := ICalEventDto{}
+
+	evt2.UID = uid
+	evt2.Summary = summary
+	evt2.Start = start.CopyOut()
+	evt2.End = end.CopyOut()
+	evt2.Duration = tDur
+
+	return evt2, nil
+}
+
+// ICalendarDto - A collection of iCalendar events
+type ICalendarDto struct {
+	ProductId string         // PRODID. Example: "-//Example Corp//Calendar//EN"
+	Events    []ICalEventDto // VEVENT components
+}
+
+// AddEvent - Adds an event to the current ICalendarDto.
+func (iCal *ICalendarDto) AddEvent(event ICalEventDto) {
+
+	iCal.Events = append(iCal.Events, event)
+}
+
+// GetICalendarStr - Returns the current ICalendarDto as iCalendar text.
+// Lines are folded at 75 octets and terminated by CRLF.
+func (iCal *ICalendarDto) GetICalendarStr() (string, error) {
+
+	ePrefix := "ICalendarDto.GetICalendarStr() "
+
+	productId := iCal.ProductId
+
+	if len(productId) == 0 {
+		productId = "-//MikeAustin71//datetimeopsgo//EN"
+	}
+
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + productId,
+	}
+
+	// Years covered by each time zone
+	type yearRange struct {
+		loc       *time.Location
+		startYear int
+		endYear   int
+	}
+
+	zoneYears := make(map[string]*yearRange)
+
+	addYears := func(loc *time.Location, startYear, endYear int) {
+
+		yr, ok := zoneYears[loc.String()]
+
+		if !ok {
+			zoneYears[loc.String()] = &yearRange{loc: loc, startYear: startYear, endYear: endYear}
+			return
+		}
+
+		if startYear < yr.startYear {
+			yr.startYear = startYear
+		}
+
+		if endYear > yr.endYear {
+			yr.endYear = endYear
+		}
+	}
+
+	eventLines := make([]string, 0, len(iCal.Events)*8)
+
+	for i, evt := range iCal.Events {
+
+		if evt.Start.DateTime.IsZero() {
+			return "", fmt.Errorf(ePrefix + "Error: Event DTSTART is a ZERO value. Event Index='%v'", i)
+		}
+
+		if len(evt.UID) == 0 {
+			return "", fmt.Errorf(ePrefix + "Error: Event UID is empty. Event Index='%v'", i)
+		}
+
+		dtStamp := evt.DtStamp
+
+		if dtStamp.IsZero() {
+			dtStamp = time.Now()
+		}
+
+		eventLines = append(eventLines,
+			"BEGIN:VEVENT",
+			"UID:"+escapeICalText(evt.UID),
+			"DTSTAMP:"+formatICalUTCDateTime(dtStamp))
+
+		eventLines = append(eventLines, iCal.getDateTimeLine("DTSTART", evt.Start.DateTime, evt))
+
+		if !evt.End.DateTime.IsZero() {
+			eventLines = append(eventLines, iCal.getDateTimeLine("DTEND", evt.End.DateTime, evt))
+		}
+
+		if len(evt.RRule) > 0 {
+			eventLines = append(eventLines, "RRULE:"+evt.RRule)
+		}
+
+		if len(evt.Summary) > 0 {
+			eventLines = append(eventLines, "SUMMARY:"+escapeICalText(evt.Summary))
+		}
+
+		if len(evt.Description) > 0 {
+			eventLines = append(eventLines, "DESCRIPTION:"+escapeICalText(evt.Description))
+		}
+
+		if len(evt.Location) > 0 {
+			eventLines = append(eventLines, "LOCATION:"+escapeICalText(evt.Location))
+		}
+
+		eventLines = append(eventLines, "END:VEVENT")
+
+		if evt.IsAllDay || evt.IsFloating || iCal.isUTCLocation(evt.Start.DateTime.Location()) {
+			continue
+		}
+
+		endYear := evt.Start.DateTime.Year()
+
+		if evt.End.DateTime.Year() > endYear {
+			endYear = evt.End.DateTime.Year()
+		}
+
+		if rRuleYear := iCal.getRRuleEndYear(evt); rRuleYear > endYear {
+			endYear = rRuleYear
+		}
+
+		addYears(evt.Start.DateTime.Location(), evt.Start.DateTime.Year(), endYear)
+
+		if !evt.End.DateTime.IsZero() && !iCal.isUTCLocation(evt.End.DateTime.Location()) {
+			addYears(evt.End.DateTime.Location(), evt.End.DateTime.Year(), endYear)
+		}
+	}
+
+	tzIds := make([]string, 0, len(zoneYears))
+
+	for tzId := range zoneYears {
+		tzIds = append(tzIds, tzId)
+	}
+
+	sort.Strings(tzIds)
+
+	for _, tzId := range tzIds {
+
+		yr := zoneYears[tzId]
+
+		lines = append(lines, getVTimeZoneLines(yr.loc, yr.startYear, yr.endYear)...)
+	}
+
+	lines = append(lines, eventLines...)
+
+	lines = append(lines, "END:VCALENDAR")
+
+	var b strings.Builder
+
+	for _, line := range lines {
+		b.WriteString(foldICalLine(line))
+		b.WriteString("\r\n")
+	}
+
+	return b.String(), nil
+}
+
+// New - Creates a new, empty ICalendarDto.
+func (iCal ICalendarDto) New(productId string) ICalendarDto {
+
+	return ICalendarDto{ProductId: productId}
+}
+
+// NewFromICalendar - Creates a new ICalendarDto from iCalendar text.
+// VEVENT components are imported. VTIMEZONE components are used to map
+// TZID values which are not IANA time zone names. Other components and
+// properties are ignored.
+//
+// Input Parameters:
+// =================
+//
+// iCalText string                 - iCalendar text containing a VCALENDAR
+//                                   component.
+//
+// defaultTimeZoneLocation string  - The IANA time zone applied to floating
+//                                   and DATE values. If submitted as an
+//                                   empty string, it defaults to "Etc/UTC".
+//
+// dateTimeFmtStr string           - The date time format applied to the
+//                                   returned DateTzDto values. If submitted
+//                                   as an empty string, a default format is
+//                                   applied.
+//
+func (iCal ICalendarDto) NewFromICalendar(
+	iCalText,
+	defaultTimeZoneLocation,
+	dateTimeFmtStr string) (ICalendarDto, error) {
+
+	ePrefix := "ICalendarDto.NewFromICalendar() "
+
+	defaultLoc, err := time.LoadLocation(iCal.preProcessTimeZoneLocation(defaultTimeZoneLocation))
+
+	if err != nil {
+		return ICalendarDto{}, fmt.Errorf(ePrefix +
+			"Error: 'defaultTimeZoneLocation' input parameter is INVALID! " +
+			"defaultTimeZoneLocation='%v' Error='%v'", defaultTimeZoneLocation, err.Error())
+	}
+
+	lines := unfoldICalLines(iCalText)
+
+	// Map VTIMEZONE TZID values to X-LIC-LOCATION time zone names
+	tzIdLocations := make(map[string]string)
+
+	currentTzId := ""
+
+	for _, line := range lines {
+
+		name, _, value, err := parseICalContentLine(line)
+
+		if err != nil {
+			return ICalendarDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		switch name {
+
+		case "BEGIN":
+
+			if strings.ToUpper(value) == "VTIMEZONE" {
+				currentTzId = ""
+			}
+
+		case "TZID":
+			currentTzId = value
+
+		case "X-LIC-LOCATION":
+
+			if len(currentTzId) > 0 {
+				tzIdLocations[currentTzId] = value
+			}
+		}
+	}
+
+	iCal2 := ICalendarDto{}
+
+	var props []string
+
+	componentStack := make([]string, 0, 4)
+
+	for _, line := range lines {
+
+		name, _, value, _ := parseICalContentLine(line)
+
+		switch name {
+
+		case "BEGIN":
+			componentStack = append(componentStack, strings.ToUpper(value))
+
+			if strings.ToUpper(value) == "VEVENT" {
+				props = props[:0]
+			}
+
+			continue
+
+		case "END":
+
+			if len(componentStack) == 0 || componentStack[len(componentStack)-1] != strings.ToUpper(value) {
+				return ICalendarDto{}, fmt.Errorf(ePrefix + "Error: Mismatched END. line='%v'", line)
+			}
+
+			componentStack = componentStack[:len(componentStack)-1]
+
+			if strings.ToUpper(value) == "VEVENT" {
+
+				evt, err := iCal2.newEvent(props, tzIdLocations, defaultLoc, dateTimeFmtStr)
+
+				if err != nil {
+					return ICalendarDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+				}
+
+				iCal2.Events = append(iCal2.Events, evt)
+			}
+
+			continue
+		}
+
+		if len(componentStack) == 0 {
+			continue
+		}
+
+		switch componentStack[len(componentStack)-1] {
+
+		case "VCALENDAR":
+
+			if name == "PRODID" {
+				iCal2.ProductId = value
+			}
+
+		case "VEVENT":
+			props = append(props, line)
+		}
+	}
+
+	if len(componentStack) != 0 {
+		return ICalendarDto{}, fmt.Errorf(ePrefix + "Error: Component '%v' is not terminated by END.",
+			componentStack[len(componentStack)-1])
+	}
+
+	return iCal2, nil
+}
+
+// getDateTimeLine - Formats a DTSTART or DTEND content line.
+func (iCal *ICalendarDto) getDateTimeLine(name string, t time.Time, evt ICalEventDto) string {
+
+	if evt.IsAllDay {
+		return name + ";VALUE=DATE:" + t.Format("20060102")
+	}
+
+	if evt.IsFloating {
+		return name + ":" + formatICalDateTime(t)
+	}
+
+	if iCal.isUTCLocation(t.Location()) {
+		return name + ":" + formatICalUTCDateTime(t)
+	}
+
+	return name + ";TZID=" + t.Location().String() + ":" + formatICalDateTime(t)
+}
+
+// getRRuleEndYear - Returns the last year covered by the RRULE of 'evt'.
+// Rules limited by COUNT or UNTIL are expanded and the year of the end of
+// the last occurrence is returned. Unlimited rules cover
+// iCalOpenRRuleYears years after the later of the DTSTART year and the
+// current year. Returns zero if 'evt' has no RRULE.
+func (iCal *ICalendarDto) getRRuleEndYear(evt ICalEventDto) int {
+
+	if evt.RRule == "" {
+		return 0
+	}
+
+	rRule, err := RecurrenceRuleDto{}.New(evt.RRule, evt.Start)
+
+	if err != nil {
+		return iCal.getRRuleUntilYear(evt.RRule)
+	}
+
+	if rRule.Count == 0 && rRule.Until.DateTime.IsZero() {
+
+		year := evt.Start.DateTime.Year()
+
+		if nowYear := time.Now().Year(); nowYear > year {
+			year = nowYear
+		}
+
+		return year + iCalOpenRRuleYears
+	}
+
+	occurrences, err := rRule.GetOccurrences(0)
+
+	if err != nil || len(occurrences) == 0 {
+		return iCal.getRRuleUntilYear(evt.RRule)
+	}
+
+	lastEnd := occurrences[len(occurrences)-1].DateTime
+
+	if !evt.End.DateTime.IsZero() {
+		lastEnd = lastEnd.Add(evt.End.DateTime.Sub(evt.Start.DateTime))
+	}
+
+	return lastEnd.In(evt.Start.DateTime.Location()).Year()
+}
+
+// getRRuleUntilYear - Returns the year of the UNTIL part of 'rRule'.
+// Returns zero if 'rRule' has no UNTIL part.
+func (iCal *ICalendarDto) getRRuleUntilYear(rRule string) int {
+
+	for _, part := range strings.Split(rRule, ";") {
+
+		if !strings.HasPrefix(strings.ToUpper(part), "UNTIL=") || len(part) < 10 {
+			continue
+		}
+
+		year, err := strconv.Atoi(part[6:10])
+
+		if err == nil {
+			return year
+		}
+	}
+
+	return 0
+}
+
+// isUTCLocation - Returns 'true' if date times in 'loc' are exported in
+// UTC form. Date times in time zone "Local" are exported as UTC because
+// "Local" is not a portable TZID.
+func (iCal *ICalendarDto) isUTCLocation(loc *time.Location) bool {
+
+	switch loc.String() {
+
+	case "UTC", TzIanaUTC, "Local":
+		return true
+	}
+
+	return false
+}
+
+// newEvent - Creates an ICalEventDto from the content lines of a VEVENT.
+func (iCal *ICalendarDto) newEvent(
+	props []string,
+	tzIdLocations map[string]string,
+	defaultLoc *time.Location,
+	dateTimeFmtStr string) (ICalEventDto, error) {
+
+	evt := ICalEventDto{}
+
+	var start, end time.Time
+	var durationValue string
+
+	parseDateTime := func(name string, params map[string]string, value string) (time.Time, bool, error) {
+
+		tzId := params["TZID"]
+
+		if ianaName, ok := tzIdLocations[tzId]; ok {
+
+			if _, err := time.LoadLocation(tzId); err != nil {
+				tzId = ianaName
+			}
+		}
+
+		t, isDate, err := parseICalDateTime(value, tzId, defaultLoc)
+
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("%v %v UID='%v'", name, err.Error(), evt.UID)
+		}
+
+		return t, isDate, nil
+	}
+
+	for _, line := range props {
+
+		name, params, value, _ := parseICalContentLine(line)
+
+		switch name {
+
+		case "UID":
+			evt.UID = unescapeICalText(value)
+
+		case "SUMMARY":
+			evt.Summary = unescapeICalText(value)
+
+		case "DESCRIPTION":
+			evt.Description = unescapeICalText(value)
+
+		case "LOCATION":
+			evt.Location = unescapeICalText(value)
+
+		case "RRULE":
+			evt.RRule = value
+
+		case "DURATION":
+			durationValue = value
+
+		case "DTSTAMP":
+
+			t, _, err := parseDateTime(name, params, value)
+
+			if err != nil {
+				return ICalEventDto{}, err
+			}
+
+			evt.DtStamp = t
+
+		case "DTSTART":
+
+			t, isDate, err := parseDateTime(name, params, value)
+
+			if err != nil {
+				return ICalEventDto{}, err
+			}
+
+			start = t
+			evt.IsAllDay = isDate
+			evt.IsFloating = !isDate && len(params["TZID"]) == 0 && !strings.HasSuffix(strings.ToUpper(value), "Z")
+
+		case "DTEND":
+
+			t, _, err := parseDateTime(name, params, value)
+
+			if err != nil {
+				return ICalEventDto{}, err
+			}
+
+			end = t
+		}
+	}
+
+	if start.IsZero() {
+		return ICalEventDto{}, fmt.Errorf("Error: VEVENT DTSTART property is missing! UID='%v'", evt.UID)
+	}
+
+	switch {
+
+	case !end.IsZero() && len(durationValue) > 0:
+
+		return ICalEventDto{}, fmt.Errorf("Error: VEVENT contains both DTEND and DURATION. UID='%v'", evt.UID)
+
+	case len(durationValue) > 0:
+
+		days, exact, err := parseICalDuration(durationValue)
+
+		if err != nil {
+			return ICalEventDto{}, fmt.Errorf("%v UID='%v'", err.Error(), evt.UID)
+		}
+
+		// Nominal days preserve the wall clock time across Daylight
+		// Savings Time transitions.
+		end = start.AddDate(0, 0, days).Add(exact)
+
+	case end.IsZero() && evt.IsAllDay:
+
+		end = start.AddDate(0, 0, 1)
+
+	case end.IsZero():
+
+		end = start
+	}
+
+	if end.Before(start) {
+		return ICalEventDto{}, fmt.Errorf("Error: VEVENT ends before it starts. UID='%v'", evt.UID)
+	}
+
+	var err error
+
+	evt.Start, err = DateTzDto{}.New(start, dateTimeFmtStr)
+
+	if err != nil {
+		return ICalEventDto{}, err
+	}
+
+	evt.End, err = DateTzDto{}.New(end, dateTimeFmtStr)
+
+	if err != nil {
+		return ICalEventDto{}, err
+	}
+
+	evt.Duration, err = TimeDurationDto{}.NewStartEndDateTzDto(evt.Start, evt.End, evt.Start.DateTimeFmt)
+
+	if err != nil {
+		return ICalEventDto{}, err
+	}
+
+	return evt, nil
+}
+
+// preProcessTimeZoneLocation - Converts an empty time zone location to
+// "Etc/UTC" and standardizes the value "Local".
+func (iCal *ICalendarDto) preProcessTimeZoneLocation(timeZoneLocation string) string {
+
+	if len(timeZoneLocation) == 0 {
+		return TzIanaUTC
+	}
+
+	if strings.ToLower(timeZoneLocation) == "local" {
+		return "Local"
+	}
+
+	return timeZoneLocation
+}
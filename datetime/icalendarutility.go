@@ -0,0 +1,519 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+/*
+ iCalendar Utility
+ =================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\icalendarutility.go
+
+ Overview and Usage
+ ==================
+ This source file contains internal helper functions used to read and
+ write iCalendar (RFC 5545) content lines and date time values.
+
+ Content lines have the form:
+
+	NAME;PARAM1=VALUE1;PARAM2="VALUE2":VALUE
+
+ Long content lines are folded by inserting a line break followed by a
+ single space or tab. 'unfoldICalLines()' reverses the folding.
+
+ Date time values:
+
+	20261019T083000Z  - UTC date time
+	20261019T083000   - Local date time. The time zone is taken from the
+	                    TZID parameter. If there is no TZID parameter, the
+	                    default location is used (floating time).
+	20261019          - Date (VALUE=DATE)
+
+ Local date times which do not exist because of a Daylight Savings Time
+ gap are interpreted using the UTC offset in effect before the gap.
+ Local date times which occur twice are interpreted as the first
+ occurrence. See RFC 5545 Section 3.3.5.
+
+ VTIMEZONE components are generated from the actual transition history
+ of an IANA time zone. See 'getVTimeZoneLines()'.
+
+*/
+
+// iCalWeekDayCodes - Two letter iCalendar weekday codes indexed
+// by time.Weekday.
+var iCalWeekDayCodes = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// escapeICalText - Escapes backslashes, semicolons, commas and new
+// lines in an iCalendar TEXT value. See RFC 5545 Section 3.3.11.
+func escapeICalText(text string) string {
+
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\r\n", "\\n",
+		"\n", "\\n")
+
+	return replacer.Replace(text)
+}
+
+// foldICalLine - Folds an iCalendar content line so that no line
+// exceeds 75 octets. Continuation lines begin with a single space.
+// Multi-byte UTF-8 characters are never split.
+func foldICalLine(line string) string {
+
+	const maxOctets = 75
+
+	var b strings.Builder
+
+	lineLen := 0
+
+	for _, r := range line {
+
+		runeLen := utf8.RuneLen(r)
+
+		if lineLen+runeLen > maxOctets {
+			b.WriteString("\r\n ")
+			lineLen = 1
+		}
+
+		b.WriteRune(r)
+		lineLen += runeLen
+	}
+
+	return b.String()
+}
+
+// formatICalDateTime - Formats date time 't' as an iCalendar local
+// date time using the wall clock time of 't'. Example: "20261019T083000"
+func formatICalDateTime(t time.Time) string {
+
+	return t.Format("20060102T150405")
+}
+
+// formatICalUTCDateTime - Formats date time 't' as an iCalendar UTC
+// date time. Example: "20261019T133000Z"
+func formatICalUTCDateTime(t time.Time) string {
+
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// formatICalUTCOffset - Formats a UTC offset expressed in seconds as an
+// iCalendar UTC-OFFSET value. Examples: "-0500", "+0530", "-001530"
+func formatICalUTCOffset(offsetSeconds int) string {
+
+	sign := "+"
+
+	if offsetSeconds < 0 {
+		sign = "-"
+		offsetSeconds = -offsetSeconds
+	}
+
+	hours := offsetSeconds / 3600
+	minutes := (offsetSeconds % 3600) / 60
+	seconds := offsetSeconds % 60
+
+	if seconds != 0 {
+		return fmt.Sprintf("%v%02d%02d%02d", sign, hours, minutes, seconds)
+	}
+
+	return fmt.Sprintf("%v%02d%02d", sign, hours, minutes)
+}
+
+// getVTimeZoneLines - Generates the content lines of a VTIMEZONE
+// component for location 'loc' from the location's actual transition
+// history. The component covers the years 'startYear' through 'endYear'.
+// A STANDARD or DAYLIGHT sub-component is generated for the offset in
+// effect at the beginning of 'startYear' and for each transition
+// occurring before the end of 'endYear'.
+func getVTimeZoneLines(loc *time.Location, startYear, endYear int) []string {
+
+	lines := []string{
+		"BEGIN:VTIMEZONE",
+		"TZID:" + loc.String(),
+		"X-LIC-LOCATION:" + loc.String(),
+	}
+
+	rangeStart := time.Date(startYear, time.January, 1, 0, 0, 0, 0, loc)
+	rangeEnd := time.Date(endYear+1, time.January, 1, 0, 0, 0, 0, loc)
+
+	addSubComponent := func(transition time.Time, dtStart string, offsetFrom int) {
+
+		componentName := "STANDARD"
+
+		if transition.IsDST() {
+			componentName = "DAYLIGHT"
+		}
+
+		zoneName, offsetTo := transition.Zone()
+
+		lines = append(lines,
+			"BEGIN:"+componentName,
+			"DTSTART:"+dtStart,
+			"TZOFFSETFROM:"+formatICalUTCOffset(offsetFrom),
+			"TZOFFSETTO:"+formatICalUTCOffset(offsetTo),
+			"TZNAME:"+zoneName,
+			"END:"+componentName)
+	}
+
+	// The offset in effect at the beginning of the range
+	zoneStart, zoneEnd := rangeStart.ZoneBounds()
+
+	_, offset := rangeStart.Zone()
+
+	if zoneStart.IsZero() {
+		addSubComponent(rangeStart, "16010101T000000", offset)
+	} else {
+		_, offsetFrom := zoneStart.Add(-time.Nanosecond).Zone()
+
+		addSubComponent(zoneStart,
+			formatICalDateTime(zoneStart.In(time.FixedZone("", offsetFrom))), offsetFrom)
+	}
+
+	// Transitions within the range. DTSTART is expressed as a local
+	// time using the offset in effect before the transition.
+	for transition := zoneEnd; !transition.IsZero() && transition.Before(rangeEnd); {
+
+		_, offsetFrom := transition.Add(-time.Nanosecond).Zone()
+
+		addSubComponent(transition,
+			formatICalDateTime(transition.In(time.FixedZone("", offsetFrom))), offsetFrom)
+
+		_, transition = transition.ZoneBounds()
+	}
+
+	return append(lines, "END:VTIMEZONE")
+}
+
+// parseICalContentLine - Splits an unfolded iCalendar content line into
+// its name, parameters and value. Names and parameter names are converted
+// to upper case. Quotes are removed from quoted parameter values.
+func parseICalContentLine(line string) (string, map[string]string, string, error) {
+
+	params := make(map[string]string)
+
+	// Locate the colon separating the value. Colons inside quoted
+	// parameter values are ignored.
+	inQuotes := false
+	colon := -1
+
+	for i := 0; i < len(line); i++ {
+
+		if line[i] == '"' {
+			inQuotes = !inQuotes
+			continue
+		}
+
+		if line[i] == ':' && !inQuotes {
+			colon = i
+			break
+		}
+	}
+
+	if colon < 0 {
+		return "", nil, "",
+			fmt.Errorf("Error: Invalid iCalendar content line. Missing ':'. line='%v'", line)
+	}
+
+	value := line[colon+1:]
+
+	elements := splitICalOutsideQuotes(line[:colon], ';')
+
+	name := strings.ToUpper(strings.TrimSpace(elements[0]))
+
+	if len(name) == 0 {
+		return "", nil, "",
+			fmt.Errorf("Error: Invalid iCalendar content line. Missing name. line='%v'", line)
+	}
+
+	for _, param := range elements[1:] {
+
+		eq := strings.Index(param, "=")
+
+		if eq < 0 {
+			return "", nil, "",
+				fmt.Errorf("Error: Invalid iCalendar parameter. param='%v' line='%v'", param, line)
+		}
+
+		paramValue := param[eq+1:]
+
+		if len(paramValue) >= 2 && paramValue[0] == '"' && paramValue[len(paramValue)-1] == '"' {
+			paramValue = paramValue[1 : len(paramValue)-1]
+		}
+
+		params[strings.ToUpper(strings.TrimSpace(param[:eq]))] = paramValue
+	}
+
+	return name, params, value, nil
+}
+
+// parseICalDateTime - Parses an iCalendar DATE or DATE-TIME value. Local
+// date times are interpreted in the time zone identified by 'tzid'. If
+// 'tzid' is an empty string, 'defaultLoc' is used. The boolean return
+// value is 'true' if 'value' is a DATE value.
+func parseICalDateTime(value, tzid string, defaultLoc *time.Location) (time.Time, bool, error) {
+
+	value = strings.TrimSpace(value)
+
+	loc := defaultLoc
+
+	if len(tzid) > 0 {
+
+		var err error
+
+		loc, err = time.LoadLocation(tzid)
+
+		if err != nil {
+			return time.Time{}, false,
+				fmt.Errorf("Error: Invalid TZID. tzid='%v' Error='%v'", tzid, err.Error())
+		}
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	switch len(value) {
+
+	case 8:
+
+		d, err := time.Parse("20060102", value)
+
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("Error: Invalid iCalendar DATE. value='%v'", value)
+		}
+
+		return startOfLocalDay(d.Year(), d.Month(), d.Day(), loc), true, nil
+
+	case 16:
+
+		if value[15] != 'Z' && value[15] != 'z' {
+			break
+		}
+
+		t, err := time.Parse("20060102T150405", value[:15])
+
+		if err != nil {
+			break
+		}
+
+		return t, false, nil
+
+	case 15:
+
+		naive, err := time.Parse("20060102T150405", value)
+
+		if err != nil {
+			break
+		}
+
+		return rfc5545LocalInstant(naive, loc), false, nil
+	}
+
+	return time.Time{}, false, fmt.Errorf("Error: Invalid iCalendar DATE-TIME. value='%v'", value)
+}
+
+// parseICalDuration - Parses an iCalendar DURATION value. Weeks and days
+// are returned as a nominal number of days. Hours, minutes and seconds are
+// returned as an exact time.Duration. Examples: "P1W", "P1DT2H30M", "-PT15M"
+// See RFC 5545 Section 3.3.6.
+func parseICalDuration(value string) (int, time.Duration, error) {
+
+	value = strings.ToUpper(strings.TrimSpace(value))
+
+	errInvalid := fmt.Errorf("Error: Invalid iCalendar DURATION. value='%v'", value)
+
+	sign := 1
+
+	if strings.HasPrefix(value, "-") {
+		sign = -1
+		value = value[1:]
+	} else if strings.HasPrefix(value, "+") {
+		value = value[1:]
+	}
+
+	if !strings.HasPrefix(value, "P") || len(value) < 3 {
+		return 0, 0, errInvalid
+	}
+
+	value = value[1:]
+
+	days := 0
+	var exact time.Duration
+
+	inTime := false
+	number := ""
+	hasElement := false
+	hasTimeElement := false
+
+	for _, r := range value {
+
+		if r >= '0' && r <= '9' {
+			number += string(r)
+			continue
+		}
+
+		if r == 'T' {
+
+			if inTime || len(number) > 0 {
+				return 0, 0, errInvalid
+			}
+
+			inTime = true
+			continue
+		}
+
+		if len(number) == 0 {
+			return 0, 0, errInvalid
+		}
+
+		n, err := strconv.Atoi(number)
+
+		if err != nil {
+			return 0, 0, errInvalid
+		}
+
+		number = ""
+		hasElement = true
+		hasTimeElement = inTime
+
+		switch {
+
+		case r == 'W' && !inTime:
+			days += n * 7
+
+		case r == 'D' && !inTime:
+			days += n
+
+		case r == 'H' && inTime:
+			exact += time.Duration(n) * time.Hour
+
+		case r == 'M' && inTime:
+			exact += time.Duration(n) * time.Minute
+
+		case r == 'S' && inTime:
+			exact += time.Duration(n) * time.Second
+
+		default:
+			return 0, 0, errInvalid
+		}
+	}
+
+	if len(number) > 0 || !hasElement || (inTime && !hasTimeElement) {
+		return 0, 0, errInvalid
+	}
+
+	return sign * days, time.Duration(sign) * exact, nil
+}
+
+// rfc5545LocalInstant - Converts the wall clock reading 'naive' (expressed
+// in UTC) to an instant in location 'loc'. Wall clock times falling in a
+// Daylight Savings Time gap are interpreted using the UTC offset in effect
+// before the gap. Wall clock times occurring twice resolve to the first
+// occurrence.
+func rfc5545LocalInstant(naive time.Time, loc *time.Location) time.Time {
+
+	y, m, d := naive.Date()
+
+	wallNs := naive.Sub(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)).Nanoseconds()
+
+	candidate := resolveWallClock(y, m, d, wallNs, loc)[0]
+
+	if wallClockAsUTC(candidate).Equal(naive) {
+		return candidate
+	}
+
+	// The wall clock time falls in a gap. 'candidate' is the first
+	// instant following the gap.
+	_, offsetBefore := candidate.Add(-time.Nanosecond).Zone()
+
+	return naive.Add(-time.Duration(offsetBefore) * time.Second).In(loc)
+}
+
+// splitICalOutsideQuotes - Splits 's' at each occurrence of 'sep' which
+// is not enclosed in double quotes.
+func splitICalOutsideQuotes(s string, sep byte) []string {
+
+	elements := make([]string, 0, 4)
+
+	inQuotes := false
+	start := 0
+
+	for i := 0; i < len(s); i++ {
+
+		if s[i] == '"' {
+			inQuotes = !inQuotes
+			continue
+		}
+
+		if s[i] == sep && !inQuotes {
+			elements = append(elements, s[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(elements, s[start:])
+}
+
+// unescapeICalText - Reverses the escaping of an iCalendar TEXT value.
+func unescapeICalText(text string) string {
+
+	var b strings.Builder
+
+	for i := 0; i < len(text); i++ {
+
+		if text[i] != '\\' || i == len(text)-1 {
+			b.WriteByte(text[i])
+			continue
+		}
+
+		i++
+
+		switch text[i] {
+
+		case 'n', 'N':
+			b.WriteByte('\n')
+
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+
+	return b.String()
+}
+
+// unfoldICalLines - Splits iCalendar text into content lines. Folded
+// lines are joined and empty lines are discarded.
+func unfoldICalLines(text string) []string {
+
+	rawLines := strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n")
+
+	lines := make([]string, 0, len(rawLines))
+
+	for _, raw := range rawLines {
+
+		raw = strings.TrimRight(raw, "\r")
+
+		if len(raw) > 0 && (raw[0] == ' ' || raw[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += raw[1:]
+			continue
+		}
+
+		if len(strings.TrimSpace(raw)) == 0 {
+			continue
+		}
+
+		lines = append(lines, raw)
+	}
+
+	return lines
+}
@@ -0,0 +1,277 @@
+package datetime
+
+import (
+	"sort"
+	"time"
+)
+
+/*
+ IntervalSet
+ ===========
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\intervalset.go
+
+ Overview and Usage
+ ==================
+ The 'IntervalSet' Type holds a normalized collection of half-open time
+ intervals (TimeIntervalDto). Normalized means:
+
+	(1) Empty intervals are discarded.
+	(2) Overlapping and adjacent intervals are merged.
+	(3) The intervals are sorted in ascending order.
+
+ As a result, the intervals of an IntervalSet are always disjoint and
+ separated by gaps.
+
+ Example:
+
+	bookings := IntervalSet{}.New(booking1, booking2, booking3)
+
+	free := workingHours.Difference(bookings)
+
+*/
+
+// IntervalSet - A normalized collection of half-open time intervals
+type IntervalSet struct {
+	intervals []TimeIntervalDto
+}
+
+// Add - Adds one or more intervals to the current IntervalSet. The set
+// is normalized after the intervals are added.
+func (iSet *IntervalSet) Add(intervals ...TimeIntervalDto) {
+
+	for _, interval := range intervals {
+		iSet.intervals = append(iSet.intervals, interval.CopyOut())
+	}
+
+	iSet.normalize()
+}
+
+// Contains - Returns 'true' if 'dateTime' falls within one of the
+// intervals of the current IntervalSet.
+func (iSet *IntervalSet) Contains(dateTime DateTzDto) bool {
+
+	t := dateTime.DateTime
+
+	// First interval ending after 't'
+	idx := sort.Search(len(iSet.intervals), func(i int) bool {
+		return iSet.intervals[i].EndTime.DateTime.After(t)
+	})
+
+	return idx < len(iSet.intervals) && iSet.intervals[idx].Contains(dateTime)
+}
+
+// CopyOut - Returns a deep copy of the current IntervalSet.
+func (iSet *IntervalSet) CopyOut() IntervalSet {
+
+	iSet2 := IntervalSet{}
+
+	iSet2.intervals = iSet.GetIntervals()
+
+	return iSet2
+}
+
+// Difference - Returns a new IntervalSet containing the portions of the
+// current IntervalSet which are not covered by 'other'.
+func (iSet *IntervalSet) Difference(other IntervalSet) IntervalSet {
+
+	result := IntervalSet{}
+
+	j := 0
+
+	for _, interval := range iSet.intervals {
+
+		// Skip intervals of 'other' which end before 'interval' starts.
+		for j < len(other.intervals) &&
+			!other.intervals[j].EndTime.DateTime.After(interval.StartTime.DateTime) {
+			j++
+		}
+
+		// 'cursor' marks the start of the portion of 'interval' not yet
+		// examined.
+		cursor := interval.StartTime
+
+		for k := j; k < len(other.intervals) &&
+			other.intervals[k].StartTime.DateTime.Before(interval.EndTime.DateTime); k++ {
+
+			if other.intervals[k].StartTime.DateTime.After(cursor.DateTime) {
+				result.intervals = append(result.intervals,
+					TimeIntervalDto{StartTime: cursor.CopyOut(), EndTime: other.intervals[k].StartTime.CopyOut()})
+			}
+
+			if other.intervals[k].EndTime.DateTime.After(cursor.DateTime) {
+				cursor = other.intervals[k].EndTime
+			}
+		}
+
+		if cursor.DateTime.Before(interval.EndTime.DateTime) {
+			result.intervals = append(result.intervals,
+				TimeIntervalDto{StartTime: cursor.CopyOut(), EndTime: interval.EndTime.CopyOut()})
+		}
+	}
+
+	return result
+}
+
+// GetGaps - Returns the gaps separating consecutive intervals of the
+// current IntervalSet.
+func (iSet *IntervalSet) GetGaps() []TimeIntervalDto {
+
+	gaps := make([]TimeIntervalDto, 0, len(iSet.intervals))
+
+	for i := 1; i < len(iSet.intervals); i++ {
+
+		gap, ok := iSet.intervals[i-1].Gap(iSet.intervals[i])
+
+		if ok {
+			gaps = append(gaps, gap)
+		}
+	}
+
+	return gaps
+}
+
+// GetIntervals - Returns a copy of the intervals of the current
+// IntervalSet in ascending order.
+func (iSet *IntervalSet) GetIntervals() []TimeIntervalDto {
+
+	intervals := make([]TimeIntervalDto, len(iSet.intervals))
+
+	for i := range iSet.intervals {
+		intervals[i] = iSet.intervals[i].CopyOut()
+	}
+
+	return intervals
+}
+
+// GetNumberOfIntervals - Returns the number of disjoint intervals in the
+// current IntervalSet.
+func (iSet *IntervalSet) GetNumberOfIntervals() int {
+
+	return len(iSet.intervals)
+}
+
+// GetTotalDuration - Returns the sum of the durations of all intervals
+// in the current IntervalSet.
+func (iSet *IntervalSet) GetTotalDuration() time.Duration {
+
+	var total time.Duration
+
+	for i := range iSet.intervals {
+		total += iSet.intervals[i].GetDuration()
+	}
+
+	return total
+}
+
+// Intersection - Returns a new IntervalSet containing the instants
+// common to the current IntervalSet and 'other'.
+func (iSet *IntervalSet) Intersection(other IntervalSet) IntervalSet {
+
+	result := IntervalSet{}
+
+	i, j := 0, 0
+
+	for i < len(iSet.intervals) && j < len(other.intervals) {
+
+		a := iSet.intervals[i]
+		b := other.intervals[j]
+
+		if common, ok := a.Intersection(b); ok {
+			result.intervals = append(result.intervals, common)
+		}
+
+		if a.EndTime.DateTime.Before(b.EndTime.DateTime) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	result.normalize()
+
+	return result
+}
+
+// IsEmpty - Returns 'true' if the current IntervalSet contains no
+// intervals.
+func (iSet *IntervalSet) IsEmpty() bool {
+
+	return len(iSet.intervals) == 0
+}
+
+// New - Creates a new, normalized IntervalSet from zero or more
+// intervals.
+func (iSet IntervalSet) New(intervals ...TimeIntervalDto) IntervalSet {
+
+	iSet2 := IntervalSet{}
+
+	iSet2.Add(intervals...)
+
+	return iSet2
+}
+
+// Overlaps - Returns 'true' if 'interval' overlaps one of the intervals
+// of the current IntervalSet.
+func (iSet *IntervalSet) Overlaps(interval TimeIntervalDto) bool {
+
+	// First interval ending after 'interval' starts
+	idx := sort.Search(len(iSet.intervals), func(i int) bool {
+		return iSet.intervals[i].EndTime.DateTime.After(interval.StartTime.DateTime)
+	})
+
+	return idx < len(iSet.intervals) && iSet.intervals[idx].Overlaps(interval)
+}
+
+// Union - Returns a new IntervalSet containing the instants of the
+// current IntervalSet and 'other'.
+func (iSet *IntervalSet) Union(other IntervalSet) IntervalSet {
+
+	result := iSet.CopyOut()
+
+	result.Add(other.intervals...)
+
+	return result
+}
+
+// normalize - Discards empty intervals, sorts the remaining intervals
+// and merges overlapping and adjacent intervals.
+func (iSet *IntervalSet) normalize() {
+
+	intervals := make([]TimeIntervalDto, 0, len(iSet.intervals))
+
+	for _, interval := range iSet.intervals {
+
+		if !interval.IsEmpty() {
+			intervals = append(intervals, interval)
+		}
+	}
+
+	sort.SliceStable(intervals, func(i, j int) bool {
+		return intervals[i].StartTime.DateTime.Before(intervals[j].StartTime.DateTime)
+	})
+
+	merged := make([]TimeIntervalDto, 0, len(intervals))
+
+	for _, interval := range intervals {
+
+		last := len(merged) - 1
+
+		if last >= 0 && !interval.StartTime.DateTime.After(merged[last].EndTime.DateTime) {
+
+			if interval.EndTime.DateTime.After(merged[last].EndTime.DateTime) {
+				merged[last].EndTime = interval.EndTime
+			}
+
+			continue
+		}
+
+		merged = append(merged, interval)
+	}
+
+	iSet.intervals = merged
+}
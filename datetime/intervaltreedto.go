@@ -0,0 +1,553 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+/*
+ IntervalTreeDto
+ ===============
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\intervaltreedto.go
+
+ Overview and Usage
+ ==================
+ The 'IntervalTreeDto' Type indexes a large number of half-open time
+ intervals (TimeIntervalDto) for fast conflict checks.
+
+ The index is an augmented AVL tree ordered by interval start time. Each
+ node records the latest end time found in its subtree, which allows
+ queries to skip subtrees that cannot contain a match.
+
+	Operation                  Time Complexity
+	---------                  ---------------
+	Insert, Delete             O(log n)
+	HasOverlap                 O(log n)
+	GetCovering                O(log n + k)   k = number of matches
+	GetOverlapping             O(log n + k)
+	FindFreeSlot               O(m log n + k) m = number of times the
+	                                          search is blocked
+
+ Each entry is identified by its interval and an 'Id' string. The same
+ interval may be stored under different Ids.
+
+ Example:
+
+	tree := IntervalTreeDto{}.New()
+
+	tree.Insert(booking.Interval, booking.Id)
+
+	if tree.HasOverlap(requestedInterval) {
+		// booking conflict
+	}
+
+	covering := tree.GetCovering(dateTimeDtz)   // "what covers 14:05?"
+
+*/
+
+// IntervalTreeEntryDto - An interval stored in an IntervalTreeDto
+type IntervalTreeEntryDto struct {
+	Interval TimeIntervalDto // The indexed interval
+	Id       string          // Identifies the entry. Example: a booking number
+}
+
+// intervalTreeNode - A node of an IntervalTreeDto
+type intervalTreeNode struct {
+	entry  IntervalTreeEntryDto
+	left   *intervalTreeNode
+	right  *intervalTreeNode
+	height int
+	maxEnd time.Time // Latest end time of the non-empty intervals in the subtree
+}
+
+// IntervalTreeDto - An index of time intervals supporting point and
+// range queries.
+type IntervalTreeDto struct {
+	root  *intervalTreeNode
+	count int
+}
+
+// Delete - Removes the entry with the specified interval and 'id'.
+// Returns 'true' if an entry was removed.
+func (iTree *IntervalTreeDto) Delete(interval TimeIntervalDto, id string) bool {
+
+	removed := false
+
+	iTree.root = iTree.deleteNode(iTree.root, IntervalTreeEntryDto{Interval: interval, Id: id}, &removed)
+
+	if removed {
+		iTree.count--
+	}
+
+	return removed
+}
+
+// FindFreeSlot - Returns the earliest interval of length 'duration' which
+// starts at or after 'searchStart', ends at or before 'searchEnd' and does
+// not overlap any indexed interval. If no free slot exists, the boolean
+// return value is 'false'.
+func (iTree *IntervalTreeDto) FindFreeSlot(
+	searchStart,
+	searchEnd DateTzDto,
+	duration time.Duration) (TimeIntervalDto, bool, error) {
+
+	ePrefix := "IntervalTreeDto.FindFreeSlot() "
+
+	if duration <= 0 {
+		return TimeIntervalDto{}, false,
+			fmt.Errorf(ePrefix + "Error: 'duration' must be greater than zero. duration='%v'", duration)
+	}
+
+	if searchStart.DateTime.IsZero() || searchEnd.DateTime.IsZero() {
+		return TimeIntervalDto{}, false,
+			errors.New(ePrefix + "Error: 'searchStart' and 'searchEnd' must be initialized!")
+	}
+
+	candidate := searchStart.DateTime
+
+	for {
+
+		candidateEnd := candidate.Add(duration)
+
+		if candidateEnd.After(searchEnd.DateTime) {
+			return TimeIntervalDto{}, false, nil
+		}
+
+		// The latest end time of the intervals blocking the candidate
+		blockedUntil, blocked := iTree.maxOverlappingEnd(iTree.root, candidate, candidateEnd)
+
+		if !blocked {
+			break
+		}
+
+		candidate = blockedUntil
+	}
+
+	startDtz, err := DateTzDto{}.New(candidate.In(searchStart.DateTime.Location()), searchStart.DateTimeFmt)
+
+	if err != nil {
+		return TimeIntervalDto{}, false, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	slot, err := TimeIntervalDto{}.NewStartDuration(startDtz, duration)
+
+	if err != nil {
+		return TimeIntervalDto{}, false, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return slot, true, nil
+}
+
+// GetAll - Returns all entries ordered by interval start time.
+func (iTree *IntervalTreeDto) GetAll() []IntervalTreeEntryDto {
+
+	entries := make([]IntervalTreeEntryDto, 0, iTree.count)
+
+	var walk func(node *intervalTreeNode)
+
+	walk = func(node *intervalTreeNode) {
+
+		if node == nil {
+			return
+		}
+
+		walk(node.left)
+		entries = append(entries, node.entry)
+		walk(node.right)
+	}
+
+	walk(iTree.root)
+
+	return entries
+}
+
+// GetCovering - Returns the entries whose intervals contain 'dateTime'
+// (a stabbing query). Entries are ordered by interval start time.
+func (iTree *IntervalTreeDto) GetCovering(dateTime DateTzDto) []IntervalTreeEntryDto {
+
+	t := dateTime.DateTime
+
+	entries := make([]IntervalTreeEntryDto, 0, 4)
+
+	var search func(node *intervalTreeNode)
+
+	search = func(node *intervalTreeNode) {
+
+		// No interval in this subtree ends after 't'.
+		if node == nil || !node.maxEnd.After(t) {
+			return
+		}
+
+		search(node.left)
+
+		// Nodes to the right start after this node.
+		if node.entry.Interval.StartTime.DateTime.After(t) {
+			return
+		}
+
+		if node.entry.Interval.EndTime.DateTime.After(t) {
+			entries = append(entries, node.entry)
+		}
+
+		search(node.right)
+	}
+
+	search(iTree.root)
+
+	return entries
+}
+
+// GetLength - Returns the number of entries in the current IntervalTreeDto.
+func (iTree *IntervalTreeDto) GetLength() int {
+
+	return iTree.count
+}
+
+// GetOverlapping - Returns the entries whose intervals overlap 'interval'.
+// Entries are ordered by interval start time.
+func (iTree *IntervalTreeDto) GetOverlapping(interval TimeIntervalDto) []IntervalTreeEntryDto {
+
+	entries := make([]IntervalTreeEntryDto, 0, 4)
+
+	if interval.IsEmpty() {
+		return entries
+	}
+
+	qStart := interval.StartTime.DateTime
+	qEnd := interval.EndTime.DateTime
+
+	var search func(node *intervalTreeNode)
+
+	search = func(node *intervalTreeNode) {
+
+		if node == nil || !node.maxEnd.After(qStart) {
+			return
+		}
+
+		search(node.left)
+
+		if !node.entry.Interval.StartTime.DateTime.Before(qEnd) {
+			return
+		}
+
+		if node.entry.Interval.Overlaps(interval) {
+			entries = append(entries, node.entry)
+		}
+
+		search(node.right)
+	}
+
+	search(iTree.root)
+
+	return entries
+}
+
+// HasOverlap - Returns 'true' if any indexed interval overlaps 'interval'.
+func (iTree *IntervalTreeDto) HasOverlap(interval TimeIntervalDto) bool {
+
+	if interval.IsEmpty() {
+		return false
+	}
+
+	qStart := interval.StartTime.DateTime
+
+	node := iTree.root
+
+	// If the left subtree contains an interval ending after 'qStart' but
+	// none of its intervals overlap, every interval in the right subtree
+	// starts too late to overlap.
+	for node != nil {
+
+		if node.entry.Interval.Overlaps(interval) {
+			return true
+		}
+
+		if node.left != nil && node.left.maxEnd.After(qStart) {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+
+	return false
+}
+
+// Insert - Adds an interval identified by 'id' to the current
+// IntervalTreeDto. If an entry with the same interval and 'id' already
+// exists, it is replaced.
+func (iTree *IntervalTreeDto) Insert(interval TimeIntervalDto, id string) {
+
+	added := false
+
+	iTree.root = iTree.insertNode(iTree.root, IntervalTreeEntryDto{Interval: interval, Id: id}, &added)
+
+	if added {
+		iTree.count++
+	}
+}
+
+// New - Creates a new, empty IntervalTreeDto.
+func (iTree IntervalTreeDto) New() IntervalTreeDto {
+
+	return IntervalTreeDto{}
+}
+
+// compareEntries - Orders entries by start time, end time and Id.
+func (iTree *IntervalTreeDto) compareEntries(a, b *IntervalTreeEntryDto) int {
+
+	aStart, bStart := a.Interval.StartTime.DateTime, b.Interval.StartTime.DateTime
+
+	if aStart.Before(bStart) {
+		return -1
+	}
+
+	if aStart.After(bStart) {
+		return 1
+	}
+
+	aEnd, bEnd := a.Interval.EndTime.DateTime, b.Interval.EndTime.DateTime
+
+	if aEnd.Before(bEnd) {
+		return -1
+	}
+
+	if aEnd.After(bEnd) {
+		return 1
+	}
+
+	if a.Id < b.Id {
+		return -1
+	}
+
+	if a.Id > b.Id {
+		return 1
+	}
+
+	return 0
+}
+
+// deleteNode - Removes 'entry' from the subtree rooted at 'node' and
+// returns the new subtree root.
+func (iTree *IntervalTreeDto) deleteNode(
+	node *intervalTreeNode,
+	entry IntervalTreeEntryDto,
+	removed *bool) *intervalTreeNode {
+
+	if node == nil {
+		return nil
+	}
+
+	cmp := iTree.compareEntries(&entry, &node.entry)
+
+	switch {
+
+	case cmp < 0:
+		node.left = iTree.deleteNode(node.left, entry, removed)
+
+	case cmp > 0:
+		node.right = iTree.deleteNode(node.right, entry, removed)
+
+	default:
+
+		*removed = true
+
+		if node.left == nil {
+			return node.right
+		}
+
+		if node.right == nil {
+			return node.left
+		}
+
+		// Replace with the in-order successor.
+		successor := node.right
+
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		node.entry = successor.entry
+
+		ignored := false
+
+		node.right = iTree.deleteNode(node.right, successor.entry, &ignored)
+	}
+
+	return iTree.rebalance(node)
+}
+
+// insertNode - Inserts 'entry' into the subtree rooted at 'node' and
+// returns the new subtree root.
+func (iTree *IntervalTreeDto) insertNode(
+	node *intervalTreeNode,
+	entry IntervalTreeEntryDto,
+	added *bool) *intervalTreeNode {
+
+	if node == nil {
+
+		*added = true
+
+		node = &intervalTreeNode{entry: entry}
+
+		iTree.update(node)
+
+		return node
+	}
+
+	cmp := iTree.compareEntries(&entry, &node.entry)
+
+	switch {
+
+	case cmp < 0:
+		node.left = iTree.insertNode(node.left, entry, added)
+
+	case cmp > 0:
+		node.right = iTree.insertNode(node.right, entry, added)
+
+	default:
+		node.entry = entry
+	}
+
+	return iTree.rebalance(node)
+}
+
+// maxOverlappingEnd - Returns the latest end time of the intervals in the
+// subtree rooted at 'node' which overlap [qStart, qEnd). The boolean
+// return value is 'false' if no interval overlaps.
+func (iTree *IntervalTreeDto) maxOverlappingEnd(
+	node *intervalTreeNode,
+	qStart,
+	qEnd time.Time) (time.Time, bool) {
+
+	if node == nil || !node.maxEnd.After(qStart) {
+		return time.Time{}, false
+	}
+
+	maxEnd, found := iTree.maxOverlappingEnd(node.left, qStart, qEnd)
+
+	if !node.entry.Interval.StartTime.DateTime.Before(qEnd) {
+		return maxEnd, found
+	}
+
+	end := node.entry.Interval.EndTime.DateTime
+
+	if end.After(qStart) && !node.entry.Interval.IsEmpty() {
+
+		if !found || end.After(maxEnd) {
+			maxEnd = end
+		}
+
+		found = true
+	}
+
+	rightEnd, rightFound := iTree.maxOverlappingEnd(node.right, qStart, qEnd)
+
+	if rightFound && (!found || rightEnd.After(maxEnd)) {
+		maxEnd = rightEnd
+		found = true
+	}
+
+	return maxEnd, found
+}
+
+// nodeHeight - Returns the height of 'node'. A nil node has height zero.
+func (iTree *IntervalTreeDto) nodeHeight(node *intervalTreeNode) int {
+
+	if node == nil {
+		return 0
+	}
+
+	return node.height
+}
+
+// rebalance - Restores the AVL balance of 'node' and returns the new
+// subtree root.
+func (iTree *IntervalTreeDto) rebalance(node *intervalTreeNode) *intervalTreeNode {
+
+	iTree.update(node)
+
+	balance := iTree.nodeHeight(node.left) - iTree.nodeHeight(node.right)
+
+	if balance > 1 {
+
+		if iTree.nodeHeight(node.left.left) < iTree.nodeHeight(node.left.right) {
+			node.left = iTree.rotateLeft(node.left)
+		}
+
+		return iTree.rotateRight(node)
+	}
+
+	if balance < -1 {
+
+		if iTree.nodeHeight(node.right.right) < iTree.nodeHeight(node.right.left) {
+			node.right = iTree.rotateRight(node.right)
+		}
+
+		return iTree.rotateLeft(node)
+	}
+
+	return node
+}
+
+// rotateLeft - Rotates the subtree rooted at 'node' to the left.
+func (iTree *IntervalTreeDto) rotateLeft(node *intervalTreeNode) *intervalTreeNode {
+
+	pivot := node.right
+
+	node.right = pivot.left
+	pivot.left = node
+
+	iTree.update(node)
+	iTree.update(pivot)
+
+	return pivot
+}
+
+// rotateRight - Rotates the subtree rooted at 'node' to the right.
+func (iTree *IntervalTreeDto) rotateRight(node *intervalTreeNode) *intervalTreeNode {
+
+	pivot := node.left
+
+	node.left = pivot.right
+	pivot.right = node
+
+	iTree.update(node)
+	iTree.update(pivot)
+
+	return pivot
+}
+
+// update - Recomputes the height and maximum end time of 'node' from its
+// children.
+func (iTree *IntervalTreeDto) update(node *intervalTreeNode) {
+
+	leftHeight := iTree.nodeHeight(node.left)
+	rightHeight := iTree.nodeHeight(node.right)
+
+	if leftHeight > rightHeight {
+		node.height = leftHeight + 1
+	} else {
+		node.height = rightHeight + 1
+	}
+
+	// Empty intervals overlap nothing and do not extend the subtree.
+	node.maxEnd = time.Time{}
+
+	if !node.entry.Interval.IsEmpty() {
+		node.maxEnd = node.entry.Interval.EndTime.DateTime
+	}
+
+	if node.left != nil && node.left.maxEnd.After(node.maxEnd) {
+		node.maxEnd = node.left.maxEnd
+	}
+
+	if node.right != nil && node.right.maxEnd.After(node.maxEnd) {
+		node.maxEnd = node.right.maxEnd
+	}
+}
@@ -0,0 +1,221 @@
+package datetime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+/*
+ ISO 8601 Week Date and Ordinal Date Utility
+ ===========================================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\isodateutility.go
+
+ Overview and Usage
+ ==================
+ This source file contains internal helper functions used to convert
+ between calendar dates and ISO 8601 week dates and ordinal dates.
+
+ ISO 8601 Week Date - Example: "2026-W42-5"
+	Weeks begin on Monday (weekday 1) and end on Sunday (weekday 7).
+	Week 1 of an ISO week-year is the week containing the first
+	Thursday of the calendar year. An ISO week-year has either 52
+	or 53 weeks. The ISO week-year may differ from the calendar year
+	for dates near the beginning and end of the calendar year.
+	Example: 2018-12-31 is "2019-W01-1".
+
+ ISO 8601 Ordinal Date - Example: "2026-290"
+	The year followed by the day of the year (001-366).
+
+ Parsing
+ =======
+ The following forms are recognized by 'parseISOWeekOrdinalDateStr()'.
+ Each form may be followed by the letter 'T' or a space and a time
+ of day with an optional UTC offset. Example: "2026-W42-5T08:30:00Z"
+
+	Extended week date:    2026-W42-5   2026-W42
+	Basic week date:       2026W425     2026W42
+	Extended ordinal date: 2026-290
+	Basic ordinal date:    2026290
+
+ If the weekday is omitted, Monday (weekday 1) is assumed.
+
+*/
+
+// isoWeekDateRegex - Matches extended and basic ISO 8601 week dates
+// plus an optional time suffix.
+var isoWeekDateRegex = regexp.MustCompile(`^(\d{4})-?W(\d{2})(?:-?([1-7]))?(?:[T ](.+))?$`)
+
+// isoOrdinalDateRegex - Matches extended and basic ISO 8601 ordinal
+// dates plus an optional time suffix.
+var isoOrdinalDateRegex = regexp.MustCompile(`^(\d{4})-?(\d{3})(?:[T ](.+))?$`)
+
+// isoTimeOfDayLayouts - Time layouts accepted after an ISO 8601 week
+// date or ordinal date. Fractional seconds are accepted by time.Parse()
+// following the seconds field.
+var isoTimeOfDayLayouts = []string{
+	"15:04:05Z07:00",
+	"15:04:05",
+	"15:04Z07:00",
+	"15:04",
+	"150405Z0700",
+	"150405",
+}
+
+// isoWeekDayNumber - Returns the ISO 8601 weekday number of date
+// time 't'. Monday = 1 through Sunday = 7.
+func isoWeekDayNumber(t time.Time) int {
+
+	wd := int(t.Weekday())
+
+	if wd == 0 {
+		return 7
+	}
+
+	return wd
+}
+
+// isoWeeksInYear - Returns the number of ISO weeks (52 or 53) in
+// ISO week-year 'isoYear'. December 28th always falls in the last
+// ISO week of its year.
+func isoWeeksInYear(isoYear int) int {
+
+	_, week := time.Date(isoYear, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+
+	return week
+}
+
+// isoWeekDateToDate - Converts an ISO 8601 week date to a calendar
+// date. Returns an error if 'week' or 'weekDay' is out of range.
+func isoWeekDateToDate(isoYear, week, weekDay int) (int, time.Month, int, error) {
+
+	if week < 1 || week > isoWeeksInYear(isoYear) {
+		return 0, 0, 0,
+			fmt.Errorf("Error: ISO week is out of range. isoYear='%v' week='%v'", isoYear, week)
+	}
+
+	if weekDay < 1 || weekDay > 7 {
+		return 0, 0, 0,
+			fmt.Errorf("Error: ISO weekday must be 1 (Monday) through 7 (Sunday). weekDay='%v'", weekDay)
+	}
+
+	// January 4th always falls in ISO week 1.
+	jan4 := time.Date(isoYear, time.January, 4, 0, 0, 0, 0, time.UTC)
+
+	week1Monday := jan4.AddDate(0, 0, 1-isoWeekDayNumber(jan4))
+
+	d := week1Monday.AddDate(0, 0, (week-1)*7+weekDay-1)
+
+	return d.Year(), d.Month(), d.Day(), nil
+}
+
+// ordinalDateToDate - Converts an ISO 8601 ordinal date to a calendar
+// date. Returns an error if 'dayOfYear' is out of range.
+func ordinalDateToDate(year, dayOfYear int) (int, time.Month, int, error) {
+
+	daysInYear := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+
+	if dayOfYear < 1 || dayOfYear > daysInYear {
+		return 0, 0, 0,
+			fmt.Errorf("Error: Day of year is out of range. year='%v' dayOfYear='%v'", year, dayOfYear)
+	}
+
+	d := time.Date(year, time.January, dayOfYear, 0, 0, 0, 0, time.UTC)
+
+	return d.Year(), d.Month(), d.Day(), nil
+}
+
+// formatISOWeekDate - Returns the ISO 8601 extended week date for
+// date time 't'. Example: "2026-W42-5"
+func formatISOWeekDate(t time.Time) string {
+
+	isoYear, week := t.ISOWeek()
+
+	return fmt.Sprintf("%04d-W%02d-%d", isoYear, week, isoWeekDayNumber(t))
+}
+
+// formatOrdinalDate - Returns the ISO 8601 extended ordinal date for
+// date time 't'. Example: "2026-290"
+func formatOrdinalDate(t time.Time) string {
+
+	return fmt.Sprintf("%04d-%03d", t.Year(), t.YearDay())
+}
+
+// parseISOWeekOrdinalDateStr - Parses an ISO 8601 week date or ordinal
+// date string with an optional time of day. If the time of day does not
+// include a UTC offset, the result is returned in UTC.
+//
+// Return Values:
+//
+//	time.Time - The parsed date time
+//	string    - A description of the matched form
+//	bool      - 'true' if 'dateTimeStr' has the form of an ISO 8601 week
+//	            date or ordinal date
+//	error     - Non-nil if 'dateTimeStr' has the form of a week date or
+//	            ordinal date but contains invalid values
+func parseISOWeekOrdinalDateStr(dateTimeStr string) (time.Time, string, bool, error) {
+
+	var year, day int
+	var month time.Month
+	var timeStr, formName string
+	var err error
+
+	if match := isoWeekDateRegex.FindStringSubmatch(dateTimeStr); match != nil {
+
+		isoYear, _ := strconv.Atoi(match[1])
+		week, _ := strconv.Atoi(match[2])
+
+		weekDay := 1
+
+		if match[3] != "" {
+			weekDay, _ = strconv.Atoi(match[3])
+		}
+
+		formName = "ISO 8601 Week Date"
+		timeStr = match[4]
+
+		year, month, day, err = isoWeekDateToDate(isoYear, week, weekDay)
+
+	} else if match := isoOrdinalDateRegex.FindStringSubmatch(dateTimeStr); match != nil {
+
+		year, _ = strconv.Atoi(match[1])
+		dayOfYear, _ := strconv.Atoi(match[2])
+
+		formName = "ISO 8601 Ordinal Date"
+		timeStr = match[3]
+
+		year, month, day, err = ordinalDateToDate(year, dayOfYear)
+
+	} else {
+		return time.Time{}, "", false, nil
+	}
+
+	if err != nil {
+		return time.Time{}, formName, true, err
+	}
+
+	if timeStr == "" {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), formName, true, nil
+	}
+
+	for _, layout := range isoTimeOfDayLayouts {
+
+		tod, err := time.Parse(layout, timeStr)
+
+		if err != nil {
+			continue
+		}
+
+		return time.Date(year, month, day, tod.Hour(), tod.Minute(), tod.Second(),
+			tod.Nanosecond(), tod.Location()), formName, true, nil
+	}
+
+	return time.Time{}, formName, true,
+		fmt.Errorf("Error: Invalid time of day following %v. timeStr='%v'", formName, timeStr)
+}
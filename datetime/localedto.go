@@ -0,0 +1,500 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+/*
+ LocaleDto
+ =========
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\localedto.go
+
+ Overview and Usage
+ ==================
+ The 'LocaleDto' Type contains the language specific data used to
+ display date times and time durations. This includes month names,
+ weekday names, AM/PM markers, duration unit labels, the plural
+ rule used to select singular or plural unit labels and the phrases
+ used by RelativeTimeFormatDto.
+
+ Supported Locales
+ =================
+	"en" - English
+	"es" - Spanish
+	"fr" - French
+	"de" - German
+	"pt" - Portuguese
+	"ja" - Japanese
+
+ Locale tags may include a region subtag. The region subtag is ignored.
+ Example: "pt-BR" and "pt_PT" both return the Portuguese locale.
+
+ Usage
+ =====
+	locale, err := LocaleDto{}.New(LocaleTagSpanish)
+
+	str := dtz.GetDateTimeEverythingLocale(locale)
+
+	str = "sábado abril 29, 2017 19:54:30.123456489 -0500 CDT"
+
+*/
+
+// Locale Tags for supported locales
+const (
+	// LocaleTagEnglish - English
+	LocaleTagEnglish = "en"
+
+	// LocaleTagSpanish - Spanish
+	LocaleTagSpanish = "es"
+
+	// LocaleTagFrench - French
+	LocaleTagFrench = "fr"
+
+	// LocaleTagGerman - German
+	LocaleTagGerman = "de"
+
+	// LocaleTagPortuguese - Portuguese
+	LocaleTagPortuguese = "pt"
+
+	// LocaleTagJapanese - Japanese
+	LocaleTagJapanese = "ja"
+)
+
+// LocaleDto - Contains language specific data used to format
+// date times and time durations.
+type LocaleDto struct {
+	Tag                string                          // Locale tag. Example: "en"
+	Name               string                          // Locale name. Example: "English"
+	MonthNames         [12]string                      // Full month names, January through December
+	MonthAbbrvs        [12]string                      // Abbreviated month names, January through December
+	WeekdayNames       [7]string                       // Full weekday names, Sunday through Saturday
+	WeekdayAbbrvs      [7]string                       // Abbreviated weekday names, Sunday through Saturday
+	AmPmMarkers        [2]string                       // AM and PM markers
+	PluralRule         PluralRuleType                  // Rule used to select singular or plural unit labels
+	UnitSeparator      string                          // Text placed between a duration value and its unit label
+	DurationUnitLabels map[string]DurationUnitLabelDto // Duration unit labels keyed by DurationFormatDto placeholder name
+	RelativeTime       RelativeTimeLabelsDto           // Phrases used by RelativeTimeFormatDto
+}
+
+// CopyOut - Returns a deep copy of the current LocaleDto instance.
+func (loc *LocaleDto) CopyOut() LocaleDto {
+
+	loc2 := *loc
+
+	loc2.DurationUnitLabels = nil
+
+	if loc.DurationUnitLabels != nil {
+
+		loc2.DurationUnitLabels = make(map[string]DurationUnitLabelDto, len(loc.DurationUnitLabels))
+
+		for k, v := range loc.DurationUnitLabels {
+			loc2.DurationUnitLabels[k] = v
+		}
+	}
+
+	return loc2
+}
+
+// FormatDateTime - Formats input parameter 'dateTime' using the
+// Golang date time layout string 'fmtStr'. Month names, weekday
+// names and AM/PM markers are displayed in the language of the
+// current locale. All other layout elements are processed by
+// time.Time.Format().
+//
+// Input Parameters:
+// =================
+//
+// dateTime time.Time - The date time to format
+//
+// fmtStr   string    - A Golang date time layout string.
+//                      Example: FmtDateTimeEverything
+//
+func (loc *LocaleDto) FormatDateTime(dateTime time.Time, fmtStr string) string {
+
+	if loc.Tag == "" {
+		return dateTime.Format(fmtStr)
+	}
+
+	b := strings.Builder{}
+
+	lastIdx := 0
+
+	for i := 0; i < len(fmtStr); {
+
+		token, value := loc.matchLayoutToken(dateTime, fmtStr[i:])
+
+		if token == "" {
+			i++
+			continue
+		}
+
+		if lastIdx < i {
+			b.WriteString(dateTime.Format(fmtStr[lastIdx:i]))
+		}
+
+		b.WriteString(value)
+
+		i += len(token)
+		lastIdx = i
+	}
+
+	if lastIdx < len(fmtStr) {
+		b.WriteString(dateTime.Format(fmtStr[lastIdx:]))
+	}
+
+	return b.String()
+}
+
+// GetTag - Returns the tag associated with the current locale.
+func (loc *LocaleDto) GetTag() string {
+	return loc.Tag
+}
+
+// GetSupportedLocaleTags - Returns the tags for all supported
+// locales.
+func (loc LocaleDto) GetSupportedLocaleTags() []string {
+
+	return []string{LocaleTagEnglish, LocaleTagSpanish, LocaleTagFrench,
+		LocaleTagGerman, LocaleTagPortuguese, LocaleTagJapanese}
+}
+
+// GetUnitLabel - Returns the singular or plural unit label associated
+// with a DurationFormatDto placeholder name and a numeric value. The
+// singular or plural form is selected using the locale plural rule.
+//
+// Example: For the French locale, GetUnitLabel("Hours", 1) returns "heure".
+func (loc *LocaleDto) GetUnitLabel(placeholderName string, value int64) string {
+
+	label, ok := loc.DurationUnitLabels[placeholderName]
+
+	if !ok {
+		label = durFmtDefaultLabels[placeholderName]
+	}
+
+	if loc.PluralRule.isSingular(value) {
+		return label.Singular
+	}
+
+	return label.Plural
+}
+
+// IsEmpty - Returns 'true' if the current LocaleDto has not
+// been initialized.
+func (loc *LocaleDto) IsEmpty() bool {
+	return loc.Tag == ""
+}
+
+// New - Returns a new LocaleDto for the locale identified by input
+// parameter 'localeTag'. Region subtags are ignored. If the locale
+// is not supported, an error is returned.
+//
+// Input Parameters:
+// =================
+//
+// localeTag string - The locale tag. Example: "es", "fr-CA", "pt_BR"
+//
+func (loc LocaleDto) New(localeTag string) (LocaleDto, error) {
+
+	ePrefix := "LocaleDto.New() "
+
+	tag := strings.ToLower(strings.TrimSpace(localeTag))
+
+	if idx := strings.IndexAny(tag, "-_"); idx > -1 {
+		tag = tag[:idx]
+	}
+
+	locData, ok := localeDataMap[tag]
+
+	if !ok {
+		return LocaleDto{},
+			fmt.Errorf(ePrefix+"Error: Locale is not supported. localeTag='%v'", localeTag)
+	}
+
+	return locData.newLocaleDto(tag), nil
+}
+
+// matchLayoutToken - Determines whether 'layout' begins with a
+// Golang layout element which is subject to localization. If a
+// match is found, the layout token and the localized value are
+// returned. Otherwise, empty strings are returned.
+func (loc *LocaleDto) matchLayoutToken(dateTime time.Time, layout string) (token, value string) {
+
+	switch {
+
+	case strings.HasPrefix(layout, "January"):
+		return "January", loc.MonthNames[dateTime.Month()-1]
+
+	case strings.HasPrefix(layout, "Jan"):
+		return "Jan", loc.MonthAbbrvs[dateTime.Month()-1]
+
+	case strings.HasPrefix(layout, "Monday"):
+		return "Monday", loc.WeekdayNames[dateTime.Weekday()]
+
+	case strings.HasPrefix(layout, "Mon"):
+		return "Mon", loc.WeekdayAbbrvs[dateTime.Weekday()]
+
+	case strings.HasPrefix(layout, "PM"):
+
+		if dateTime.Hour() >= 12 {
+			return "PM", loc.AmPmMarkers[1]
+		}
+
+		return "PM", loc.AmPmMarkers[0]
+
+	case strings.HasPrefix(layout, "pm"):
+
+		if dateTime.Hour() >= 12 {
+			return "pm", strings.ToLower(loc.AmPmMarkers[1])
+		}
+
+		return "pm", strings.ToLower(loc.AmPmMarkers[0])
+	}
+
+	return "", ""
+}
+
+// localeData - Internal storage for locale data.
+type localeData struct {
+	name          string
+	monthNames    [12]string
+	monthAbbrvs   [12]string
+	weekdayNames  [7]string
+	weekdayAbbrvs [7]string
+	amPm          [2]string
+	pluralRule    PluralRuleType
+	unitSeparator string
+
+	// Unit labels in the order: Years, Half-Years, Quarters, Months,
+	// Weeks, Days, Hours, Minutes, Seconds, Milliseconds, Microseconds,
+	// Nanoseconds
+	unitLabels [12]DurationUnitLabelDto
+
+	// Label used for the 'WeekDays' placeholder
+	weekDayLabel DurationUnitLabelDto
+
+	// Phrases used by RelativeTimeFormatDto
+	relativeTime RelativeTimeLabelsDto
+}
+
+// newLocaleDto - Creates a LocaleDto from internal locale data.
+func (locData localeData) newLocaleDto(tag string) LocaleDto {
+
+	loc := LocaleDto{}
+
+	loc.Tag = tag
+	loc.Name = locData.name
+	loc.MonthNames = locData.monthNames
+	loc.MonthAbbrvs = locData.monthAbbrvs
+	loc.WeekdayNames = locData.weekdayNames
+	loc.WeekdayAbbrvs = locData.weekdayAbbrvs
+	loc.AmPmMarkers = locData.amPm
+	loc.PluralRule = locData.pluralRule
+	loc.UnitSeparator = locData.unitSeparator
+
+	unitIdx := map[TimeUnitType]int{
+		TimeUnitYEARS:        0,
+		TimeUnitHALFYEARS:    1,
+		TimeUnitQUARTERS:     2,
+		TimeUnitMONTHS:       3,
+		TimeUnitWEEKS:        4,
+		TimeUnitDAYS:         5,
+		TimeUnitHOURS:        6,
+		TimeUnitMINUTES:      7,
+		TimeUnitSECONDS:      8,
+		TimeUnitMILLISECONDS: 9,
+		TimeUnitMICROSECONDS: 10,
+		TimeUnitNANOSECONDS:  11,
+	}
+
+	loc.DurationUnitLabels = make(map[string]DurationUnitLabelDto, len(durFmtElements))
+
+	for name, spec := range durFmtElements {
+		loc.DurationUnitLabels[name] = locData.unitLabels[unitIdx[spec.unit]]
+	}
+
+	loc.DurationUnitLabels["WeekDays"] = locData.weekDayLabel
+
+	loc.RelativeTime = locData.relativeTime
+
+	return loc
+}
+
+// localeDataMap - Data for all supported locales keyed by
+// locale tag.
+var localeDataMap = map[string]localeData{
+
+	LocaleTagEnglish: {
+		name: "English",
+		monthNames: [12]string{"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December"},
+		monthAbbrvs: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		weekdayNames: [7]string{"Sunday", "Monday", "Tuesday", "Wednesday",
+			"Thursday", "Friday", "Saturday"},
+		weekdayAbbrvs: [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		amPm:          [2]string{"AM", "PM"},
+		pluralRule:    PluralRuleONESINGULAR,
+		unitSeparator: " ",
+		unitLabels: [12]DurationUnitLabelDto{
+			{"Year", "Years"}, {"Half-Year", "Half-Years"}, {"Quarter", "Quarters"},
+			{"Month", "Months"}, {"Week", "Weeks"},
+			{"Day", "Days"}, {"Hour", "Hours"}, {"Minute", "Minutes"},
+			{"Second", "Seconds"}, {"Millisecond", "Milliseconds"},
+			{"Microsecond", "Microseconds"}, {"Nanosecond", "Nanoseconds"}},
+		weekDayLabel: DurationUnitLabelDto{"WeekDay", "WeekDays"},
+		relativeTime: RelativeTimeLabelsDto{
+			JustNow: "just now", PastFmt: "%v ago", FutureFmt: "in %v",
+			Yesterday: "yesterday", Tomorrow: "tomorrow", DayAtTimeFmt: "%v at %v",
+			LastWeekdayFmt: "last %v", NextWeekdayFmt: "next %v", UnitJoiner: " and ",
+			UnitLabels: [7]DurationUnitLabelDto{
+				{"second", "seconds"}, {"minute", "minutes"}, {"hour", "hours"},
+				{"day", "days"}, {"week", "weeks"}, {"month", "months"}, {"year", "years"}}},
+	},
+
+	LocaleTagSpanish: {
+		name: "Español",
+		monthNames: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		monthAbbrvs: [12]string{"ene", "feb", "mar", "abr", "may", "jun",
+			"jul", "ago", "sep", "oct", "nov", "dic"},
+		weekdayNames: [7]string{"domingo", "lunes", "martes", "miércoles",
+			"jueves", "viernes", "sábado"},
+		weekdayAbbrvs: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		amPm:          [2]string{"a. m.", "p. m."},
+		pluralRule:    PluralRuleONESINGULAR,
+		unitSeparator: " ",
+		unitLabels: [12]DurationUnitLabelDto{
+			{"año", "años"}, {"semestre", "semestres"}, {"trimestre", "trimestres"},
+			{"mes", "meses"}, {"semana", "semanas"},
+			{"día", "días"}, {"hora", "horas"}, {"minuto", "minutos"},
+			{"segundo", "segundos"}, {"milisegundo", "milisegundos"},
+			{"microsegundo", "microsegundos"}, {"nanosegundo", "nanosegundos"}},
+		weekDayLabel: DurationUnitLabelDto{"día", "días"},
+		relativeTime: RelativeTimeLabelsDto{
+			JustNow: "ahora mismo", PastFmt: "hace %v", FutureFmt: "dentro de %v",
+			Yesterday: "ayer", Tomorrow: "mañana", DayAtTimeFmt: "%v a las %v",
+			LastWeekdayFmt: "el %v pasado", NextWeekdayFmt: "el próximo %v", UnitJoiner: " y ",
+			UnitLabels: [7]DurationUnitLabelDto{
+				{"segundo", "segundos"}, {"minuto", "minutos"}, {"hora", "horas"},
+				{"día", "días"}, {"semana", "semanas"}, {"mes", "meses"}, {"año", "años"}}},
+	},
+
+	LocaleTagFrench: {
+		name: "Français",
+		monthNames: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		monthAbbrvs: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		weekdayNames: [7]string{"dimanche", "lundi", "mardi", "mercredi",
+			"jeudi", "vendredi", "samedi"},
+		weekdayAbbrvs: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		amPm:          [2]string{"AM", "PM"},
+		pluralRule:    PluralRuleZEROONESINGULAR,
+		unitSeparator: " ",
+		unitLabels: [12]DurationUnitLabelDto{
+			{"an", "ans"}, {"semestre", "semestres"}, {"trimestre", "trimestres"},
+			{"mois", "mois"}, {"semaine", "semaines"},
+			{"jour", "jours"}, {"heure", "heures"}, {"minute", "minutes"},
+			{"seconde", "secondes"}, {"milliseconde", "millisecondes"},
+			{"microseconde", "microsecondes"}, {"nanoseconde", "nanosecondes"}},
+		weekDayLabel: DurationUnitLabelDto{"jour", "jours"},
+		relativeTime: RelativeTimeLabelsDto{
+			JustNow: "à l'instant", PastFmt: "il y a %v", FutureFmt: "dans %v",
+			Yesterday: "hier", Tomorrow: "demain", DayAtTimeFmt: "%v à %v",
+			LastWeekdayFmt: "%v dernier", NextWeekdayFmt: "%v prochain", UnitJoiner: " et ",
+			UnitLabels: [7]DurationUnitLabelDto{
+				{"seconde", "secondes"}, {"minute", "minutes"}, {"heure", "heures"},
+				{"jour", "jours"}, {"semaine", "semaines"}, {"mois", "mois"}, {"an", "ans"}}},
+	},
+
+	LocaleTagGerman: {
+		name: "Deutsch",
+		monthNames: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember"},
+		monthAbbrvs: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+			"Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		weekdayNames: [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch",
+			"Donnerstag", "Freitag", "Samstag"},
+		weekdayAbbrvs: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		amPm:          [2]string{"AM", "PM"},
+		pluralRule:    PluralRuleONESINGULAR,
+		unitSeparator: " ",
+		unitLabels: [12]DurationUnitLabelDto{
+			{"Jahr", "Jahre"}, {"Halbjahr", "Halbjahre"}, {"Quartal", "Quartale"},
+			{"Monat", "Monate"}, {"Woche", "Wochen"},
+			{"Tag", "Tage"}, {"Stunde", "Stunden"}, {"Minute", "Minuten"},
+			{"Sekunde", "Sekunden"}, {"Millisekunde", "Millisekunden"},
+			{"Mikrosekunde", "Mikrosekunden"}, {"Nanosekunde", "Nanosekunden"}},
+		weekDayLabel: DurationUnitLabelDto{"Tag", "Tage"},
+		relativeTime: RelativeTimeLabelsDto{
+			JustNow: "gerade eben", PastFmt: "vor %v", FutureFmt: "in %v",
+			Yesterday: "gestern", Tomorrow: "morgen", DayAtTimeFmt: "%v um %v",
+			LastWeekdayFmt: "letzten %v", NextWeekdayFmt: "nächsten %v", UnitJoiner: " und ",
+			UnitLabels: [7]DurationUnitLabelDto{
+				{"Sekunde", "Sekunden"}, {"Minute", "Minuten"}, {"Stunde", "Stunden"},
+				{"Tag", "Tagen"}, {"Woche", "Wochen"}, {"Monat", "Monaten"}, {"Jahr", "Jahren"}}},
+	},
+
+	LocaleTagPortuguese: {
+		name: "Português",
+		monthNames: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		monthAbbrvs: [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.",
+			"jul.", "ago.", "set.", "out.", "nov.", "dez."},
+		weekdayNames: [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira",
+			"quinta-feira", "sexta-feira", "sábado"},
+		weekdayAbbrvs: [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+		amPm:          [2]string{"AM", "PM"},
+		pluralRule:    PluralRuleZEROONESINGULAR,
+		unitSeparator: " ",
+		unitLabels: [12]DurationUnitLabelDto{
+			{"ano", "anos"}, {"semestre", "semestres"}, {"trimestre", "trimestres"},
+			{"mês", "meses"}, {"semana", "semanas"},
+			{"dia", "dias"}, {"hora", "horas"}, {"minuto", "minutos"},
+			{"segundo", "segundos"}, {"milissegundo", "milissegundos"},
+			{"microssegundo", "microssegundos"}, {"nanossegundo", "nanossegundos"}},
+		weekDayLabel: DurationUnitLabelDto{"dia", "dias"},
+		relativeTime: RelativeTimeLabelsDto{
+			JustNow: "agora mesmo", PastFmt: "há %v", FutureFmt: "em %v",
+			Yesterday: "ontem", Tomorrow: "amanhã", DayAtTimeFmt: "%v às %v",
+			LastWeekdayFmt: "%v anterior", NextWeekdayFmt: "%v seguinte", UnitJoiner: " e ",
+			UnitLabels: [7]DurationUnitLabelDto{
+				{"segundo", "segundos"}, {"minuto", "minutos"}, {"hora", "horas"},
+				{"dia", "dias"}, {"semana", "semanas"}, {"mês", "meses"}, {"ano", "anos"}}},
+	},
+
+	LocaleTagJapanese: {
+		name: "日本語",
+		monthNames: [12]string{"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月"},
+		monthAbbrvs: [12]string{"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdayNames: [7]string{"日曜日", "月曜日", "火曜日", "水曜日",
+			"木曜日", "金曜日", "土曜日"},
+		weekdayAbbrvs: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		amPm:          [2]string{"午前", "午後"},
+		pluralRule:    PluralRuleINVARIANT,
+		unitSeparator: "",
+		unitLabels: [12]DurationUnitLabelDto{
+			{"年", "年"}, {"半期", "半期"}, {"四半期", "四半期"},
+			{"か月", "か月"}, {"週間", "週間"},
+			{"日", "日"}, {"時間", "時間"}, {"分", "分"},
+			{"秒", "秒"}, {"ミリ秒", "ミリ秒"},
+			{"マイクロ秒", "マイクロ秒"}, {"ナノ秒", "ナノ秒"}},
+		weekDayLabel: DurationUnitLabelDto{"日", "日"},
+		relativeTime: RelativeTimeLabelsDto{
+			JustNow: "たった今", PastFmt: "%v前", FutureFmt: "%v後",
+			Yesterday: "昨日", Tomorrow: "明日", DayAtTimeFmt: "%v %v",
+			LastWeekdayFmt: "先週の%v", NextWeekdayFmt: "来週の%v", UnitJoiner: "",
+			UnitLabels: [7]DurationUnitLabelDto{
+				{"秒", "秒"}, {"分", "分"}, {"時間", "時間"},
+				{"日", "日"}, {"週間", "週間"}, {"か月", "か月"}, {"年", "年"}}},
+	},
+}
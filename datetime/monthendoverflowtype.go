@@ -0,0 +1,165 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+ MonthEndOverflowType
+ ====================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\monthendoverflowtype.go
+
+ Overview and Usage
+ ==================
+ 'MonthEndOverflowType' is an enumeration of the policies applied when
+ years or months are added to a date whose day of the month does not
+ exist in the target month. Example: January 31st plus one month.
+
+	MonthEndOverflowNORMALIZE - The surplus days carry into the following
+	                            month, as computed by time.AddDate().
+	                            2019-01-31 + 1 month = 2019-03-03
+
+	MonthEndOverflowCLAMP     - The day is limited to the last day of the
+	                            target month.
+	                            2019-01-31 + 1 month = 2019-02-28
+
+	MonthEndOverflowROLLNEXT  - The date rolls to the first day of the
+	                            month following the target month.
+	                            2019-01-31 + 1 month = 2019-03-01
+
+ MonthEndOverflowNORMALIZE is the zero value. Methods which do not accept
+ an overflow policy use MonthEndOverflowNORMALIZE.
+
+ The policy is applied once, after the years and months have been added
+ and before any days or time components are added.
+
+*/
+
+// MonthEndOverflowType - Specifies the treatment of a day of the month
+// which does not exist in the month produced by calendar addition.
+type MonthEndOverflowType int
+
+// String - Returns a string equivalent to the
+// integer value of MonthEndOverflowType
+func (overflow MonthEndOverflowType) String() string {
+
+	if overflow < 0 || int(overflow) >= len(MonthEndOverflowTypeLabels) {
+		return ""
+	}
+
+	return MonthEndOverflowTypeLabels[overflow]
+}
+
+// IsValid - Returns 'true' if the current MonthEndOverflowType
+// identifies a valid month end overflow policy.
+func (overflow MonthEndOverflowType) IsValid() bool {
+
+	if overflow < 0 || int(overflow) >= len(MonthEndOverflowTypeLabels) {
+		return false
+	}
+
+	return true
+}
+
+// Month End Overflow Policies
+const (
+
+	// MonthEndOverflowNORMALIZE - Surplus days carry into the following
+	// month. This is the behavior of time.AddDate().
+	MonthEndOverflowNORMALIZE MonthEndOverflowType = iota
+
+	// MonthEndOverflowCLAMP - The day is limited to the last day
+	// of the target month.
+	MonthEndOverflowCLAMP
+
+	// MonthEndOverflowROLLNEXT - The date rolls to the first day of
+	// the month following the target month.
+	MonthEndOverflowROLLNEXT
+)
+
+// MonthEndOverflowTypeLabels - Text Names associated with
+// MonthEndOverflowType types.
+var MonthEndOverflowTypeLabels = [...]string{"Normalize", "Clamp", "RollNext"}
+
+// addYearsMonthsOverflow - Adds 'years' and 'months' to the local date of
+// 't' and applies month end overflow policy 'overflow'. The wall clock time
+// of 't' is retained. With MonthEndOverflowNORMALIZE the result is identical
+// to t.AddDate(years, months, 0).
+func addYearsMonthsOverflow(t time.Time, years, months int,
+	overflow MonthEndOverflowType) time.Time {
+
+	year, month, day := t.Date()
+
+	year, month, day = monthEndOverflowDate(year+years, month+time.Month(months), day, overflow)
+
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// monthEndOverflowDate - Normalizes 'year' and 'month' in the manner of
+// time.Date() and applies month end overflow policy 'overflow' to 'day'.
+// With MonthEndOverflowNORMALIZE, 'day' is returned unchanged and is
+// normalized by time.Date() when the date is constructed.
+func monthEndOverflowDate(year int, month time.Month, day int,
+	overflow MonthEndOverflowType) (int, time.Month, int) {
+
+	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	year = firstOfMonth.Year()
+	month = firstOfMonth.Month()
+
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	if day <= lastDay {
+		return year, month, day
+	}
+
+	switch overflow {
+
+	case MonthEndOverflowCLAMP:
+		day = lastDay
+
+	case MonthEndOverflowROLLNEXT:
+		month++
+		day = 1
+
+		if month > time.December {
+			month = time.January
+			year++
+		}
+	}
+
+	return year, month, day
+}
+
+// validateMonthEndOverflow - Returns an error if 'overflow' is not a
+// valid MonthEndOverflowType.
+func validateMonthEndOverflow(overflow MonthEndOverflowType) error {
+
+	if !overflow.IsValid() {
+		return fmt.Errorf("Error: Invalid month end overflow policy. MonthEndOverflowType='%v'", int(overflow))
+	}
+
+	return nil
+}
+
+// anniversaryDate - Returns the date time located 'months' months from
+// 'anchor'. A negative value of 'months' counts backward. If 'isEndOfMonth'
+// is 'true', the last day of the target month is returned. Otherwise,
+// month end overflow policy 'overflow' is applied. The wall clock time of
+// 'anchor' is retained.
+func anniversaryDate(anchor time.Time, months int, isEndOfMonth bool,
+	overflow MonthEndOverflowType) time.Time {
+
+	if isEndOfMonth {
+		return time.Date(anchor.Year(), anchor.Month()+time.Month(months)+1, 0,
+			anchor.Hour(), anchor.Minute(), anchor.Second(), anchor.Nanosecond(), anchor.Location())
+	}
+
+	return addYearsMonthsOverflow(anchor, 0, months, overflow)
+}
@@ -0,0 +1,676 @@
+package datetime
+
+import (
+	"sort"
+	"time"
+)
+
+/*
+ Recurrence Expansion Utility
+ ============================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\recurrenceexpansionutility.go
+
+ Overview and Usage
+ ==================
+ This source file contains the 'RecurrenceIteratorDto' Type and the
+ internal functions used to expand a 'RecurrenceRuleDto' into a series
+ of occurrences.
+
+ Expansion proceeds one period at a time. The length of a period is
+ determined by FREQ. For each period, the candidate days are filtered by
+ the BYMONTH, BYWEEKNO, BYYEARDAY, BYMONTHDAY and BYDAY rule parts. The
+ candidate days are combined with the BYHOUR, BYMINUTE and BYSECOND times
+ and BYSETPOS is applied to the resulting set. All calculations use local
+ wall clock times. Each wall clock time is converted to an instant in the
+ time zone of 'DtStart' as the final step.
+
+ If a rule produces no occurrences for 400 consecutive years, or the
+ expansion passes the year 9999, the expansion ends.
+
+*/
+
+// rRuleMaxEmptyYears - The number of consecutive years without any
+// candidate occurrences after which the expansion ends.
+const rRuleMaxEmptyYears = 400
+
+// RecurrenceIteratorDto - Lazily computes the occurrences of a
+// RecurrenceRuleDto, including RDATE values and excluding EXDATE
+// values. Create with RecurrenceRuleDto.NewIterator().
+type RecurrenceIteratorDto struct {
+	expander    *rRuleExpander
+	rDates      []time.Time
+	rDateIdx    int
+	exDates     map[string]bool
+	nextRule    time.Time
+	hasNextRule bool
+	ruleFetched bool
+	last        time.Time
+	hasLast     bool
+	loc         *time.Location
+	dtFmt       string
+	err         error
+}
+
+// Error - Returns the error which terminated the iteration, if any.
+func (rIter *RecurrenceIteratorDto) Error() error {
+
+	return rIter.err
+}
+
+// Next - Returns the next occurrence. The boolean return value is 'false'
+// when there are no more occurrences.
+func (rIter *RecurrenceIteratorDto) Next() (DateTzDto, bool) {
+
+	if rIter.err != nil {
+		return DateTzDto{}, false
+	}
+
+	for {
+
+		if !rIter.ruleFetched {
+			rIter.nextRule, rIter.hasNextRule = rIter.expander.next()
+			rIter.ruleFetched = true
+		}
+
+		var candidate time.Time
+
+		if rIter.hasNextRule &&
+			(rIter.rDateIdx >= len(rIter.rDates) || !rIter.rDates[rIter.rDateIdx].Before(rIter.nextRule)) {
+
+			candidate = rIter.nextRule
+			rIter.ruleFetched = false
+
+		} else if rIter.rDateIdx < len(rIter.rDates) {
+
+			candidate = rIter.rDates[rIter.rDateIdx]
+			rIter.rDateIdx++
+
+		} else {
+			return DateTzDto{}, false
+		}
+
+		if rIter.hasLast && candidate.Equal(rIter.last) {
+			continue
+		}
+
+		rIter.last = candidate
+		rIter.hasLast = true
+
+		if rIter.exDates[rRuleInstantKey(candidate)] {
+			continue
+		}
+
+		dtz, err := DateTzDto{}.New(candidate.In(rIter.loc), rIter.dtFmt)
+
+		if err != nil {
+			rIter.err = err
+			return DateTzDto{}, false
+		}
+
+		return dtz, true
+	}
+}
+
+// newRecurrenceIterator - Creates a RecurrenceIteratorDto for 'rRule'.
+func newRecurrenceIterator(rRule *RecurrenceRuleDto) *RecurrenceIteratorDto {
+
+	rIter := RecurrenceIteratorDto{}
+
+	err := rRule.IsValid()
+
+	if err != nil {
+		rIter.err = err
+		return &rIter
+	}
+
+	rIter.expander = newRRuleExpander(rRule)
+	rIter.loc = rRule.DtStart.DateTime.Location()
+	rIter.dtFmt = rRule.DtStart.DateTimeFmt
+
+	for _, rDate := range rRule.RDates {
+		rIter.rDates = append(rIter.rDates, rDate.DateTime)
+	}
+
+	sort.Slice(rIter.rDates, func(i, j int) bool {
+		return rIter.rDates[i].Before(rIter.rDates[j])
+	})
+
+	rIter.exDates = make(map[string]bool)
+
+	for _, exDate := range rRule.ExDates {
+		rIter.exDates[rRuleInstantKey(exDate.DateTime)] = true
+	}
+
+	return &rIter
+}
+
+// rRuleInstantKey - Returns a map key which identifies the instant 't'.
+func rRuleInstantKey(t time.Time) string {
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// rRuleExpander - Expands the recurrence rule of a RecurrenceRuleDto.
+// RDATE and EXDATE values are processed by RecurrenceIteratorDto.
+type rRuleExpander struct {
+	rule               RecurrenceRuleDto
+	loc                *time.Location
+	startNaive         time.Time
+	until              time.Time
+	cursor             time.Time
+	step               time.Duration
+	hours              []int
+	minutes            []int
+	seconds            []int
+	emitted            int
+	pending            []time.Time
+	lastProductiveYear int
+	done               bool
+}
+
+// newRRuleExpander - Creates an rRuleExpander. Default rule parts are
+// derived from 'DtStart' as specified by RFC 5545.
+func newRRuleExpander(rRule *RecurrenceRuleDto) *rRuleExpander {
+
+	e := rRuleExpander{}
+
+	e.rule = rRule.CopyOut()
+	e.loc = rRule.DtStart.DateTime.Location()
+	e.startNaive = wallClockAsUTC(rRule.DtStart.DateTime)
+	e.until = rRule.Until.DateTime
+	e.lastProductiveYear = e.startNaive.Year()
+
+	r := &e.rule
+	start := e.startNaive
+
+	if len(r.ByWeekNo) == 0 && len(r.ByYearDay) == 0 && len(r.ByMonthDay) == 0 && len(r.ByDay) == 0 {
+
+		switch r.Freq {
+
+		case RecurrenceFreqYEARLY:
+
+			if len(r.ByMonth) == 0 {
+				r.ByMonth = []int{int(start.Month())}
+			}
+
+			r.ByMonthDay = []int{start.Day()}
+
+		case RecurrenceFreqMONTHLY:
+
+			r.ByMonthDay = []int{start.Day()}
+
+		case RecurrenceFreqWEEKLY:
+
+			r.ByDay = []RecurrenceWeekDayDto{{WeekDay: start.Weekday()}}
+		}
+	}
+
+	e.hours = sortedUniqueInts(r.ByHour)
+	e.minutes = sortedUniqueInts(r.ByMinute)
+	e.seconds = sortedUniqueInts(r.BySecond)
+
+	if len(e.hours) == 0 {
+		e.hours = []int{start.Hour()}
+	}
+
+	if len(e.minutes) == 0 {
+		e.minutes = []int{start.Minute()}
+	}
+
+	if len(e.seconds) == 0 {
+		e.seconds = []int{start.Second()}
+	}
+
+	startDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch r.Freq {
+
+	case RecurrenceFreqYEARLY:
+		e.cursor = time.Date(start.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	case RecurrenceFreqMONTHLY:
+		e.cursor = time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	case RecurrenceFreqWEEKLY:
+		e.cursor = startDay.AddDate(0, 0, -((int(start.Weekday()) - int(r.WeekStart) + 7) % 7))
+
+	case RecurrenceFreqDAILY:
+		e.cursor = startDay
+
+	case RecurrenceFreqHOURLY:
+		e.cursor = start.Truncate(time.Hour)
+		e.step = time.Duration(r.Interval) * time.Hour
+
+	case RecurrenceFreqMINUTELY:
+		e.cursor = start.Truncate(time.Minute)
+		e.step = time.Duration(r.Interval) * time.Minute
+
+	case RecurrenceFreqSECONDLY:
+		e.cursor = start.Truncate(time.Second)
+		e.step = time.Duration(r.Interval) * time.Second
+	}
+
+	return &e
+}
+
+// next - Returns the next occurrence generated by the rule. The boolean
+// return value is 'false' when the rule is exhausted.
+func (e *rRuleExpander) next() (time.Time, bool) {
+
+	for len(e.pending) == 0 {
+
+		if e.done {
+			return time.Time{}, false
+		}
+
+		e.expandPeriod()
+	}
+
+	t := e.pending[0]
+
+	e.pending = e.pending[1:]
+
+	return t, true
+}
+
+// advance - Moves the cursor to the next period. Sub-daily periods which
+// cannot satisfy the day, hour or minute rule parts are skipped.
+func (e *rRuleExpander) advance() {
+
+	r := &e.rule
+
+	switch r.Freq {
+
+	case RecurrenceFreqYEARLY:
+		e.cursor = e.cursor.AddDate(r.Interval, 0, 0)
+		return
+
+	case RecurrenceFreqMONTHLY:
+		e.cursor = e.cursor.AddDate(0, r.Interval, 0)
+		return
+
+	case RecurrenceFreqWEEKLY:
+		e.cursor = e.cursor.AddDate(0, 0, 7*r.Interval)
+		return
+
+	case RecurrenceFreqDAILY:
+		e.cursor = e.cursor.AddDate(0, 0, r.Interval)
+		return
+	}
+
+	c := e.cursor.Add(e.step)
+
+	for c.Year() <= 9999 && c.Year()-e.lastProductiveYear <= rRuleMaxEmptyYears {
+
+		day := time.Date(c.Year(), c.Month(), c.Day(), 0, 0, 0, 0, time.UTC)
+
+		if !e.dayMatches(day) {
+			c = e.jumpTo(c, day.AddDate(0, 0, 1))
+			continue
+		}
+
+		if r.Freq < RecurrenceFreqHOURLY && len(r.ByHour) > 0 && !containsInt(r.ByHour, c.Hour()) {
+			c = e.jumpTo(c, c.Truncate(time.Hour).Add(time.Hour))
+			continue
+		}
+
+		if r.Freq < RecurrenceFreqMINUTELY && len(r.ByMinute) > 0 && !containsInt(r.ByMinute, c.Minute()) {
+			c = e.jumpTo(c, c.Truncate(time.Minute).Add(time.Minute))
+			continue
+		}
+
+		break
+	}
+
+	e.cursor = c
+}
+
+// applySetPos - Applies the BYSETPOS rule part to the sorted candidate
+// set 'set'.
+func (e *rRuleExpander) applySetPos(set []time.Time) []time.Time {
+
+	result := make([]time.Time, 0, len(e.rule.BySetPos))
+
+	for _, pos := range e.rule.BySetPos {
+
+		idx := pos - 1
+
+		if pos < 0 {
+			idx = len(set) + pos
+		}
+
+		if idx < 0 || idx >= len(set) {
+			continue
+		}
+
+		isDup := false
+
+		for _, t := range result {
+			if t.Equal(set[idx]) {
+				isDup = true
+				break
+			}
+		}
+
+		if !isDup {
+			result = append(result, set[idx])
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Before(result[j])
+	})
+
+	return result
+}
+
+// dayMatches - Returns 'true' if the wall clock date 'day' satisfies the
+// BYMONTH, BYWEEKNO, BYYEARDAY, BYMONTHDAY and BYDAY rule parts.
+func (e *rRuleExpander) dayMatches(day time.Time) bool {
+
+	r := &e.rule
+
+	if len(r.ByMonth) > 0 && !containsInt(r.ByMonth, int(day.Month())) {
+		return false
+	}
+
+	if len(r.ByWeekNo) > 0 {
+
+		weekYear, week := rfcWeekNumber(day, r.WeekStart)
+
+		if r.Freq == RecurrenceFreqYEARLY && weekYear != e.cursor.Year() {
+			return false
+		}
+
+		weeksInYear := rfcWeeksInYear(weekYear, r.WeekStart)
+
+		if !containsInt(r.ByWeekNo, week) && !containsInt(r.ByWeekNo, week-weeksInYear-1) {
+			return false
+		}
+	}
+
+	daysInYear := time.Date(day.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+	daysInMonth := time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	if len(r.ByYearDay) > 0 {
+
+		yd := day.YearDay()
+
+		if !containsInt(r.ByYearDay, yd) && !containsInt(r.ByYearDay, yd-daysInYear-1) {
+			return false
+		}
+	}
+
+	if len(r.ByMonthDay) > 0 {
+
+		md := day.Day()
+
+		if !containsInt(r.ByMonthDay, md) && !containsInt(r.ByMonthDay, md-daysInMonth-1) {
+			return false
+		}
+	}
+
+	if len(r.ByDay) == 0 {
+		return true
+	}
+
+	for _, wd := range r.ByDay {
+
+		if wd.WeekDay != day.Weekday() {
+			continue
+		}
+
+		if wd.Nth == 0 {
+			return true
+		}
+
+		// Numeric BYDAY values are relative to the month for MONTHLY
+		// rules and for YEARLY rules with BYMONTH. Otherwise, they are
+		// relative to the year.
+		idx := day.YearDay() - 1
+		scopeLen := daysInYear
+
+		if r.Freq == RecurrenceFreqMONTHLY || len(r.ByMonth) > 0 {
+			idx = day.Day() - 1
+			scopeLen = daysInMonth
+		}
+
+		if wd.Nth == idx/7+1 || wd.Nth == -((scopeLen-1-idx)/7 + 1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandPeriod - Computes the occurrences in the period identified by
+// the cursor and advances the cursor to the next period.
+func (e *rRuleExpander) expandPeriod() {
+
+	if e.cursor.Year() > 9999 || e.cursor.Year()-e.lastProductiveYear > rRuleMaxEmptyYears {
+		e.done = true
+		return
+	}
+
+	set := make([]time.Time, 0, 8)
+
+	for _, day := range e.periodDays() {
+
+		if !e.dayMatches(day) {
+			continue
+		}
+
+		set = append(set, e.periodTimes(day)...)
+	}
+
+	if len(e.rule.BySetPos) > 0 {
+		set = e.applySetPos(set)
+	}
+
+	if len(set) > 0 {
+		e.lastProductiveYear = e.cursor.Year()
+	}
+
+	e.advance()
+
+	for _, naive := range set {
+
+		if naive.Before(e.startNaive) {
+			continue
+		}
+
+		t := rfc5545LocalInstant(naive, e.loc)
+
+		if !e.until.IsZero() && t.After(e.until) {
+			e.done = true
+			return
+		}
+
+		e.pending = append(e.pending, t)
+		e.emitted++
+
+		if e.rule.Count > 0 && e.emitted >= e.rule.Count {
+			e.done = true
+			return
+		}
+	}
+}
+
+// jumpTo - Advances the sub-daily date time 'c' by whole multiples of
+// the rule step until 'c' is greater than or equal to 'boundary'.
+func (e *rRuleExpander) jumpTo(c, boundary time.Time) time.Time {
+
+	if !c.Before(boundary) {
+		return c
+	}
+
+	k := (boundary.Sub(c) + e.step - 1) / e.step
+
+	return c.Add(k * e.step)
+}
+
+// periodDays - Returns the wall clock dates contained in the current
+// period.
+func (e *rRuleExpander) periodDays() []time.Time {
+
+	c := e.cursor
+
+	var first, last time.Time
+
+	switch e.rule.Freq {
+
+	case RecurrenceFreqYEARLY:
+
+		first = c
+		last = time.Date(c.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)
+
+		if len(e.rule.ByWeekNo) > 0 {
+			// Weeks at the start and end of a week numbering year may
+			// include days of the adjacent calendar years.
+			first = first.AddDate(0, 0, -7)
+			last = last.AddDate(0, 0, 7)
+		}
+
+	case RecurrenceFreqMONTHLY:
+
+		first = c
+		last = c.AddDate(0, 1, -1)
+
+	case RecurrenceFreqWEEKLY:
+
+		first = c
+		last = c.AddDate(0, 0, 6)
+
+	default:
+
+		first = time.Date(c.Year(), c.Month(), c.Day(), 0, 0, 0, 0, time.UTC)
+		last = first
+	}
+
+	days := make([]time.Time, 0, int(last.Sub(first).Hours()/24)+1)
+
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+
+	return days
+}
+
+// periodTimes - Returns the wall clock date times on 'day' within the
+// current period.
+func (e *rRuleExpander) periodTimes(day time.Time) []time.Time {
+
+	r := &e.rule
+	c := e.cursor
+
+	hours := e.hours
+	minutes := e.minutes
+	seconds := e.seconds
+
+	if r.Freq <= RecurrenceFreqHOURLY {
+
+		if len(r.ByHour) > 0 && !containsInt(r.ByHour, c.Hour()) {
+			return nil
+		}
+
+		hours = []int{c.Hour()}
+	}
+
+	if r.Freq <= RecurrenceFreqMINUTELY {
+
+		if len(r.ByMinute) > 0 && !containsInt(r.ByMinute, c.Minute()) {
+			return nil
+		}
+
+		minutes = []int{c.Minute()}
+	}
+
+	if r.Freq == RecurrenceFreqSECONDLY {
+
+		if len(r.BySecond) > 0 && !containsInt(r.BySecond, c.Second()) {
+			return nil
+		}
+
+		seconds = []int{c.Second()}
+	}
+
+	nanos := time.Duration(e.startNaive.Nanosecond())
+
+	times := make([]time.Time, 0, len(hours)*len(minutes)*len(seconds))
+
+	for _, h := range hours {
+		for _, m := range minutes {
+			for _, s := range seconds {
+				times = append(times, day.Add(time.Duration(h)*time.Hour+
+					time.Duration(m)*time.Minute+time.Duration(s)*time.Second+nanos))
+			}
+		}
+	}
+
+	return times
+}
+
+// containsInt - Returns 'true' if 'values' contains 'v'.
+func containsInt(values []int, v int) bool {
+
+	for _, value := range values {
+
+		if value == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rfcWeekOneStart - Returns the first day of week number one of 'year'.
+// Weeks begin on 'weekStart'. Week number one is the first week which
+// contains at least four days of the calendar year. See RFC 5545 BYWEEKNO.
+func rfcWeekOneStart(year int, weekStart time.Weekday) time.Time {
+
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	offset := (int(jan1.Weekday()) - int(weekStart) + 7) % 7
+
+	if 7-offset >= 4 {
+		return jan1.AddDate(0, 0, -offset)
+	}
+
+	return jan1.AddDate(0, 0, 7-offset)
+}
+
+// rfcWeekNumber - Returns the week numbering year and the week number of
+// wall clock date 'day'.
+func rfcWeekNumber(day time.Time, weekStart time.Weekday) (int, int) {
+
+	year := day.Year()
+
+	start := rfcWeekOneStart(year, weekStart)
+
+	if day.Before(start) {
+
+		year--
+		start = rfcWeekOneStart(year, weekStart)
+
+	} else if nextStart := rfcWeekOneStart(year+1, weekStart); !day.Before(nextStart) {
+
+		year++
+		start = nextStart
+	}
+
+	return year, int(day.Sub(start).Hours()/24)/7 + 1
+}
+
+// rfcWeeksInYear - Returns the number of weeks (52 or 53) in week
+// numbering year 'year'.
+func rfcWeeksInYear(year int, weekStart time.Weekday) int {
+
+	days := rfcWeekOneStart(year+1, weekStart).Sub(rfcWeekOneStart(year, weekStart)).Hours() / 24
+
+	return int(days) / 7
+}
@@ -0,0 +1,852 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ RecurrenceRuleDto
+ =================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\recurrenceruledto.go
+
+ Overview and Usage
+ ==================
+ The 'RecurrenceRuleDto' Type implements iCalendar (RFC 5545) recurrence
+ rules (RRULE) together with RDATE and EXDATE exceptions. Occurrences are
+ returned as 'DateTzDto' instances in the time zone of the starting date
+ time, 'DtStart'.
+
+ Supported rule parts:
+
+	FREQ       - SECONDLY, MINUTELY, HOURLY, DAILY, WEEKLY, MONTHLY, YEARLY
+	INTERVAL   - Default 1
+	COUNT      - Maximum number of occurrences generated by the rule
+	UNTIL      - Last date time (inclusive). COUNT and UNTIL may not both be
+	             specified.
+	BYSECOND, BYMINUTE, BYHOUR, BYDAY, BYMONTHDAY, BYYEARDAY, BYWEEKNO,
+	BYMONTH, BYSETPOS
+	WKST       - First day of the week. Default MO
+
+ Recurrences are computed using local wall clock times in the time zone of
+ 'DtStart'. An occurrence at 10:00 America/New_York remains at 10:00 local
+ time when Daylight Savings Time begins or ends. Local times falling in a
+ Daylight Savings Time gap are shifted forward by the length of the gap.
+ Local times occurring twice resolve to the first occurrence.
+
+ Example: Every second Tuesday at 10:00 New York time until the end of 2027.
+
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+	dtStart, _ := DateTzDto{}.New(time.Date(2026, 1, 6, 10, 0, 0, 0, loc), "")
+
+	rule, err := RecurrenceRuleDto{}.New(
+	              "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;UNTIL=20271231T235959Z", dtStart)
+
+	iter := rule.NewIterator()
+
+	for dtz, ok := iter.Next(); ok; dtz, ok = iter.Next() {
+		...
+	}
+
+ Occurrences may also be retrieved with GetBetween() and GetOccurrences().
+ The rule expansion is located in source file 'recurrenceexpansionutility.go'.
+
+*/
+
+// RecurrenceFreqType - The frequency of an iCalendar recurrence rule.
+type RecurrenceFreqType int
+
+// String - Returns a string equivalent to the
+// integer value of RecurrenceFreqType
+func (rFreq RecurrenceFreqType) String() string {
+
+	return RecurrenceFreqTypeLabels[rFreq]
+}
+
+// Recurrence Frequencies
+const (
+
+	// RecurrenceFreqSECONDLY - Repeats every 'Interval' seconds
+	RecurrenceFreqSECONDLY RecurrenceFreqType = iota
+
+	// RecurrenceFreqMINUTELY - Repeats every 'Interval' minutes
+	RecurrenceFreqMINUTELY
+
+	// RecurrenceFreqHOURLY - Repeats every 'Interval' hours
+	RecurrenceFreqHOURLY
+
+	// RecurrenceFreqDAILY - Repeats every 'Interval' days
+	RecurrenceFreqDAILY
+
+	// RecurrenceFreqWEEKLY - Repeats every 'Interval' weeks
+	RecurrenceFreqWEEKLY
+
+	// RecurrenceFreqMONTHLY - Repeats every 'Interval' months
+	RecurrenceFreqMONTHLY
+
+	// RecurrenceFreqYEARLY - Repeats every 'Interval' years
+	RecurrenceFreqYEARLY
+)
+
+// RecurrenceFreqTypeLabels - Text Names associated with RecurrenceFreqType
+// types. The labels are equal to the iCalendar FREQ values.
+var RecurrenceFreqTypeLabels = [...]string{"SECONDLY", "MINUTELY", "HOURLY", "DAILY",
+	"WEEKLY", "MONTHLY", "YEARLY"}
+
+// RecurrenceWeekDayDto - A BYDAY rule part element. If 'Nth' is zero,
+// every 'WeekDay' in the period matches. Otherwise, only the nth
+// 'WeekDay' of the month or year matches. Negative values count from
+// the end of the month or year. Example: -1FR = last Friday
+type RecurrenceWeekDayDto struct {
+	WeekDay time.Weekday
+	Nth     int
+}
+
+// String - Returns the iCalendar representation of the
+// RecurrenceWeekDayDto. Example: "2TU"
+func (rWkDay RecurrenceWeekDayDto) String() string {
+
+	if rWkDay.Nth == 0 {
+		return iCalWeekDayCodes[rWkDay.WeekDay]
+	}
+
+	return strconv.Itoa(rWkDay.Nth) + iCalWeekDayCodes[rWkDay.WeekDay]
+}
+
+// RecurrenceRuleDto - An iCalendar recurrence rule with RDATE and
+// EXDATE exceptions.
+type RecurrenceRuleDto struct {
+	DtStart    DateTzDto              // First occurrence. Determines the time zone of the rule.
+	Freq       RecurrenceFreqType     // FREQ
+	Interval   int                    // INTERVAL. Minimum value 1.
+	Count      int                    // COUNT. Zero = no limit.
+	Until      DateTzDto              // UNTIL. Zero value = no limit.
+	BySecond   []int                  // BYSECOND 0-59
+	ByMinute   []int                  // BYMINUTE 0-59
+	ByHour     []int                  // BYHOUR 0-23
+	ByDay      []RecurrenceWeekDayDto // BYDAY
+	ByMonthDay []int                  // BYMONTHDAY 1 to 31 or -31 to -1
+	ByYearDay  []int                  // BYYEARDAY 1 to 366 or -366 to -1
+	ByWeekNo   []int                  // BYWEEKNO 1 to 53 or -53 to -1
+	ByMonth    []int                  // BYMONTH 1-12
+	BySetPos   []int                  // BYSETPOS 1 to 366 or -366 to -1
+	WeekStart  time.Weekday           // WKST. Default Monday.
+	RDates     []DateTzDto            // Additional occurrences (RDATE)
+	ExDates    []DateTzDto            // Excluded occurrences (EXDATE)
+}
+
+// rRuleByDayRegex - Matches a single BYDAY element. Example: "-1FR"
+var rRuleByDayRegex = regexp.MustCompile(`^([+-]?\d{1,2})?(MO|TU|WE|TH|FR|SA|SU)$`)
+
+// AddExDate - Excludes the occurrence at date time 'exDate'. Occurrences
+// are matched by instant. EXDATE values do not affect COUNT.
+func (rRule *RecurrenceRuleDto) AddExDate(exDate DateTzDto) {
+
+	rRule.ExDates = append(rRule.ExDates, exDate.CopyOut())
+}
+
+// AddRDate - Adds an occurrence at date time 'rDate' which is not generated
+// by the recurrence rule.
+func (rRule *RecurrenceRuleDto) AddRDate(rDate DateTzDto) {
+
+	rRule.RDates = append(rRule.RDates, rDate.CopyOut())
+}
+
+// CopyOut - Returns a deep copy of the current RecurrenceRuleDto.
+func (rRule *RecurrenceRuleDto) CopyOut() RecurrenceRuleDto {
+
+	rRule2 := RecurrenceRuleDto{}
+
+	rRule2.DtStart = rRule.DtStart.CopyOut()
+	rRule2.Freq = rRule.Freq
+	rRule2.Interval = rRule.Interval
+	rRule2.Count = rRule.Count
+	rRule2.Until = rRule.Until.CopyOut()
+	rRule2.BySecond = append([]int(nil), rRule.BySecond...)
+	rRule2.ByMinute = append([]int(nil), rRule.ByMinute...)
+	rRule2.ByHour = append([]int(nil), rRule.ByHour...)
+	rRule2.ByDay = append([]RecurrenceWeekDayDto(nil), rRule.ByDay...)
+	rRule2.ByMonthDay = append([]int(nil), rRule.ByMonthDay...)
+	rRule2.ByYearDay = append([]int(nil), rRule.ByYearDay...)
+	rRule2.ByWeekNo = append([]int(nil), rRule.ByWeekNo...)
+	rRule2.ByMonth = append([]int(nil), rRule.ByMonth...)
+	rRule2.BySetPos = append([]int(nil), rRule.BySetPos...)
+	rRule2.WeekStart = rRule.WeekStart
+
+	for _, rDate := range rRule.RDates {
+		rRule2.RDates = append(rRule2.RDates, rDate.CopyOut())
+	}
+
+	for _, exDate := range rRule.ExDates {
+		rRule2.ExDates = append(rRule2.ExDates, exDate.CopyOut())
+	}
+
+	return rRule2
+}
+
+// GetBetween - Returns all occurrences which fall between 'startDateTime'
+// and 'endDateTime'. If 'inclusive' is 'true', occurrences equal to
+// 'startDateTime' or 'endDateTime' are included.
+func (rRule *RecurrenceRuleDto) GetBetween(
+	startDateTime,
+	endDateTime DateTzDto,
+	inclusive bool) ([]DateTzDto, error) {
+
+	ePrefix := "RecurrenceRuleDto.GetBetween() "
+
+	err := rRule.IsValid()
+
+	if err != nil {
+		return nil, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	start := startDateTime.DateTime
+	end := endDateTime.DateTime
+
+	if end.Before(start) {
+		return nil, errors.New(ePrefix + "Error: 'endDateTime' is before 'startDateTime'!")
+	}
+
+	occurrences := make([]DateTzDto, 0, 10)
+
+	iter := rRule.NewIterator()
+
+	for dtz, ok := iter.Next(); ok; dtz, ok = iter.Next() {
+
+		t := dtz.DateTime
+
+		if t.After(end) || (!inclusive && t.Equal(end)) {
+			break
+		}
+
+		if t.Before(start) || (!inclusive && t.Equal(start)) {
+			continue
+		}
+
+		occurrences = append(occurrences, dtz)
+	}
+
+	if iter.err != nil {
+		return nil, fmt.Errorf(ePrefix + "%v", iter.err.Error())
+	}
+
+	return occurrences, nil
+}
+
+// GetOccurrences - Returns the first 'maxCount' occurrences. If 'maxCount'
+// is less than or equal to zero, all occurrences are returned. In that case
+// the rule must be limited by COUNT or UNTIL.
+func (rRule *RecurrenceRuleDto) GetOccurrences(maxCount int) ([]DateTzDto, error) {
+
+	ePrefix := "RecurrenceRuleDto.GetOccurrences() "
+
+	err := rRule.IsValid()
+
+	if err != nil {
+		return nil, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	if maxCount <= 0 && rRule.Count == 0 && rRule.Until.DateTime.IsZero() {
+		return nil, errors.New(ePrefix +
+			"Error: The recurrence rule is unlimited. 'maxCount' must be greater than zero!")
+	}
+
+	occurrences := make([]DateTzDto, 0, 10)
+
+	iter := rRule.NewIterator()
+
+	for dtz, ok := iter.Next(); ok; dtz, ok = iter.Next() {
+
+		occurrences = append(occurrences, dtz)
+
+		if maxCount > 0 && len(occurrences) >= maxCount {
+			break
+		}
+	}
+
+	if iter.err != nil {
+		return nil, fmt.Errorf(ePrefix + "%v", iter.err.Error())
+	}
+
+	return occurrences, nil
+}
+
+// GetRRuleStr - Returns the RRULE value of the current RecurrenceRuleDto.
+// DtStart, RDATE and EXDATE values are not included. UNTIL is formatted
+// as a UTC date time.
+//
+// Example: "FREQ=WEEKLY;UNTIL=20271231T235959Z;INTERVAL=2;BYDAY=TU"
+func (rRule *RecurrenceRuleDto) GetRRuleStr() string {
+
+	parts := make([]string, 0, 8)
+
+	parts = append(parts, "FREQ="+rRule.Freq.String())
+
+	if !rRule.Until.DateTime.IsZero() {
+		parts = append(parts, "UNTIL="+formatICalUTCDateTime(rRule.Until.DateTime))
+	}
+
+	if rRule.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(rRule.Count))
+	}
+
+	if rRule.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(rRule.Interval))
+	}
+
+	intList := func(name string, values []int) {
+
+		if len(values) == 0 {
+			return
+		}
+
+		strs := make([]string, len(values))
+
+		for i, v := range values {
+			strs[i] = strconv.Itoa(v)
+		}
+
+		parts = append(parts, name+"="+strings.Join(strs, ","))
+	}
+
+	intList("BYSECOND", rRule.BySecond)
+	intList("BYMINUTE", rRule.ByMinute)
+	intList("BYHOUR", rRule.ByHour)
+
+	if len(rRule.ByDay) > 0 {
+
+		strs := make([]string, len(rRule.ByDay))
+
+		for i, wd := range rRule.ByDay {
+			strs[i] = wd.String()
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(strs, ","))
+	}
+
+	intList("BYMONTHDAY", rRule.ByMonthDay)
+	intList("BYYEARDAY", rRule.ByYearDay)
+	intList("BYWEEKNO", rRule.ByWeekNo)
+	intList("BYMONTH", rRule.ByMonth)
+	intList("BYSETPOS", rRule.BySetPos)
+
+	if rRule.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+iCalWeekDayCodes[rRule.WeekStart])
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// IsValid - Returns an error if the current RecurrenceRuleDto is invalid.
+func (rRule *RecurrenceRuleDto) IsValid() error {
+
+	ePrefix := "RecurrenceRuleDto.IsValid() "
+
+	if rRule.DtStart.DateTime.IsZero() {
+		return errors.New(ePrefix + "Error: DtStart is a ZERO value!")
+	}
+
+	if rRule.Freq < RecurrenceFreqSECONDLY || rRule.Freq > RecurrenceFreqYEARLY {
+		return fmt.Errorf(ePrefix + "Error: Invalid Freq. Freq='%v'", int(rRule.Freq))
+	}
+
+	if rRule.Interval < 1 {
+		return fmt.Errorf(ePrefix + "Error: Interval must be greater than zero. Interval='%v'",
+			rRule.Interval)
+	}
+
+	if rRule.Count < 0 {
+		return fmt.Errorf(ePrefix + "Error: Count is negative. Count='%v'", rRule.Count)
+	}
+
+	if rRule.Count > 0 && !rRule.Until.DateTime.IsZero() {
+		return errors.New(ePrefix + "Error: COUNT and UNTIL may not both be specified!")
+	}
+
+	checkRange := func(name string, values []int, min, max int, allowNegative bool) error {
+
+		for _, v := range values {
+
+			if allowNegative && v < 0 {
+				v = -v
+			}
+
+			if v < min || v > max {
+				return fmt.Errorf(ePrefix + "Error: %v value is out of range. value='%v'", name, v)
+			}
+		}
+
+		return nil
+	}
+
+	checks := []struct {
+		name          string
+		values        []int
+		min, max      int
+		allowNegative bool
+	}{
+		{"BYSECOND", rRule.BySecond, 0, 59, false},
+		{"BYMINUTE", rRule.ByMinute, 0, 59, false},
+		{"BYHOUR", rRule.ByHour, 0, 23, false},
+		{"BYMONTHDAY", rRule.ByMonthDay, 1, 31, true},
+		{"BYYEARDAY", rRule.ByYearDay, 1, 366, true},
+		{"BYWEEKNO", rRule.ByWeekNo, 1, 53, true},
+		{"BYMONTH", rRule.ByMonth, 1, 12, false},
+		{"BYSETPOS", rRule.BySetPos, 1, 366, true},
+	}
+
+	for _, c := range checks {
+
+		err := checkRange(c.name, c.values, c.min, c.max, c.allowNegative)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, wd := range rRule.ByDay {
+
+		if wd.WeekDay < time.Sunday || wd.WeekDay > time.Saturday || wd.Nth > 53 || wd.Nth < -53 {
+			return fmt.Errorf(ePrefix + "Error: Invalid BYDAY value. WeekDay='%v' Nth='%v'",
+				int(wd.WeekDay), wd.Nth)
+		}
+
+		if wd.Nth != 0 && rRule.Freq != RecurrenceFreqMONTHLY && rRule.Freq != RecurrenceFreqYEARLY {
+			return fmt.Errorf(ePrefix + "Error: Numeric BYDAY values are only valid with " +
+				"MONTHLY or YEARLY rules. BYDAY='%v'", wd.String())
+		}
+
+		if wd.Nth != 0 && rRule.Freq == RecurrenceFreqYEARLY && len(rRule.ByWeekNo) > 0 {
+			return fmt.Errorf(ePrefix + "Error: Numeric BYDAY values may not be combined " +
+				"with BYWEEKNO. BYDAY='%v'", wd.String())
+		}
+	}
+
+	if len(rRule.ByWeekNo) > 0 && rRule.Freq != RecurrenceFreqYEARLY {
+		return errors.New(ePrefix + "Error: BYWEEKNO is only valid with YEARLY rules!")
+	}
+
+	if len(rRule.ByYearDay) > 0 &&
+		(rRule.Freq == RecurrenceFreqDAILY || rRule.Freq == RecurrenceFreqWEEKLY ||
+			rRule.Freq == RecurrenceFreqMONTHLY) {
+		return errors.New(ePrefix + "Error: BYYEARDAY is not valid with DAILY, WEEKLY or MONTHLY rules!")
+	}
+
+	if len(rRule.ByMonthDay) > 0 && rRule.Freq == RecurrenceFreqWEEKLY {
+		return errors.New(ePrefix + "Error: BYMONTHDAY is not valid with WEEKLY rules!")
+	}
+
+	if rRule.WeekStart < time.Sunday || rRule.WeekStart > time.Saturday {
+		return fmt.Errorf(ePrefix + "Error: Invalid WeekStart. WeekStart='%v'", int(rRule.WeekStart))
+	}
+
+	return nil
+}
+
+// New - Creates a new RecurrenceRuleDto from an iCalendar RRULE value and
+// a starting date time. The "RRULE:" prefix is optional. Occurrences are
+// computed in the time zone of 'dtStart'.
+//
+// A local UNTIL value (no trailing 'Z') is interpreted in the time zone of
+// 'dtStart'. A DATE UNTIL value includes the entire day.
+//
+// Example:
+//
+//	rule, err := RecurrenceRuleDto{}.New("FREQ=MONTHLY;BYDAY=-1FR;COUNT=6", dtStart)
+//
+func (rRule RecurrenceRuleDto) New(rRuleStr string, dtStart DateTzDto) (RecurrenceRuleDto, error) {
+
+	ePrefix := "RecurrenceRuleDto.New() "
+
+	if dtStart.DateTime.IsZero() {
+		return RecurrenceRuleDto{}, errors.New(ePrefix + "Error: Input parameter 'dtStart' is ZERO!")
+	}
+
+	rRule2 := RecurrenceRuleDto{}
+
+	rRule2.DtStart = dtStart.CopyOut()
+
+	err := rRule2.parseRRuleStr(rRuleStr)
+
+	if err != nil {
+		return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	err = rRule2.IsValid()
+
+	if err != nil {
+		return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return rRule2, nil
+}
+
+// NewFromICalendar - Creates a new RecurrenceRuleDto from iCalendar
+// content lines containing a DTSTART property, a single RRULE property
+// and optional RDATE and EXDATE properties. Other properties are ignored.
+// If 'dateTimeFmtStr' is an empty string, a default date time format is
+// applied to the returned DateTzDto values.
+//
+// Example:
+//
+//	DTSTART;TZID=America/New_York:20260106T100000
+//	RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;UNTIL=20271231T235959Z
+//	EXDATE;TZID=America/New_York:20260203T100000
+//	RDATE;TZID=America/New_York:20260205T100000
+//
+func (rRule RecurrenceRuleDto) NewFromICalendar(iCalText, dateTimeFmtStr string) (RecurrenceRuleDto, error) {
+
+	ePrefix := "RecurrenceRuleDto.NewFromICalendar() "
+
+	var dtStart time.Time
+	var rRuleStr string
+	var rDates, exDates []time.Time
+
+	lines := unfoldICalLines(iCalText)
+
+	// DTSTART determines the default location of the other properties.
+	for _, line := range lines {
+
+		name, params, value, err := parseICalContentLine(line)
+
+		if err != nil {
+			return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		if name != "DTSTART" {
+			continue
+		}
+
+		dtStart, _, err = parseICalDateTime(value, params["TZID"], time.UTC)
+
+		if err != nil {
+			return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "DTSTART %v", err.Error())
+		}
+	}
+
+	if dtStart.IsZero() {
+		return RecurrenceRuleDto{}, errors.New(ePrefix + "Error: DTSTART property is missing!")
+	}
+
+	for _, line := range lines {
+
+		name, params, value, _ := parseICalContentLine(line)
+
+		switch name {
+
+		case "RRULE":
+
+			if len(rRuleStr) > 0 {
+				return RecurrenceRuleDto{}, errors.New(ePrefix + "Error: Multiple RRULE properties are not supported!")
+			}
+
+			rRuleStr = value
+
+		case "RDATE", "EXDATE":
+
+			if strings.ToUpper(params["VALUE"]) == "PERIOD" {
+				return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "Error: %v PERIOD values are not supported!", name)
+			}
+
+			for _, v := range strings.Split(value, ",") {
+
+				t, _, err := parseICalDateTime(v, params["TZID"], dtStart.Location())
+
+				if err != nil {
+					return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "%v %v", name, err.Error())
+				}
+
+				if name == "RDATE" {
+					rDates = append(rDates, t)
+				} else {
+					exDates = append(exDates, t)
+				}
+			}
+		}
+	}
+
+	if len(rRuleStr) == 0 {
+		return RecurrenceRuleDto{}, errors.New(ePrefix + "Error: RRULE property is missing!")
+	}
+
+	dtStartDtz, err := DateTzDto{}.New(dtStart, dateTimeFmtStr)
+
+	if err != nil {
+		return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	rRule2, err := RecurrenceRuleDto{}.New(rRuleStr, dtStartDtz)
+
+	if err != nil {
+		return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	for _, t := range rDates {
+
+		dtz, err := DateTzDto{}.New(t.In(dtStart.Location()), dtStartDtz.DateTimeFmt)
+
+		if err != nil {
+			return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		rRule2.AddRDate(dtz)
+	}
+
+	for _, t := range exDates {
+
+		dtz, err := DateTzDto{}.New(t.In(dtStart.Location()), dtStartDtz.DateTimeFmt)
+
+		if err != nil {
+			return RecurrenceRuleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		rRule2.AddExDate(dtz)
+	}
+
+	return rRule2, nil
+}
+
+// NewIterator - Returns an iterator which lazily computes the occurrences
+// of the current RecurrenceRuleDto in ascending order. Changes made to the
+// RecurrenceRuleDto after the iterator is created do not affect the
+// iterator.
+//
+// Example:
+//
+//	iter := rule.NewIterator()
+//
+//	for dtz, ok := iter.Next(); ok; dtz, ok = iter.Next() {
+//		fmt.Println(dtz.String())
+//	}
+//
+//	if err := iter.Error(); err != nil {
+//		...
+//	}
+//
+func (rRule *RecurrenceRuleDto) NewIterator() *RecurrenceIteratorDto {
+
+	return newRecurrenceIterator(rRule)
+}
+
+// parseRRuleStr - Parses an RRULE value and assigns the rule parts to
+// the current RecurrenceRuleDto. 'rRule.DtStart' must be set before
+// calling this method.
+func (rRule *RecurrenceRuleDto) parseRRuleStr(rRuleStr string) error {
+
+	s := strings.TrimSpace(rRuleStr)
+
+	if strings.HasPrefix(strings.ToUpper(s), "RRULE:") {
+		s = s[6:]
+	}
+
+	if len(s) == 0 {
+		return errors.New("Error: RRULE value is empty!")
+	}
+
+	rRule.Interval = 1
+	rRule.WeekStart = time.Monday
+
+	hasFreq := false
+
+	intList := func(name, value string) ([]int, error) {
+
+		values := make([]int, 0, 4)
+
+		for _, element := range strings.Split(value, ",") {
+
+			v, err := strconv.Atoi(strings.TrimSpace(element))
+
+			if err != nil {
+				return nil, fmt.Errorf("Error: Invalid %v value. value='%v'", name, element)
+			}
+
+			values = append(values, v)
+		}
+
+		return values, nil
+	}
+
+	for _, part := range strings.Split(s, ";") {
+
+		if len(part) == 0 {
+			continue
+		}
+
+		eq := strings.Index(part, "=")
+
+		if eq < 0 {
+			return fmt.Errorf("Error: Invalid RRULE part. part='%v'", part)
+		}
+
+		name := strings.ToUpper(strings.TrimSpace(part[:eq]))
+		value := strings.ToUpper(strings.TrimSpace(part[eq+1:]))
+
+		var err error
+
+		switch name {
+
+		case "FREQ":
+
+			found := false
+
+			for i, label := range RecurrenceFreqTypeLabels {
+
+				if label == value {
+					rRule.Freq = RecurrenceFreqType(i)
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("Error: Invalid FREQ value. FREQ='%v'", value)
+			}
+
+			hasFreq = true
+
+		case "INTERVAL":
+
+			rRule.Interval, err = strconv.Atoi(value)
+
+		case "COUNT":
+
+			rRule.Count, err = strconv.Atoi(value)
+
+			if err == nil && rRule.Count < 1 {
+				err = errors.New("COUNT must be greater than zero")
+			}
+
+		case "UNTIL":
+
+			var until time.Time
+			var isDate bool
+
+			until, isDate, err = parseICalDateTime(value, "", rRule.DtStart.DateTime.Location())
+
+			if err == nil && isDate {
+				y, m, d := until.Date()
+				until = startOfLocalDay(y, m, d+1, until.Location()).Add(-time.Nanosecond)
+			}
+
+			if err == nil {
+				rRule.Until, err = DateTzDto{}.New(until.In(rRule.DtStart.DateTime.Location()),
+					rRule.DtStart.DateTimeFmt)
+			}
+
+		case "BYSECOND":
+			rRule.BySecond, err = intList(name, value)
+
+		case "BYMINUTE":
+			rRule.ByMinute, err = intList(name, value)
+
+		case "BYHOUR":
+			rRule.ByHour, err = intList(name, value)
+
+		case "BYMONTHDAY":
+			rRule.ByMonthDay, err = intList(name, value)
+
+		case "BYYEARDAY":
+			rRule.ByYearDay, err = intList(name, value)
+
+		case "BYWEEKNO":
+			rRule.ByWeekNo, err = intList(name, value)
+
+		case "BYMONTH":
+			rRule.ByMonth, err = intList(name, value)
+
+		case "BYSETPOS":
+			rRule.BySetPos, err = intList(name, value)
+
+		case "BYDAY":
+
+			rRule.ByDay = make([]RecurrenceWeekDayDto, 0, 4)
+
+			for _, element := range strings.Split(value, ",") {
+
+				match := rRuleByDayRegex.FindStringSubmatch(strings.TrimSpace(element))
+
+				if match == nil {
+					return fmt.Errorf("Error: Invalid BYDAY value. value='%v'", element)
+				}
+
+				wd := RecurrenceWeekDayDto{}
+
+				wd.WeekDay, _ = parseICalWeekDay(match[2])
+
+				if len(match[1]) > 0 {
+					wd.Nth, _ = strconv.Atoi(match[1])
+
+					if wd.Nth == 0 {
+						return fmt.Errorf("Error: Invalid BYDAY value. value='%v'", element)
+					}
+				}
+
+				rRule.ByDay = append(rRule.ByDay, wd)
+			}
+
+		case "WKST":
+
+			rRule.WeekStart, err = parseICalWeekDay(value)
+
+		default:
+			return fmt.Errorf("Error: Unsupported RRULE part. part='%v'", part)
+		}
+
+		if err != nil {
+			return fmt.Errorf("Error: Invalid %v value. value='%v' Error='%v'", name, value, err.Error())
+		}
+	}
+
+	if !hasFreq {
+		return errors.New("Error: RRULE does not contain FREQ!")
+	}
+
+	return nil
+}
+
+// parseICalWeekDay - Converts a two letter iCalendar weekday code to
+// a time.Weekday.
+func parseICalWeekDay(code string) (time.Weekday, error) {
+
+	for i, c := range iCalWeekDayCodes {
+
+		if c == strings.ToUpper(code) {
+			return time.Weekday(i), nil
+		}
+	}
+
+	return time.Sunday, fmt.Errorf("Error: Invalid weekday code. code='%v'", code)
+}
+
+// sortedUniqueInts - Returns a sorted copy of 'values' with duplicates
+// removed.
+func sortedUniqueInts(values []int) []int {
+
+	result := append([]int(nil), values...)
+
+	sort.Ints(result)
+
+	n := 0
+
+	for i, v := range result {
+
+		if i > 0 && v == result[n-1] {
+			continue
+		}
+
+		result[n] = v
+		n++
+	}
+
+	return result[:n]
+}
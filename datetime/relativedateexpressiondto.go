@@ -0,0 +1,1055 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ RelativeDateExpressionDto
+ =========================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\relativedateexpressiondto.go
+
+ Overview and Usage
+ ==================
+ The 'RelativeDateExpressionDto' Type parses English relative date
+ expressions such as "tomorrow at noon", "next Friday", "3 days ago",
+ "end of month", "first Monday of March" or "in 90 minutes". The
+ expression is resolved against a reference date time in an IANA time
+ zone. Absolute date time strings are parsed by method
+ FormatDateTimeUtility.ParseDateTimeString().
+
+ Expressions are case insensitive. An expression consists of a date
+ clause OR an offset clause, optionally combined with a time of day
+ clause in either order. Example: "next Tuesday 3pm", "3pm next Tuesday"
+
+ Date Clauses:
+
+	now                            The reference date time
+	today, tomorrow, yesterday
+	the day after tomorrow
+	the day before yesterday
+	Friday, this Friday            The next Friday on or after the
+	                               reference date
+	next Friday                    The first Friday after the reference
+	                               date
+	last Friday                    The last Friday before the reference
+	                               date
+	next week, last week           Plus or minus 7 days
+	next month, last month         Plus or minus 1 calendar month
+	next year, last year           Plus or minus 1 calendar year
+	start of <period>              The first day of the period
+	beginning of <period>
+	end of <period>                The last day of the period
+	first Monday of <period>       Ordinals: first - fifth, 1st - 5th
+	last Friday in <period>        and last. <period> must be a month or
+	                               a year.
+
+	<period>: [the] [this|next|last] week|month|year
+	          March, March 2027    A named month without a year refers
+	                               to the next occurrence of the month.
+	                               If the selected date precedes the
+	                               reference date, the following year is
+	                               used.
+
+	Weeks begin on Monday. Weekday names and month names may be
+	abbreviated to three letters. Example: "Tue", "Sep"
+
+ Offset Clauses:
+
+	in 90 minutes
+	in 2 hours and 30 minutes
+	3 days ago
+	a week from now
+	2 months later
+
+	Units: second(s), sec(s), minute(s), min(s), hour(s), hr(s),
+	day(s), week(s), month(s), quarter(s), year(s). The amount may
+	be an integer or "a", "an" or "one".
+
+	Seconds, minutes and hours are added as elapsed time. Days and
+	weeks are added to the local wall clock date. Months, quarters and
+	years are calendar units. If the day of month does not exist in the
+	target month, the last day of the month is used (MonthEndOverflowCLAMP).
+
+ Time of Day Clauses:
+
+	[at] noon, midday, midnight
+	[at] 3pm, 3:30pm, 3 pm, 3:30 p.m.
+	[at] 15:00
+	at 15                          A bare hour requires "at"
+
+ Time of Day Policy:
+
+	(1) A date clause without a time of day resolves to the start of
+	    the day (00:00), except "now", which retains the reference time
+	    of day.
+
+	(2) An offset clause retains the reference time of day unless a
+	    time of day is specified. A time of day may not be combined with
+	    an offset in seconds, minutes or hours.
+
+	(3) A time of day without a date clause or offset clause applies to
+	    the reference date.
+
+	(4) Wall clock times skipped when Daylight Savings Time begins
+	    resolve to the first instant following the gap. Wall clock times
+	    which occur twice when Daylight Savings Time ends resolve to the
+	    first occurrence.
+
+ Interpretation:
+
+ The 'Interpretation' field contains a canonical restatement of the
+ parsed expression suitable for confirmation prompts. Example: "Tue
+ 3 p.m." is restated as "Tuesday at 15:00". Method GetConfirmationStr()
+ appends the resolved date time.
+
+ Example:
+
+	relDate, err := RelativeDateExpressionDto{}.New(
+	                  "next Tuesday 3pm", refDtz, TzIanaUsCentral, "")
+
+	relDate.DateTime        = 2026-10-20 15:00:00 CDT
+	relDate.Interpretation  = "next Tuesday at 15:00"
+
+*/
+
+// relDateTimeOfDayRegex - Matches time of day tokens such as "3pm",
+// "3:30pm", "15:00" or "15".
+var relDateTimeOfDayRegex = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+
+// relDateYearRegex - Matches a four digit year
+var relDateYearRegex = regexp.MustCompile(`^\d{4}$`)
+
+// relDateWeekDays - Weekday names and abbreviations
+var relDateWeekDays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// relDateMonths - Month names and abbreviations
+var relDateMonths = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may": time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+// relDateOrdinals - Ordinal words and their values. The value -1
+// selects the last occurrence.
+var relDateOrdinals = map[string]int{
+	"first": 1, "1st": 1,
+	"second": 2, "2nd": 2,
+	"third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4,
+	"fifth": 5, "5th": 5,
+	"last": -1,
+}
+
+// relDateOrdinalNames - Canonical ordinal names indexed by ordinal value
+var relDateOrdinalNames = []string{"", "first", "second", "third", "fourth", "fifth"}
+
+// relDateUnits - Offset unit words and their time units
+var relDateUnits = map[string]TimeUnitType{
+	"second": TimeUnitSECONDS, "seconds": TimeUnitSECONDS, "sec": TimeUnitSECONDS, "secs": TimeUnitSECONDS,
+	"minute": TimeUnitMINUTES, "minutes": TimeUnitMINUTES, "min": TimeUnitMINUTES, "mins": TimeUnitMINUTES,
+	"hour": TimeUnitHOURS, "hours": TimeUnitHOURS, "hr": TimeUnitHOURS, "hrs": TimeUnitHOURS,
+	"day": TimeUnitDAYS, "days": TimeUnitDAYS,
+	"week": TimeUnitWEEKS, "weeks": TimeUnitWEEKS,
+	"month": TimeUnitMONTHS, "months": TimeUnitMONTHS,
+	"quarter": TimeUnitQUARTERS, "quarters": TimeUnitQUARTERS,
+	"year": TimeUnitYEARS, "years": TimeUnitYEARS,
+}
+
+// relDatePeriodUnits - Period words and their time units
+var relDatePeriodUnits = map[string]TimeUnitType{
+	"week":  TimeUnitWEEKS,
+	"month": TimeUnitMONTHS,
+	"year":  TimeUnitYEARS,
+}
+
+// RelativeDateExpressionDto - A relative date expression resolved
+// against a reference date time.
+type RelativeDateExpressionDto struct {
+	Expression       string    // The relative date expression submitted for parsing
+	Interpretation   string    // Canonical restatement of the parsed expression. Example: "next Friday at 15:00"
+	ReferenceTime    DateTzDto // The reference date time expressed in TimeZoneLocation
+	DateTime         DateTzDto // The resolved date time expressed in TimeZoneLocation
+	TimeZoneLocation string    // IANA Time Zone Location in which the expression was resolved
+	HasTimeOfDay     bool      // 'true' if the expression specified a time of day
+}
+
+// CopyOut - Returns a deep copy of the current RelativeDateExpressionDto.
+func (relDate *RelativeDateExpressionDto) CopyOut() RelativeDateExpressionDto {
+
+	relDate2 := *relDate
+
+	relDate2.ReferenceTime = relDate.ReferenceTime.CopyOut()
+	relDate2.DateTime = relDate.DateTime.CopyOut()
+
+	return relDate2
+}
+
+// GetConfirmationStr - Returns the interpretation of the expression
+// followed by the resolved date time. Suitable for confirmation prompts.
+//
+// Example: "next Tuesday at 15:00 (Tuesday 2026-10-20 15:00 CDT)"
+//
+func (relDate *RelativeDateExpressionDto) GetConfirmationStr() string {
+
+	return fmt.Sprintf("%v (%v)", relDate.Interpretation,
+		relDate.DateTime.DateTime.Format("Monday 2006-01-02 15:04 MST"))
+}
+
+// New - Parses a relative date expression and resolves it against
+// 'referenceTime' in time zone 'timeZoneLocation'.
+//
+// Input Parameters
+// ================
+//
+// expression string         - A relative date expression. Examples:
+//                             "tomorrow at noon", "next Friday", "3 days ago",
+//                             "end of month", "first Monday of March",
+//                             "in 90 minutes". See the source file header
+//                             for the supported clauses.
+//
+// referenceTime DateTzDto   - The date time against which the expression is
+//                             resolved. Typically the current date time.
+//
+// timeZoneLocation string   - The IANA time zone in which the expression is
+//                             resolved. If 'timeZoneLocation' is submitted as
+//                             an empty string, the time zone of 'referenceTime'
+//                             is applied.
+//
+// dateTimeFmtStr string     - The date time format applied to the returned
+//                             DateTzDto values. If 'dateTimeFmtStr' is submitted
+//                             as an empty string, the format of 'referenceTime'
+//                             is applied.
+//
+func (relDate RelativeDateExpressionDto) New(
+	expression string,
+	referenceTime DateTzDto,
+	timeZoneLocation,
+	dateTimeFmtStr string) (RelativeDateExpressionDto, error) {
+
+	ePrefix := "RelativeDateExpressionDto.New() "
+
+	if referenceTime.DateTime.IsZero() {
+		return RelativeDateExpressionDto{},
+			errors.New(ePrefix + "Error: Input parameter 'referenceTime' has a Zero value!")
+	}
+
+	loc := referenceTime.DateTime.Location()
+
+	if len(timeZoneLocation) > 0 {
+
+		var err error
+
+		loc, err = time.LoadLocation(timeZoneLocation)
+
+		if err != nil {
+			return RelativeDateExpressionDto{}, fmt.Errorf(ePrefix +
+				"Error: 'timeZoneLocation' input parameter is INVALID! " +
+				"timeZoneLocation='%v' Error='%v'", timeZoneLocation, err.Error())
+		}
+	}
+
+	fmtStr := dateTimeFmtStr
+
+	if len(fmtStr) == 0 {
+		fmtStr = referenceTime.DateTimeFmt
+	}
+
+	p := relDateParser{tokens: relDateTokens(expression)}
+
+	if len(p.tokens) == 0 {
+		return RelativeDateExpressionDto{},
+			errors.New(ePrefix + "Error: Input parameter 'expression' is an empty string!")
+	}
+
+	err := p.parse()
+
+	if err != nil {
+		return RelativeDateExpressionDto{}, fmt.Errorf(ePrefix + "%v expression='%v'", err.Error(), expression)
+	}
+
+	base := referenceTime.DateTime.In(loc)
+
+	t, err := p.resolve(base)
+
+	if err != nil {
+		return RelativeDateExpressionDto{}, fmt.Errorf(ePrefix + "%v expression='%v'", err.Error(), expression)
+	}
+
+	relDate2 := RelativeDateExpressionDto{}
+
+	relDate2.Expression = expression
+	relDate2.Interpretation = p.interpretation()
+	relDate2.TimeZoneLocation = loc.String()
+	relDate2.HasTimeOfDay = p.hasTime
+
+	relDate2.ReferenceTime, err = DateTzDto{}.New(base, fmtStr)
+
+	if err != nil {
+		return RelativeDateExpressionDto{},
+			fmt.Errorf(ePrefix + "Error returned by DateTzDto{}.New(base). Error='%v'", err.Error())
+	}
+
+	relDate2.DateTime, err = DateTzDto{}.New(t, fmtStr)
+
+	if err != nil {
+		return RelativeDateExpressionDto{},
+			fmt.Errorf(ePrefix + "Error returned by DateTzDto{}.New(t). Error='%v'", err.Error())
+	}
+
+	return relDate2, nil
+}
+
+// relDatePeriod - A week, month or year referenced by a relative date
+// expression. Examples: "next month", "March 2027"
+type relDatePeriod struct {
+	unit  TimeUnitType // TimeUnitWEEKS, TimeUnitMONTHS or TimeUnitYEARS
+	shift int          // this = 0, next = 1, last = -1
+	month time.Month   // A named month. Zero if the period is not a named month.
+	year  int          // The year of a named month. Zero if omitted.
+}
+
+// bounds - Returns the first and last civil dates of the period. Civil
+// dates are expressed as midnight UTC. 'yearOffset' is added to the year
+// of a named month submitted without a year.
+func (per relDatePeriod) bounds(refDate time.Time, yearOffset int) (start, end time.Time) {
+
+	year, month, _ := refDate.Date()
+
+	switch per.unit {
+
+	case TimeUnitWEEKS:
+		start = refDate.AddDate(0, 0, 1-isoWeekDayNumber(refDate)+7*per.shift)
+		end = start.AddDate(0, 0, 6)
+
+	case TimeUnitMONTHS:
+
+		if per.month != 0 {
+
+			if per.year != 0 {
+				year = per.year
+			} else {
+				year += yearOffset
+			}
+
+			month = per.month
+
+		} else {
+			month += time.Month(per.shift)
+		}
+
+		start = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		end = start.AddDate(0, 1, -1)
+
+	default:
+		start = time.Date(year+per.shift, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end = time.Date(year+per.shift, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+
+	return start, end
+}
+
+// selectDate - Selects a civil date from the period using function 'sel'.
+// A named month without a year refers to the next occurrence of the
+// month. If the month has ended, or the selected date precedes 'refDate',
+// the following year is used.
+func (per relDatePeriod) selectDate(
+	refDate time.Time,
+	sel func(start, end time.Time) (time.Time, bool)) (time.Time, bool) {
+
+	start, end := per.bounds(refDate, 0)
+
+	date, ok := sel(start, end)
+
+	if per.month != 0 && per.year == 0 &&
+		(end.Before(refDate) || ok && date.Before(refDate)) {
+
+		start, end = per.bounds(refDate, 1)
+
+		date, ok = sel(start, end)
+	}
+
+	return date, ok
+}
+
+// text - Returns the canonical name of the period.
+func (per relDatePeriod) text() string {
+
+	if per.month != 0 {
+
+		if per.year != 0 {
+			return fmt.Sprintf("%v %v", per.month.String(), per.year)
+		}
+
+		return per.month.String()
+	}
+
+	names := map[TimeUnitType]string{TimeUnitWEEKS: "week", TimeUnitMONTHS: "month", TimeUnitYEARS: "year"}
+
+	return []string{"last", "this", "next"}[per.shift+1] + " " + names[per.unit]
+}
+
+// relDateParser - Internal state used to parse a relative date expression.
+type relDateParser struct {
+	tokens []string
+	pos    int
+
+	dateFunc func(refDate time.Time) (time.Time, error) // Resolves the civil date of the date clause
+	dateText string
+	keepTime bool // "now" retains the reference time of day
+
+	hasOffset      bool
+	offsetMonths   int
+	offsetDays     int
+	offsetNanosecs int64
+	offsetText     string
+
+	hasTime      bool
+	timeNanosecs int64
+	timeText     string
+}
+
+// interpretation - Returns the canonical restatement of the parsed
+// expression.
+func (p *relDateParser) interpretation() string {
+
+	parts := make([]string, 0, 2)
+
+	if p.dateFunc != nil {
+		parts = append(parts, p.dateText)
+	} else if p.hasOffset {
+		parts = append(parts, p.offsetText)
+	} else {
+		parts = append(parts, "today")
+	}
+
+	if p.hasTime {
+		parts = append(parts, p.timeText)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// parse - Parses the tokens of the expression into date, offset and time
+// of day clauses.
+func (p *relDateParser) parse() error {
+
+	for p.pos < len(p.tokens) {
+
+		tok := p.tokens[p.pos]
+
+		if tok == "at" {
+
+			p.pos++
+
+			ok, err := p.parseTime(true)
+
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				return fmt.Errorf("Error: Expected a time of day after 'at'. Found '%v'.", p.peek(0))
+			}
+
+			continue
+		}
+
+		if tok == "on" || tok == "the" {
+			p.pos++
+			continue
+		}
+
+		ok, err := p.parseOffset()
+
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			continue
+		}
+
+		ok, err = p.parseTime(false)
+
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			continue
+		}
+
+		ok, err = p.parseDate()
+
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return fmt.Errorf("Error: Unrecognized word '%v'.", tok)
+		}
+	}
+
+	if p.dateFunc == nil && !p.hasOffset && !p.hasTime {
+		return errors.New("Error: The expression does not contain a date, offset or time of day.")
+	}
+
+	if p.hasTime && p.keepTime {
+		return errors.New("Error: 'now' may not be combined with a time of day.")
+	}
+
+	if p.hasTime && p.offsetNanosecs != 0 {
+		return errors.New("Error: A time of day may not be combined with an offset in seconds, minutes or hours.")
+	}
+
+	return nil
+}
+
+// parseDate - Parses a date clause at the current position. Returns
+// 'false' if the current token does not begin a date clause.
+func (p *relDateParser) parseDate() (bool, error) {
+
+	tok := p.peek(0)
+
+	switch {
+
+	case tok == "now":
+		p.pos++
+		p.keepTime = true
+		return true, p.setDayOffset("now", 0)
+
+	case tok == "today":
+		p.pos++
+		return true, p.setDayOffset("today", 0)
+
+	case tok == "tomorrow":
+		p.pos++
+		return true, p.setDayOffset("tomorrow", 1)
+
+	case tok == "yesterday":
+		p.pos++
+		return true, p.setDayOffset("yesterday", -1)
+
+	case tok == "day" && p.peek(1) == "after" && p.peek(2) == "tomorrow":
+		p.pos += 3
+		return true, p.setDayOffset("the day after tomorrow", 2)
+
+	case tok == "day" && p.peek(1) == "before" && p.peek(2) == "yesterday":
+		p.pos += 3
+		return true, p.setDayOffset("the day before yesterday", -2)
+	}
+
+	if weekDay, ok := relDateWeekDays[tok]; ok {
+		p.pos++
+		return true, p.setWeekDay(weekDay.String(), weekDay, 0)
+	}
+
+	shift, isShift := map[string]int{"this": 0, "next": 1, "last": -1}[tok]
+
+	if isShift {
+
+		nextTok := p.peek(1)
+
+		weekDay, isWeekDay := relDateWeekDays[nextTok]
+
+		if isWeekDay && !(tok == "last" && (p.peek(2) == "of" || p.peek(2) == "in")) {
+
+			p.pos += 2
+
+			text := weekDay.String()
+
+			if shift != 0 {
+				text = tok + " " + text
+			}
+
+			return true, p.setWeekDay(text, weekDay, shift)
+		}
+
+		if unit, isUnit := relDatePeriodUnits[nextTok]; isUnit && shift != 0 {
+
+			p.pos += 2
+
+			return true, p.setDate(tok+" "+nextTok, func(refDate time.Time) (time.Time, error) {
+
+				if unit == TimeUnitWEEKS {
+					return refDate.AddDate(0, 0, 7*shift), nil
+				}
+
+				return addYearsMonthsOverflow(refDate, 0, shift*unit.calendarMonths(), MonthEndOverflowCLAMP), nil
+			})
+		}
+	}
+
+	if nth, ok := relDateOrdinals[tok]; ok {
+
+		weekDay, isWeekDay := relDateWeekDays[p.peek(1)]
+
+		if isWeekDay && (p.peek(2) == "of" || p.peek(2) == "in") {
+
+			p.pos += 3
+
+			per, err := p.parsePeriod()
+
+			if err != nil {
+				return true, err
+			}
+
+			if per.unit == TimeUnitWEEKS {
+				return true, errors.New("Error: An ordinal weekday requires a month or year.")
+			}
+
+			ordinal := "last"
+
+			if nth > 0 {
+				ordinal = relDateOrdinalNames[nth]
+			}
+
+			text := fmt.Sprintf("%v %v of %v", ordinal, weekDay.String(), per.text())
+
+			return true, p.setDate(text, func(refDate time.Time) (time.Time, error) {
+
+				date, ok := per.selectDate(refDate, func(start, end time.Time) (time.Time, bool) {
+					return relDateNthWeekDay(start, end, weekDay, nth)
+				})
+
+				if !ok {
+					return time.Time{}, fmt.Errorf("Error: There is no %v.", text)
+				}
+
+				return date, nil
+			})
+		}
+	}
+
+	if (tok == "start" || tok == "beginning" || tok == "end") && p.peek(1) == "of" {
+
+		p.pos += 2
+
+		per, err := p.parsePeriod()
+
+		if err != nil {
+			return true, err
+		}
+
+		isEnd := tok == "end"
+
+		return true, p.setDate(tok+" of "+per.text(), func(refDate time.Time) (time.Time, error) {
+
+			date, _ := per.selectDate(refDate, func(start, end time.Time) (time.Time, bool) {
+
+				if isEnd {
+					return end, true
+				}
+
+				return start, true
+			})
+
+			return date, nil
+		})
+	}
+
+	return false, nil
+}
+
+// parseOffset - Parses an offset clause at the current position. Returns
+// 'false' if the current token does not begin an offset clause.
+func (p *relDateParser) parseOffset() (bool, error) {
+
+	start := p.pos
+
+	isPrefixed := p.peek(0) == "in"
+
+	if isPrefixed {
+		p.pos++
+	}
+
+	months := 0
+	days := 0
+	nanosecs := int64(0)
+	amounts := make([]string, 0, 2)
+
+	for {
+
+		amount, ok := relDateAmount(p.peek(0))
+
+		if !ok {
+			break
+		}
+
+		unit, isUnit := relDateUnits[p.peek(1)]
+
+		if !isUnit {
+			break
+		}
+
+		p.pos += 2
+
+		switch unit {
+		case TimeUnitDAYS:
+			days += amount
+		case TimeUnitWEEKS:
+			days += 7 * amount
+		case TimeUnitMONTHS, TimeUnitQUARTERS, TimeUnitYEARS:
+			months += amount * unit.calendarMonths()
+		default:
+			unitNanosecs, _ := unit.nanoseconds()
+			nanosecs += int64(amount) * unitNanosecs
+		}
+
+		unitName := strings.ToLower(unit.String())
+
+		if amount == 1 {
+			unitName = strings.TrimSuffix(unitName, "s")
+		}
+
+		amounts = append(amounts, fmt.Sprintf("%v %v", amount, unitName))
+
+		if p.peek(0) != "and" {
+			break
+		}
+
+		if _, ok := relDateAmount(p.peek(1)); !ok {
+			break
+		}
+
+		p.pos++
+	}
+
+	if len(amounts) == 0 {
+		p.pos = start
+		return false, nil
+	}
+
+	sign := 1
+	hasSuffix := true
+
+	switch {
+
+	case p.peek(0) == "ago":
+		sign = -1
+		p.pos++
+
+	case p.peek(0) == "from" && p.peek(1) == "now":
+		p.pos += 2
+
+	case p.peek(0) == "later":
+		p.pos++
+
+	default:
+		hasSuffix = false
+	}
+
+	if isPrefixed && hasSuffix {
+		return true, errors.New("Error: An offset may not be both preceded by 'in' and followed by 'ago', 'from now' or 'later'.")
+	}
+
+	if !isPrefixed && !hasSuffix {
+		return true, fmt.Errorf("Error: The offset '%v' must be preceded by 'in' or followed by 'ago'.",
+			strings.Join(amounts, " and "))
+	}
+
+	if p.hasOffset {
+		return true, errors.New("Error: The expression contains more than one offset.")
+	}
+
+	if p.dateFunc != nil {
+		return true, errors.New("Error: An offset may not be combined with a date.")
+	}
+
+	p.hasOffset = true
+	p.offsetMonths = sign * months
+	p.offsetDays = sign * days
+	p.offsetNanosecs = int64(sign) * nanosecs
+
+	if sign < 0 {
+		p.offsetText = strings.Join(amounts, " and ") + " ago"
+	} else {
+		p.offsetText = "in " + strings.Join(amounts, " and ")
+	}
+
+	return true, nil
+}
+
+// parsePeriod - Parses a week, month, year or named month at the current
+// position.
+func (p *relDateParser) parsePeriod() (relDatePeriod, error) {
+
+	if p.peek(0) == "the" {
+		p.pos++
+	}
+
+	if month, ok := relDateMonths[p.peek(0)]; ok {
+
+		p.pos++
+
+		per := relDatePeriod{unit: TimeUnitMONTHS, month: month}
+
+		if relDateYearRegex.MatchString(p.peek(0)) {
+			per.year, _ = strconv.Atoi(p.peek(0))
+			p.pos++
+		}
+
+		return per, nil
+	}
+
+	per := relDatePeriod{}
+
+	if shift, ok := map[string]int{"this": 0, "next": 1, "last": -1}[p.peek(0)]; ok {
+		per.shift = shift
+		p.pos++
+	}
+
+	unit, ok := relDatePeriodUnits[p.peek(0)]
+
+	if !ok {
+		return relDatePeriod{},
+			fmt.Errorf("Error: Expected a week, month, year or month name. Found '%v'.", p.peek(0))
+	}
+
+	p.pos++
+
+	per.unit = unit
+
+	return per, nil
+}
+
+// parseTime - Parses a time of day clause at the current position. A bare
+// hour such as "15" is accepted only if 'afterAt' is 'true'. Returns
+// 'false' if the current token does not begin a time of day.
+func (p *relDateParser) parseTime(afterAt bool) (bool, error) {
+
+	tok := p.peek(0)
+
+	hour := 0
+	minute := 0
+	consumed := 1
+
+	switch tok {
+
+	case "noon", "midday":
+		hour = 12
+
+	case "midnight":
+
+	default:
+
+		m := relDateTimeOfDayRegex.FindStringSubmatch(tok)
+
+		if m == nil {
+			return false, nil
+		}
+
+		meridiem := m[3]
+
+		if meridiem == "" && (p.peek(1) == "am" || p.peek(1) == "pm") {
+			meridiem = p.peek(1)
+			consumed = 2
+		}
+
+		if meridiem == "" && m[2] == "" && !afterAt {
+			return false, nil
+		}
+
+		hour, _ = strconv.Atoi(m[1])
+
+		if m[2] != "" {
+			minute, _ = strconv.Atoi(m[2])
+		}
+
+		if minute > 59 {
+			return true, fmt.Errorf("Error: Invalid time of day '%v'.", tok)
+		}
+
+		if meridiem != "" {
+
+			if hour < 1 || hour > 12 {
+				return true, fmt.Errorf("Error: Invalid time of day '%v'.", tok)
+			}
+
+			hour %= 12
+
+			if meridiem == "pm" {
+				hour += 12
+			}
+
+		} else if hour > 23 {
+			return true, fmt.Errorf("Error: Invalid time of day '%v'.", tok)
+		}
+	}
+
+	if p.hasTime {
+		return true, errors.New("Error: The expression contains more than one time of day.")
+	}
+
+	p.pos += consumed
+	p.hasTime = true
+	p.timeNanosecs = int64(hour)*HourNanoSeconds + int64(minute)*MinuteNanoSeconds
+	p.timeText = fmt.Sprintf("at %02d:%02d", hour, minute)
+
+	return true, nil
+}
+
+// peek - Returns the token 'offset' positions after the current position.
+// Returns an empty string if there is no such token.
+func (p *relDateParser) peek(offset int) string {
+
+	if p.pos+offset < len(p.tokens) {
+		return p.tokens[p.pos+offset]
+	}
+
+	return ""
+}
+
+// resolve - Resolves the parsed expression against reference date time
+// 'base'. The returned date time is expressed in the location of 'base'.
+func (p *relDateParser) resolve(base time.Time) (time.Time, error) {
+
+	if p.keepTime {
+		return base, nil
+	}
+
+	if p.hasOffset {
+
+		base = addTimeMathMode(base, 0, p.offsetMonths, p.offsetDays, 0,
+			TimeMathWALLCLOCK, MonthEndOverflowCLAMP).Add(time.Duration(p.offsetNanosecs))
+
+		if !p.hasTime {
+			return base, nil
+		}
+	}
+
+	year, month, day := base.Date()
+
+	refDate := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	if p.dateFunc != nil {
+
+		var err error
+
+		refDate, err = p.dateFunc(refDate)
+
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	year, month, day = refDate.Date()
+
+	return resolveWallClock(year, month, day, p.timeNanosecs, base.Location())[0], nil
+}
+
+// setDate - Records the date clause of the expression.
+func (p *relDateParser) setDate(text string, dateFunc func(refDate time.Time) (time.Time, error)) error {
+
+	if p.dateFunc != nil {
+		return errors.New("Error: The expression contains more than one date.")
+	}
+
+	if p.hasOffset {
+		return errors.New("Error: An offset may not be combined with a date.")
+	}
+
+	p.dateFunc = dateFunc
+	p.dateText = text
+
+	return nil
+}
+
+// setDayOffset - Records a date clause located 'days' days from the
+// reference date.
+func (p *relDateParser) setDayOffset(text string, days int) error {
+
+	return p.setDate(text, func(refDate time.Time) (time.Time, error) {
+		return refDate.AddDate(0, 0, days), nil
+	})
+}
+
+// setWeekDay - Records a weekday date clause. 'shift' = 0 selects the
+// next 'weekDay' on or after the reference date, 1 the first 'weekDay'
+// after the reference date and -1 the last 'weekDay' before the
+// reference date.
+func (p *relDateParser) setWeekDay(text string, weekDay time.Weekday, shift int) error {
+
+	return p.setDate(text, func(refDate time.Time) (time.Time, error) {
+
+		switch shift {
+		case 1:
+			return refDate.AddDate(0, 0, (int(weekDay)-int(refDate.Weekday())+6)%7+1), nil
+		case -1:
+			return refDate.AddDate(0, 0, -((int(refDate.Weekday())-int(weekDay)+6)%7 + 1)), nil
+		}
+
+		return refDate.AddDate(0, 0, (int(weekDay)-int(refDate.Weekday())+7)%7), nil
+	})
+}
+
+// relDateAmount - Converts an offset amount token to an integer. The
+// tokens "a", "an" and "one" equal 1.
+func relDateAmount(tok string) (int, bool) {
+
+	if tok == "a" || tok == "an" || tok == "one" {
+		return 1, true
+	}
+
+	amount, err := strconv.Atoi(tok)
+
+	if err != nil || amount < 0 {
+		return 0, false
+	}
+
+	return amount, true
+}
+
+// relDateNthWeekDay - Returns the nth occurrence of 'weekDay' between
+// civil dates 'start' and 'end' inclusive. The value nth = -1 selects the
+// last occurrence. Returns 'false' if the occurrence does not exist.
+func relDateNthWeekDay(start, end time.Time, weekDay time.Weekday, nth int) (time.Time, bool) {
+
+	if nth < 0 {
+		return end.AddDate(0, 0, -((int(end.Weekday()) - int(weekDay) + 7) % 7)), true
+	}
+
+	date := start.AddDate(0, 0, (int(weekDay)-int(start.Weekday())+7)%7+7*(nth-1))
+
+	return date, !date.After(end)
+}
+
+// relDateTokens - Converts a relative date expression to lower case
+// tokens. Commas are treated as spaces and "a.m." and "p.m." are
+// converted to "am" and "pm".
+func relDateTokens(expression string) []string {
+
+	s := strings.ToLower(expression)
+
+	s = strings.NewReplacer("a.m.", "am", "p.m.", "pm", ",", " ").Replace(s)
+
+	return strings.Fields(s)
+}
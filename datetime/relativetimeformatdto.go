@@ -0,0 +1,429 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+/*
+ RelativeTimeFormatDto
+ =====================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\relativetimeformatdto.go
+
+ Overview and Usage
+ ==================
+ The 'RelativeTimeFormatDto' Type describes a date time relative to a
+ reference instant, usually 'now', in short phrases suitable for display
+ in a user interface:
+
+	"just now"
+	"5 minutes ago"
+	"in 2 hours and 10 minutes"
+	"yesterday at 14:05"
+	"last Tuesday"
+	"in 3 weeks"
+
+ Unit Selection
+ ==============
+ The unit used to express the difference between the date time and the
+ reference instant is selected by the thresholds contained in the
+ 'Thresholds' field. Each threshold is the number of units at which the
+ next larger unit is used. With the default thresholds:
+
+	Difference                 Displayed As
+	----------                 ------------
+	less than 45 seconds       just now
+	less than 45 minutes       minutes
+	less than 22 hours         hours
+	less than 7 days           days
+	less than 4 weeks          weeks
+	less than 11 months        months
+	11 months or more          years
+
+ Seconds, minutes, hours, days and weeks are elapsed time units. Months
+ and years are calendar units computed from the local calendar dates of
+ the date time and the reference instant. Values are truncated, never
+ rounded up. The smallest value displayed is 1.
+
+ Precision
+ =========
+ 'Precision' is the number of units displayed. With a precision of 2,
+ the remainder is expressed in the next smaller unit. Example:
+ "2 hours and 10 minutes ago". A remainder of zero is not displayed.
+
+ Calendar Phrases
+ ================
+ If 'CalendarPhrases' is 'true', differences of at least 'Hours' hours
+ which span 1 to 6 local calendar days are described by the day:
+
+	1 day          "yesterday at 14:05"  or  "tomorrow at 09:30"
+	2 - 6 days     "last Tuesday"        or  "next Friday"
+
+ Calendar days and the time of day are computed in the time zone of the
+ date time being described.
+
+ Locale
+ ======
+ Phrases are displayed in the language of the 'Locale' field. If 'Locale'
+ is empty, English is used. See LocaleDto.
+
+ Usage
+ =====
+	relFmt := RelativeTimeFormatDto{}.New()
+
+	str, err := relFmt.Format(eventDtz, nowDtz)
+
+	str = "yesterday at 14:05"
+
+*/
+
+// RelativeTimeLabelsDto - Holds the language specific phrases used by
+// a RelativeTimeFormatDto. Phrase formats contain '%v' verbs which are
+// replaced by fmt.Sprintf().
+type RelativeTimeLabelsDto struct {
+	JustNow        string                  // Phrase used for very small differences. Example: "just now"
+	PastFmt        string                  // Past form. Example: "%v ago"
+	FutureFmt      string                  // Future form. Example: "in %v"
+	Yesterday      string                  // Example: "yesterday"
+	Tomorrow       string                  // Example: "tomorrow"
+	DayAtTimeFmt   string                  // Day and time of day. Example: "%v at %v"
+	LastWeekdayFmt string                  // Example: "last %v"
+	NextWeekdayFmt string                  // Example: "next %v"
+	UnitJoiner     string                  // Placed between two units. Example: " and "
+	UnitLabels     [7]DurationUnitLabelDto // Unit labels: Seconds, Minutes, Hours, Days, Weeks, Months, Years
+}
+
+// RelativeTimeThresholdsDto - Controls the unit selected by a
+// RelativeTimeFormatDto. A zero value field is replaced by its default.
+type RelativeTimeThresholdsDto struct {
+	JustNow time.Duration // Differences less than 'JustNow' are displayed as 'just now'. Default: 45 seconds
+	Seconds int64         // Number of seconds at which minutes are used. Default: 45
+	Minutes int64         // Number of minutes at which hours are used. Default: 45
+	Hours   int64         // Number of hours at which days are used. Default: 22
+	Days    int64         // Number of days at which weeks are used. Default: 7
+	Weeks   int64         // Number of weeks at which months are used. Default: 4
+	Months  int64         // Number of months at which years are used. Default: 11
+}
+
+// RelativeTimeFormatDto - Formats a date time relative to a reference
+// instant. See the source file header above for details.
+type RelativeTimeFormatDto struct {
+	Thresholds      RelativeTimeThresholdsDto // Unit selection thresholds
+	Precision       int                       // Number of units displayed: 1 or 2. Zero = 1
+	CalendarPhrases bool                      // If 'true', 'yesterday at 14:05' and 'last Tuesday' phrases are used
+	TimeFmtStr      string                    // Layout of the time of day in 'yesterday at 14:05'. Empty = "15:04"
+	Locale          LocaleDto                 // Language of the phrases. Empty = English
+}
+
+// Format - Describes input parameter 'dateTime' relative to input
+// parameter 'reference'. If 'dateTime' precedes 'reference', the past
+// form is returned. Otherwise, the future form is returned.
+//
+// Input Parameters:
+// =================
+//
+// dateTime  DateTzDto - The date time to describe.
+//
+// reference DateTzDto - The reference instant, usually 'now'. It is
+//                       converted to the time zone of 'dateTime'.
+//
+// Example:
+//
+//	relFmt := RelativeTimeFormatDto{}.New()
+//
+//	str, err := relFmt.Format(eventDtz, nowDtz)
+//
+//	str = "3 hours ago"
+//
+func (relFmt *RelativeTimeFormatDto) Format(dateTime, reference DateTzDto) (string, error) {
+
+	ePrefix := "RelativeTimeFormatDto.Format() "
+
+	if dateTime.DateTime.IsZero() || reference.DateTime.IsZero() {
+		return "", errors.New(ePrefix + "Error: 'dateTime' and 'reference' must be initialized!")
+	}
+
+	err := relFmt.IsValid()
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	locale := relFmt.Locale
+
+	if locale.IsEmpty() {
+		locale, _ = LocaleDto{}.New(LocaleTagEnglish)
+	}
+
+	labels := locale.RelativeTime
+
+	th := relFmt.getThresholds()
+
+	t := dateTime.DateTime
+	ref := reference.DateTime.In(t.Location())
+
+	diff := ref.Sub(t)
+	isPast := diff > 0
+
+	if diff < 0 {
+		diff = -diff
+	}
+
+	if diff < th.JustNow {
+		return labels.JustNow, nil
+	}
+
+	var text string
+
+	switch {
+
+	case diff < time.Duration(th.Seconds)*time.Second:
+		text = relFmt.formatElapsed(labels, locale, diff, 0, -1)
+
+	case diff < time.Duration(th.Minutes)*time.Minute:
+		text = relFmt.formatElapsed(labels, locale, diff, 1, 0)
+
+	case diff < time.Duration(th.Hours)*time.Hour:
+		text = relFmt.formatElapsed(labels, locale, diff, 2, 1)
+
+	default:
+
+		tYear, tMonth, tDay := t.Date()
+		rYear, rMonth, rDay := ref.Date()
+
+		tDate := time.Date(tYear, tMonth, tDay, 0, 0, 0, 0, time.UTC)
+		rDate := time.Date(rYear, rMonth, rDay, 0, 0, 0, 0, time.UTC)
+
+		if !isPast {
+			tDate, rDate = rDate, tDate
+		}
+
+		calendarDays := int(rDate.Sub(tDate) / (24 * time.Hour))
+
+		if relFmt.CalendarPhrases && calendarDays >= 1 && calendarDays < 7 {
+			return relFmt.formatCalendarPhrase(labels, locale, t, isPast, calendarDays), nil
+		}
+
+		years, months, days := calendarYearsMonthsDays(tDate, rDate, MonthEndOverflowCLAMP)
+
+		totalMonths := int64(years*12 + months)
+
+		switch {
+
+		case diff < time.Duration(th.Days)*24*time.Hour:
+			text = relFmt.formatElapsed(labels, locale, diff, 3, 2)
+
+		case diff < time.Duration(th.Weeks)*7*24*time.Hour || totalMonths == 0:
+			text = relFmt.formatElapsed(labels, locale, diff, 4, 3)
+
+		case totalMonths < th.Months:
+			text = relFmt.formatUnits(labels, locale, totalMonths, 5, int64(days), 3)
+
+		default:
+			text = relFmt.formatUnits(labels, locale, int64(years), 6, int64(months), 5)
+		}
+	}
+
+	if isPast {
+		return fmt.Sprintf(labels.PastFmt, text), nil
+	}
+
+	return fmt.Sprintf(labels.FutureFmt, text), nil
+}
+
+// FormatTimeDurationDto - Describes the time duration contained in input
+// parameter 'tDur' as a relative time phrase.
+//
+// If 'isFuture' is 'false', the starting date time is described relative
+// to the ending date time and the past form is returned. Example:
+// "3 hours ago". If 'isFuture' is 'true', the ending date time is
+// described relative to the starting date time and the future form is
+// returned. Example: "in 3 hours".
+//
+func (relFmt *RelativeTimeFormatDto) FormatTimeDurationDto(tDur TimeDurationDto, isFuture bool) (string, error) {
+
+	ePrefix := "RelativeTimeFormatDto.FormatTimeDurationDto() "
+
+	var str string
+	var err error
+
+	if isFuture {
+		str, err = relFmt.Format(tDur.EndTimeDateTz, tDur.StartTimeDateTz)
+	} else {
+		str, err = relFmt.Format(tDur.StartTimeDateTz, tDur.EndTimeDateTz)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return str, nil
+}
+
+// IsValid - Validates the current RelativeTimeFormatDto. If 'Precision'
+// is not 0, 1 or 2 or a threshold is negative, an error is returned.
+func (relFmt *RelativeTimeFormatDto) IsValid() error {
+
+	ePrefix := "RelativeTimeFormatDto.IsValid() "
+
+	if relFmt.Precision < 0 || relFmt.Precision > 2 {
+		return fmt.Errorf(ePrefix + "Error: 'Precision' must be 1 or 2. Precision='%v'", relFmt.Precision)
+	}
+
+	th := relFmt.Thresholds
+
+	if th.JustNow < 0 || th.Seconds < 0 || th.Minutes < 0 || th.Hours < 0 ||
+		th.Days < 0 || th.Weeks < 0 || th.Months < 0 {
+		return errors.New(ePrefix + "Error: Relative time thresholds must not be negative!")
+	}
+
+	return nil
+}
+
+// New - Creates and returns a new RelativeTimeFormatDto. Default thresholds
+// are used, one unit is displayed, calendar phrases are enabled and phrases
+// are displayed in English.
+func (relFmt RelativeTimeFormatDto) New() RelativeTimeFormatDto {
+
+	rel2Fmt := RelativeTimeFormatDto{}
+
+	rel2Fmt.Precision = 1
+	rel2Fmt.CalendarPhrases = true
+	rel2Fmt.TimeFmtStr = "15:04"
+	rel2Fmt.Locale, _ = LocaleDto{}.New(LocaleTagEnglish)
+
+	return rel2Fmt
+}
+
+// SetLocale - Configures the current RelativeTimeFormatDto to display
+// phrases in the language of input parameter 'locale'.
+func (relFmt *RelativeTimeFormatDto) SetLocale(locale LocaleDto) {
+
+	relFmt.Locale = locale.CopyOut()
+}
+
+// formatCalendarPhrase - Returns 'yesterday at 14:05', 'tomorrow at 14:05',
+// 'last Tuesday' or 'next Tuesday' for date time 't'.
+func (relFmt *RelativeTimeFormatDto) formatCalendarPhrase(labels RelativeTimeLabelsDto,
+	locale LocaleDto, t time.Time, isPast bool, calendarDays int) string {
+
+	if calendarDays == 1 {
+
+		timeFmtStr := relFmt.TimeFmtStr
+
+		if timeFmtStr == "" {
+			timeFmtStr = "15:04"
+		}
+
+		day := labels.Tomorrow
+
+		if isPast {
+			day = labels.Yesterday
+		}
+
+		return fmt.Sprintf(labels.DayAtTimeFmt, day, locale.FormatDateTime(t, timeFmtStr))
+	}
+
+	if isPast {
+		return fmt.Sprintf(labels.LastWeekdayFmt, locale.WeekdayNames[t.Weekday()])
+	}
+
+	return fmt.Sprintf(labels.NextWeekdayFmt, locale.WeekdayNames[t.Weekday()])
+}
+
+// formatElapsed - Expresses 'diff' in the elapsed time unit identified by
+// label index 'unitIdx' with a remainder in the unit identified by
+// 'nextIdx'. A 'nextIdx' of -1 indicates there is no smaller unit.
+func (relFmt *RelativeTimeFormatDto) formatElapsed(labels RelativeTimeLabelsDto,
+	locale LocaleDto, diff time.Duration, unitIdx, nextIdx int) string {
+
+	unitNanosecs := []int64{SecondNanoseconds, MinuteNanoSeconds, HourNanoSeconds,
+		DayNanoSeconds, WeekNanoSeconds}
+
+	value := int64(diff) / unitNanosecs[unitIdx]
+
+	remainder := int64(0)
+
+	if nextIdx >= 0 {
+		remainder = (int64(diff) - value*unitNanosecs[unitIdx]) / unitNanosecs[nextIdx]
+	}
+
+	return relFmt.formatUnits(labels, locale, value, unitIdx, remainder, nextIdx)
+}
+
+// formatUnits - Formats 'value' with the unit label identified by
+// 'unitIdx'. If 'Precision' is 2 and 'remainder' is greater than zero,
+// the remainder is appended with the unit label identified by 'nextIdx'.
+func (relFmt *RelativeTimeFormatDto) formatUnits(labels RelativeTimeLabelsDto,
+	locale LocaleDto, value int64, unitIdx int, remainder int64, nextIdx int) string {
+
+	if value < 1 {
+		value = 1
+	}
+
+	text := relFmt.formatUnit(labels, locale, value, unitIdx)
+
+	if relFmt.Precision == 2 && remainder > 0 && nextIdx >= 0 {
+		text += labels.UnitJoiner + relFmt.formatUnit(labels, locale, remainder, nextIdx)
+	}
+
+	return text
+}
+
+// formatUnit - Formats 'value' followed by the singular or plural
+// form of the unit label identified by 'unitIdx'.
+func (relFmt *RelativeTimeFormatDto) formatUnit(labels RelativeTimeLabelsDto,
+	locale LocaleDto, value int64, unitIdx int) string {
+
+	label := labels.UnitLabels[unitIdx].Plural
+
+	if locale.PluralRule.isSingular(value) {
+		label = labels.UnitLabels[unitIdx].Singular
+	}
+
+	return strconv.FormatInt(value, 10) + locale.UnitSeparator + label
+}
+
+// getThresholds - Returns the current thresholds with zero value
+// fields replaced by their defaults.
+func (relFmt *RelativeTimeFormatDto) getThresholds() RelativeTimeThresholdsDto {
+
+	th := relFmt.Thresholds
+
+	if th.JustNow == 0 {
+		th.JustNow = 45 * time.Second
+	}
+
+	if th.Seconds == 0 {
+		th.Seconds = 45
+	}
+
+	if th.Minutes == 0 {
+		th.Minutes = 45
+	}
+
+	if th.Hours == 0 {
+		th.Hours = 22
+	}
+
+	if th.Days == 0 {
+		th.Days = 7
+	}
+
+	if th.Weeks == 0 {
+		th.Weeks = 4
+	}
+
+	if th.Months == 0 {
+		th.Months = 11
+	}
+
+	return th
+}
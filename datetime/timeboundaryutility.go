@@ -0,0 +1,476 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+ Time Boundary Utility
+ =====================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\timeboundaryutility.go
+
+ Overview and Usage
+ ==================
+ This source file contains internal helper functions used to locate
+ local wall clock boundaries (start of day, start of hour, start of
+ month etc.) within a time zone. These functions correctly handle
+ daylight savings transitions:
+
+	(1) Wall clock times which do not exist because clocks were
+	    moved forward (Example: 02:30 on a spring forward day)
+	    resolve to the first instant after the gap.
+
+	(2) Wall clock times which occur twice because clocks were
+	    moved back (Example: 01:30 on a fall back day) resolve
+	    to both instants, allowing callers to select the earlier
+	    or later occurrence.
+
+*/
+
+// wallClockAsUTC - Returns the wall clock reading of 't' in its
+// own location, re-expressed as a UTC time. Used to compare wall
+// clock readings without regard to UTC offsets.
+func wallClockAsUTC(t time.Time) time.Time {
+
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(),
+		t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// wallClockNanosecs - Returns the number of wall clock nanoseconds
+// elapsed since local midnight for date time 't'.
+func wallClockNanosecs(t time.Time) int64 {
+
+	return int64(t.Hour())*HourNanoSeconds +
+		int64(t.Minute())*MinuteNanoSeconds +
+		int64(t.Second())*SecondNanoseconds +
+		int64(t.Nanosecond())
+}
+
+// resolveWallClock - Returns all instants at which the local wall
+// clock in location 'loc' reads year, month, day plus 'wallNanosecs'
+// nanoseconds after midnight. Month and day values are normalized
+// in the manner of time.Date().
+//
+// Normally one instant is returned. If the wall clock time occurs
+// twice due to a daylight savings transition, two instants are
+// returned in ascending order. If the wall clock time does not exist,
+// a single instant is returned: the first instant at which the wall
+// clock reading is later than the requested time (the moment of the
+// transition).
+func resolveWallClock(year int, month time.Month, day int, wallNanosecs int64,
+	loc *time.Location) []time.Time {
+
+	target := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).
+		Add(time.Duration(wallNanosecs))
+
+	offsets := make([]int, 0, 3)
+
+	for _, probe := range []time.Duration{-48 * time.Hour, 0, 48 * time.Hour} {
+
+		_, offset := target.Add(probe).In(loc).Zone()
+
+		isDup := false
+
+		for _, o := range offsets {
+			if o == offset {
+				isDup = true
+				break
+			}
+		}
+
+		if !isDup {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	results := make([]time.Time, 0, 2)
+
+	for _, offset := range offsets {
+
+		candidate := target.Add(-time.Duration(offset) * time.Second).In(loc)
+
+		if !wallClockAsUTC(candidate).Equal(target) {
+			continue
+		}
+
+		isDup := false
+
+		for _, r := range results {
+			if r.Equal(candidate) {
+				isDup = true
+				break
+			}
+		}
+
+		if !isDup {
+			results = append(results, candidate)
+		}
+	}
+
+	if len(results) == 2 && results[1].Before(results[0]) {
+		results[0], results[1] = results[1], results[0]
+	}
+
+	if len(results) > 0 {
+		return results
+	}
+
+	// The wall clock time falls in a gap. Search for the
+	// first instant with a wall clock reading >= target.
+	lo := target.Add(-time.Duration(offsets[0]) * time.Second)
+	hi := lo
+
+	for _, offset := range offsets {
+
+		candidate := target.Add(-time.Duration(offset) * time.Second)
+
+		if candidate.Before(lo) {
+			lo = candidate
+		}
+
+		if candidate.After(hi) {
+			hi = candidate
+		}
+	}
+
+	lo = lo.Add(-time.Hour)
+	hi = hi.Add(time.Hour)
+
+	for hi.Sub(lo) > time.Nanosecond {
+
+		mid := lo.Add(hi.Sub(lo) / 2)
+
+		if wallClockAsUTC(mid.In(loc)).Before(target) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+
+	return []time.Time{hi.In(loc)}
+}
+
+// startOfLocalDay - Returns the first instant of the local calendar
+// day identified by year, month and day in location 'loc'. If local
+// midnight does not exist on that day, the first instant after the
+// daylight savings gap is returned.
+func startOfLocalDay(year int, month time.Month, day int, loc *time.Location) time.Time {
+
+	return resolveWallClock(year, month, day, 0, loc)[0]
+}
+
+// civilDayNumber - Returns the number of days between 1970-01-01
+// and the calendar date of 't' as displayed in its own location.
+func civilDayNumber(t time.Time) int64 {
+
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	return floorDivInt64(d.Unix(), 86400)
+}
+
+// civilDate - Returns the year, month and day associated with a
+// civil day number computed by civilDayNumber().
+func civilDate(dayNumber int64) (int, time.Month, int) {
+
+	d := time.Unix(dayNumber*86400, 0).UTC()
+
+	return d.Year(), d.Month(), d.Day()
+}
+
+// floorDivInt64 - Integer division rounded toward negative infinity.
+func floorDivInt64(a, b int64) int64 {
+
+	q := a / b
+
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+
+	return q
+}
+
+// floorModInt64 - Remainder of floored integer division. The result
+// carries the sign of the divisor.
+func floorModInt64(a, b int64) int64 {
+
+	return a - floorDivInt64(a, b)*b
+}
+
+// localUnitBoundaries - Computes the local wall clock boundaries which
+// bracket date time 't' for a period of 'step' units of 'unit'.
+// Boundaries are computed in the location of 't'.
+//
+// Returned values:
+//
+//	lower     - The latest boundary which is less than or equal to 't'
+//	upper     - The earliest boundary which is greater than 't'
+//	lowerIdx  - The index of the lower boundary counted in steps from the
+//	            anchor. Used by half-even rounding.
+//
+// Anchors:
+//
+//	Nanoseconds through Hours - Local midnight of the day containing 't'.
+//	                            The last period of a day may be shorter
+//	                            than 'step' if 'step' does not divide the day.
+//	Days                      - 1970-01-01
+//	Weeks                     - Monday, 1969-12-29
+//	Months through Years      - January, year zero. Quarters and
+//	                            Half-Years are multiples of 3 and 6 months.
+func localUnitBoundaries(t time.Time, unit TimeUnitType, step int64) (
+	lower, upper time.Time, lowerIdx int64, err error) {
+
+	if step < 1 {
+		err = fmt.Errorf("Error: Input parameter 'step' must be greater than zero. step='%v'", step)
+		return
+	}
+
+	loc := t.Location()
+
+	year, month, day := t.Date()
+
+	switch unit {
+
+	case TimeUnitNANOSECONDS, TimeUnitMICROSECONDS, TimeUnitMILLISECONDS,
+		TimeUnitSECONDS, TimeUnitMINUTES, TimeUnitHOURS:
+
+		unitNanosecs, _ := unit.nanoseconds()
+
+		if step > DayNanoSeconds/unitNanosecs {
+			err = fmt.Errorf("Error: 'step' exceeds one day. unit='%v' step='%v'", unit.String(), step)
+			return
+		}
+
+		stepNanosecs := unitNanosecs * step
+
+		wallNanosecs := wallClockNanosecs(t)
+
+		lowerIdx = wallNanosecs / stepNanosecs
+
+		lowerWall := lowerIdx * stepNanosecs
+
+		upperWall := lowerWall + stepNanosecs
+
+		lowerCandidates := resolveWallClock(year, month, day, lowerWall, loc)
+
+		lower = lowerCandidates[0]
+
+		for _, c := range lowerCandidates {
+			if !c.After(t) {
+				lower = c
+			}
+		}
+
+		upperCandidates := lowerCandidates
+
+		if upperWall >= DayNanoSeconds {
+			upperCandidates = append(upperCandidates, startOfLocalDay(year, month, day+1, loc))
+		} else {
+			upperCandidates = append(upperCandidates, resolveWallClock(year, month, day, upperWall, loc)...)
+		}
+
+		upper = time.Time{}
+
+		for _, c := range upperCandidates {
+			if c.After(t) && (upper.IsZero() || c.Before(upper)) {
+				upper = c
+			}
+		}
+
+		return
+
+	case TimeUnitDAYS:
+
+		dayNum := civilDayNumber(t)
+
+		lowerIdx = floorDivInt64(dayNum, step)
+
+		y, m, d := civilDate(lowerIdx * step)
+		lower = startOfLocalDay(y, m, d, loc)
+
+		y, m, d = civilDate((lowerIdx + 1) * step)
+		upper = startOfLocalDay(y, m, d, loc)
+
+		return
+
+	case TimeUnitWEEKS:
+
+		// Day number of Monday 1969-12-29 is -3
+		weekDays := civilDayNumber(t) + 3
+
+		lowerIdx = floorDivInt64(weekDays, 7*step)
+
+		y, m, d := civilDate(lowerIdx*7*step - 3)
+		lower = startOfLocalDay(y, m, d, loc)
+
+		y, m, d = civilDate((lowerIdx+1)*7*step - 3)
+		upper = startOfLocalDay(y, m, d, loc)
+
+		return
+
+	case TimeUnitMONTHS, TimeUnitQUARTERS, TimeUnitHALFYEARS, TimeUnitYEARS:
+
+		stepMonths := int64(unit.calendarMonths()) * step
+
+		monthIdx := int64(year)*12 + int64(month) - 1
+
+		lowerIdx = floorDivInt64(monthIdx, stepMonths)
+
+		lowerMonth := lowerIdx * stepMonths
+
+		lower = startOfLocalDay(int(floorDivInt64(lowerMonth, 12)),
+			time.Month(floorModInt64(lowerMonth, 12)+1), 1, loc)
+
+		upperMonth := lowerMonth + stepMonths
+
+		upper = startOfLocalDay(int(floorDivInt64(upperMonth, 12)),
+			time.Month(floorModInt64(upperMonth, 12)+1), 1, loc)
+
+		return
+	}
+
+	err = fmt.Errorf("Error: Invalid time unit. unit='%v'", int(unit))
+
+	return
+}
+
+// selectRoundedBoundary - Selects either the 'lower' or 'upper'
+// boundary bracketing date time 't' based on rounding mode 'roundMode'.
+// Distances to the boundaries are measured in elapsed time. 'lowerIdx'
+// is the step index of the lower boundary and is used to break ties
+// under RoundHALFEVEN.
+func selectRoundedBoundary(t, lower, upper time.Time, lowerIdx int64,
+	roundMode RoundingModeType) (time.Time, error) {
+
+	if t.Equal(lower) {
+		return lower, nil
+	}
+
+	distLower := t.Sub(lower)
+	distUpper := upper.Sub(t)
+
+	switch roundMode {
+
+	case RoundFLOOR, RoundTOWARDZERO:
+		return lower, nil
+
+	case RoundCEILING, RoundAWAYFROMZERO:
+		return upper, nil
+
+	case RoundHALFUP:
+
+		if distUpper <= distLower {
+			return upper, nil
+		}
+
+		return lower, nil
+
+	case RoundHALFDOWN:
+
+		if distUpper < distLower {
+			return upper, nil
+		}
+
+		return lower, nil
+
+	case RoundHALFEVEN:
+
+		if distLower < distUpper {
+			return lower, nil
+		}
+
+		if distUpper < distLower {
+			return upper, nil
+		}
+
+		if floorModInt64(lowerIdx, 2) == 0 {
+			return lower, nil
+		}
+
+		return upper, nil
+	}
+
+	return time.Time{}, fmt.Errorf("Error: Invalid rounding mode. roundMode='%v'", int(roundMode))
+}
+
+// localPeriodStart - Returns the first instant of the local calendar
+// period containing date time 't' together with the first instant of
+// the following period. Periods are computed in the location of 't'.
+// 'firstWeekDay' specifies the day on which weeks begin and is only
+// used when 'unit' is TimeUnitWEEKS.
+//
+// Valid values for 'unit' are TimeUnitDAYS, TimeUnitWEEKS, TimeUnitMONTHS,
+// TimeUnitQUARTERS, TimeUnitHALFYEARS and TimeUnitYEARS.
+func localPeriodStart(t time.Time, unit TimeUnitType, firstWeekDay time.Weekday) (
+	start, next time.Time, err error) {
+
+	loc := t.Location()
+
+	year, month, day := t.Date()
+
+	switch unit {
+
+	case TimeUnitDAYS:
+
+		start = startOfLocalDay(year, month, day, loc)
+		next = startOfLocalDay(year, month, day+1, loc)
+
+		return
+
+	case TimeUnitWEEKS:
+
+		if firstWeekDay < time.Sunday || firstWeekDay > time.Saturday {
+			err = fmt.Errorf("Error: Invalid first week day. firstWeekDay='%v'", int(firstWeekDay))
+			return
+		}
+
+		offset := (int(t.Weekday()) - int(firstWeekDay) + 7) % 7
+
+		start = startOfLocalDay(year, month, day-offset, loc)
+		next = startOfLocalDay(year, month, day-offset+7, loc)
+
+		return
+
+	case TimeUnitMONTHS, TimeUnitQUARTERS, TimeUnitHALFYEARS, TimeUnitYEARS:
+
+		start, next, _, err = localUnitBoundaries(t, unit, 1)
+
+		return
+	}
+
+	err = fmt.Errorf("Error: Time unit is not a calendar period. unit='%v'", unit.String())
+
+	return
+}
+
+// addLocalDays - Adds 'days' calendar days to the local date of 't'
+// while preserving its wall clock time. If the resulting wall clock
+// time does not exist due to a daylight savings gap, the first instant
+// after the gap is returned. If it occurs twice, the first occurrence
+// is returned.
+func addLocalDays(t time.Time, days int) time.Time {
+
+	year, month, day := t.Date()
+
+	return resolveWallClock(year, month, day+days, wallClockNanosecs(t), t.Location())[0]
+}
+
+// addLocalMonthsClamped - Adds 'months' calendar months to the local
+// date of 't' while preserving its wall clock time. If the day of the
+// month does not exist in the target month, the last day of the target
+// month is used. Example: January 31st plus one month is February 28th
+// or 29th. Daylight savings gaps and overlaps are resolved as in
+// addLocalDays().
+func addLocalMonthsClamped(t time.Time, months int) time.Time {
+
+	year, month, day := t.Date()
+
+	year, month, day = monthEndOverflowDate(year, month+time.Month(months), day, MonthEndOverflowCLAMP)
+
+	return resolveWallClock(year, month, day, wallClockNanosecs(t), t.Location())[0]
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 	"errors"
+	"math/big"
 	"strings"
 )
 
@@ -56,6 +57,10 @@ type TimeDto struct {
 // AddTimeDto - Adds time to the current TimeDto. The amount of time added
 // is provided by the input parameter 't2Dto' of type TimeDto.
 //
+// Both TimeDto instances are treated as durations. No month end overflow
+// policy is applied. To add time to a TimeDto holding a calendar date, use
+// AddTimeDtoToDate().
+//
 // Date time math uses timezone UTC.
 //
 //	Input Parameters
@@ -89,6 +94,75 @@ func (tDto *TimeDto) AddTimeDto(t2Dto TimeDto) error {
 	return nil
 }
 
+// AddTimeDtoToDate - Adds time to the current TimeDto which holds a
+// calendar date. The amount of time added is provided by the input
+// parameter 't2Dto' of type TimeDto. Month end overflow is resolved
+// according to input parameter 'overflow'.
+//
+// The years and months of 't2Dto' are added to the date first and the
+// overflow policy is applied to its day of the month. The days and time
+// components of 't2Dto' are then added. Example:
+//
+//	tDto = 2019-01-31   t2Dto = 1-Month
+//
+//	MonthEndOverflowNORMALIZE  2019-03-03
+//	MonthEndOverflowCLAMP      2019-02-28
+//	MonthEndOverflowROLLNEXT   2019-03-01
+//
+// Date time math uses timezone UTC.
+//
+//	Input Parameters
+//	================
+//
+//	t2Dto						TimeDto	- The amount of time to be added to the current TimeDto
+//															data fields.
+//
+//	overflow	MonthEndOverflowType - The month end overflow policy.
+//
+//	Error Conditions
+//	================
+//
+//	An error is returned if the current TimeDto does not hold a calendar
+//	date. Months must be 1 through 12 and DateDays must be 1 through 31.
+//
+func (tDto *TimeDto) AddTimeDtoToDate(t2Dto TimeDto, overflow MonthEndOverflowType) error {
+
+	ePrefix := "TimeDto.AddTimeDtoToDate() "
+
+	err := validateMonthEndOverflow(overflow)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	if tDto.Months < 1 || tDto.Months > 12 {
+		return fmt.Errorf(ePrefix + "Error: Months value is INVALID! tDto.Months='%v'", tDto.Months)
+	}
+
+	if tDto.DateDays < 1 || tDto.DateDays > 31 {
+		return fmt.Errorf(ePrefix + "Error: DateDays value is INVALID! tDto.DateDays='%v'", tDto.DateDays)
+	}
+
+	year, month, day := monthEndOverflowDate(tDto.Years+t2Dto.Years,
+		time.Month(tDto.Months+t2Dto.Months), tDto.DateDays, overflow)
+
+	hours := tDto.Hours + t2Dto.Hours
+	minutes := tDto.Minutes + t2Dto.Minutes
+	seconds := tDto.Seconds + t2Dto.Seconds
+	milliseconds := tDto.Milliseconds + t2Dto.Milliseconds
+	microseconds := tDto.Microseconds + t2Dto.Microseconds
+	nanoseconds := tDto.Nanoseconds + t2Dto.Nanoseconds
+
+	err = tDto.SetTimeElements(year, int(month), 0, day+t2Dto.DateDays, hours, minutes, seconds,
+		milliseconds, microseconds, nanoseconds)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDto.SetTimeElements(). Error='%v' ", err.Error())
+	}
+
+	return nil
+}
+
 
 // CopyOut - Creates a new TimeDto instance
 // which precisely duplicates the current TimeDto
@@ -318,6 +392,99 @@ func (tDto *TimeDto) GetDateTime(timeZoneLocation string) (time.Time, error) {
 	return dTime, nil
 }
 
+// GetDecimalDuration - Returns the time elements of the current TimeDto
+// expressed as an exact decimal quantity of the time unit specified by
+// input parameter 'unit'. The result is rounded to 'precision' digits to
+// the right of the decimal point using rounding mode 'roundMode'.
+//
+// Because a TimeDto carries no starting date time, Years and Months are
+// converted using the average Gregorian Year of 365.2425 days. A Month
+// is equal to one twelfth of a Gregorian Year. DateDays are converted as
+// 24-hour days.
+//
+// Input Parameters:
+// =================
+//
+// unit      TimeUnitType     - The time unit in which the time elements will be
+//                              expressed. Valid values range from TimeUnitNANOSECONDS
+//                              through TimeUnitYEARS.
+//
+// precision uint             - The number of digits to the right of the decimal point.
+//
+// roundMode RoundingModeType - The rounding mode used to round the result to
+//                              'precision' digits. Example: RoundHALFEVEN
+//
+func (tDto *TimeDto) GetDecimalDuration(
+	unit TimeUnitType,
+	precision uint,
+	roundMode RoundingModeType) (DecimalDto, error) {
+
+	ePrefix := "TimeDto.GetDecimalDuration() "
+
+	unitNanosecs, err := unit.nanoseconds()
+
+	if err != nil {
+		return DecimalDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	totNanosecs := big.NewInt(0)
+
+	elements := []struct {
+		value    int
+		nanosecs int64
+	}{
+		{tDto.Years, GregorianYearNanoSeconds},
+		{tDto.Months, GregorianYearNanoSeconds / 12},
+		{tDto.DateDays, DayNanoSeconds},
+		{tDto.Hours, HourNanoSeconds},
+		{tDto.Minutes, MinuteNanoSeconds},
+		{tDto.Seconds, SecondNanoseconds},
+		{tDto.Milliseconds, MilliSecondNanoseconds},
+		{tDto.Microseconds, MicroSecondNanoseconds},
+		{tDto.Nanoseconds, 1},
+	}
+
+	for _, element := range elements {
+		totNanosecs.Add(totNanosecs,
+			new(big.Int).Mul(big.NewInt(int64(element.value)), big.NewInt(element.nanosecs)))
+	}
+
+	dec, err := DecimalDto{}.NewFrac(totNanosecs, big.NewInt(unitNanosecs), precision, roundMode)
+
+	if err != nil {
+		return DecimalDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return dec, nil
+}
+
+// GetDayOfYear - Returns the day of the year (1-366) for the date
+// specified by the Years, Months and DateDays fields of the current
+// TimeDto. This method assumes the TimeDto represents a specific date
+// rather than incremental time. Example: 2026-10-17 returns 290.
+func (tDto *TimeDto) GetDayOfYear() int {
+
+	return tDto.getCalendarDate().YearDay()
+}
+
+// GetISOWeek - Returns the ISO 8601 week-year and week number (1-53)
+// for the date specified by the Years, Months and DateDays fields of
+// the current TimeDto. This method assumes the TimeDto represents a
+// specific date rather than incremental time.
+func (tDto *TimeDto) GetISOWeek() (isoYear, week int) {
+
+	return tDto.getCalendarDate().ISOWeek()
+}
+
+// GetISOWeekDay - Returns the ISO 8601 weekday number, Monday = 1
+// through Sunday = 7, for the date specified by the Years, Months and
+// DateDays fields of the current TimeDto. This method assumes the
+// TimeDto represents a specific date rather than incremental time.
+func (tDto *TimeDto) GetISOWeekDay() int {
+
+	return isoWeekDayNumber(tDto.getCalendarDate())
+}
+
 // IsEmpty - Returns 'true' if all data fields in the current
 // TimeDto instance are equal to zero or equal to their
 // uninitialized values.
@@ -1010,6 +1177,14 @@ func (tDto *TimeDto) allocateTotalNanoseconds(totalNanoSeconds int) error {
 	return nil
 }
 
+// getCalendarDate - Returns the date specified by the Years, Months
+// and DateDays fields of the current TimeDto as a UTC time.Time value.
+func (tDto *TimeDto) getCalendarDate() time.Time {
+
+	return time.Date(tDto.Years, time.Month(tDto.Months), tDto.DateDays,
+		0, 0, 0, 0, time.UTC)
+}
+
 func (tDto *TimeDto) preProcessTimeZoneLocation(timeZoneLocation string) string {
 
 	if len(timeZoneLocation) == 0 {
@@ -4,6 +4,7 @@ import (
 	"time"
 	"fmt"
 	"errors"
+	"math/big"
 	"strings"
 )
 
@@ -69,6 +70,10 @@ const (
 //			CalcType                    = TDurCalcTypeYEARMTH
 //			Years                       populated
 //			YearsNanosecs               populated
+//			HalfYears                   NOT-populated
+//			HalfYearsNanosecs           NOT-populated
+//			Quarters                    NOT-populated
+//			QuartersNanosecs            NOT-populated
 //			Months                      populated
 //			MonthsNanosecs              populated
 //			Weeks                       populated
@@ -111,6 +116,10 @@ const (
 //			CalcType                    = TDurCalcTypeCUMMONTHS
 //			Years                       NOT-populated
 //			YearsNanosecs               NOT-populated
+//			HalfYears                   NOT-populated
+//			HalfYearsNanosecs           NOT-populated
+//			Quarters                    NOT-populated
+//			QuartersNanosecs            NOT-populated
 //			Months                      populated
 //			MonthsNanosecs              populated
 //			Weeks                       populated
@@ -153,6 +162,10 @@ const (
 	//			CalcType                    = TDurCalcTypeCUMWEEKS
 	//			Years                       NOT-populated
 	//			YearsNanosecs               NOT-populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
 	//			Months                      NOT-populated
 	//			MonthsNanosecs              NOT-populated
 	//			Weeks                       populated
@@ -196,6 +209,10 @@ const (
 	//			CalcType                    = TDurCalcTypeCUMDAYS
 	//			Years                       NOT-populated
 	//			YearsNanosecs               NOT-populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
 	//			Months                      NOT-populated
 	//			MonthsNanosecs              NOT-populated
 	//			Weeks                       NOT-populated
@@ -239,6 +256,10 @@ const (
 	//			CalcType                    = TDurCalcTypeCUMHOURS
 	//			Years                       NOT-populated
 	//			YearsNanosecs               NOT-populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
 	//			Months                      NOT-populated
 	//			MonthsNanosecs              NOT-populated
 	//			Weeks                       NOT-populated
@@ -284,6 +305,10 @@ const (
 	//			CalcType                    = TDurCalcTypeCUMMINUTES
 	//			Years                       NOT-populated
 	//			YearsNanosecs               NOT-populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
 	//			Months                      NOT-populated
 	//			MonthsNanosecs              NOT-populated
 	//			Weeks                       NOT-populated
@@ -330,6 +355,10 @@ const (
 	//			CalcType                    = TDurCalcTypeCUMSECONDS
 	//			Years                       NOT-populated
 	//			YearsNanosecs               NOT-populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
 	//			Months                      NOT-populated
 	//			MonthsNanosecs              NOT-populated
 	//			Weeks                       NOT-populated
@@ -377,6 +406,10 @@ const (
 	//			CalcType                    = TDurCalcTypeGregorianYrs
 	//			Years                       populated
 	//			YearsNanosecs               populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
 	//			Months                      populated
 	//			MonthsNanosecs              populated
 	//			Weeks                       populated
@@ -402,12 +435,379 @@ const (
 	//	}
 	//
 	TDurCalcTypeGregorianYrs
-	
+
+	// TDurCalcTypeWORKINGHOURS - Working Hours calculations. Time duration is equal to
+	// the working time between the starting and ending date times as defined by a
+	// weekly work schedule, 'WorkScheduleDto'. Time outside of scheduled work periods,
+	// including weekends and holidays, is excluded. As a result, 'TimeDuration' may be
+	// less than the elapsed time between starting and ending date times.
+	//
+	// The working time is broken down by cumulative hours plus minutes, seconds,
+	// milliseconds, microseconds and nanoseconds. Data Fields for years, months,
+	// weeks, and days are always set to zero.
+	//
+	// This calculation type requires a work schedule. Use methods
+	// 'TimeDurationDto.NewStartEndWorkingTime()' and
+	// 'TimeDurationDto.NewStartWorkingDuration()'. Submitting 'TDurCalcTypeWORKINGHOURS'
+	// to any other method will generate an error.
+	//
+	// For the 'TDurCalcTypeWORKINGHOURS' calculation type, the following fields are
+	// populated:
+	//
+	//	type TimeDurationDto struct {
+	//			StartTimeDateTz							populated
+	//			EndTimeDateTz               populated
+	//			TimeDuration                populated - Working time only
+	//			CalcType                    = TDurCalcTypeWORKINGHOURS
+	//			Years                       NOT-populated
+	//			YearsNanosecs               NOT-populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
+	//			Months                      NOT-populated
+	//			MonthsNanosecs              NOT-populated
+	//			Weeks                       NOT-populated
+	//			WeeksNanosecs               NOT-populated
+	//			WeekDays                    NOT-populated
+	//			WeekDaysNanosecs            NOT-populated
+	//			DateDays                    NOT-populated
+	//			DateDaysNanosecs            NOT-populated
+	//			Hours                       populated
+	//			HoursNanosecs               populated
+	//			Minutes                     populated
+	//			MinutesNanosecs             populated
+	//			Seconds                     populated
+	//			SecondsNanosecs             populated
+	//			Milliseconds                populated
+	//			MillisecondsNanosecs        populated
+	//			Microseconds                populated
+	//			MicrosecondsNanosecs        populated
+	//			Nanoseconds                 populated
+	//			TotSubSecNanoseconds        populated
+	//			TotDateNanoseconds          populated
+	//			TotTimeNanoseconds          populated
+	//	}
+	//
+	TDurCalcTypeWORKINGHOURS
+
+	// TDurCalcTypeFWDANNIVERSARY - Forward Anniversary Year, Month calculation. Years and
+	// months are counted forward from the starting date time. Each anniversary is computed
+	// directly from the starting date time, 'n' months later, applying the month end overflow
+	// policy, 'TimeDurationDto.MonthEndOverflow'. Years and months are allocated to the latest
+	// anniversary which does not follow the ending date time. The remainder, from that
+	// anniversary to the ending date time, is allocated over weeks, week days, date days,
+	// hours, minutes, seconds, milliseconds, microseconds and nanoseconds.
+	//
+	// Example: 2019-01-31 to 2019-03-30 with MonthEndOverflowCLAMP
+	// 		1-Month 30-Days   (Anniversary 2019-02-28)
+	//
+	// For the 'TDurCalcTypeFWDANNIVERSARY' calculation type, the following fields are
+	// populated:
+	//
+	//	type TimeDurationDto struct {
+	//			StartTimeDateTz							populated
+	//			EndTimeDateTz               populated
+	//			TimeDuration                populated
+	//			CalcType                    = TDurCalcTypeFWDANNIVERSARY
+	//			Years                       populated
+	//			YearsNanosecs               populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
+	//			Months                      populated
+	//			MonthsNanosecs              populated
+	//			Weeks                       populated
+	//			WeeksNanosecs               populated
+	//			WeekDays                    populated
+	//			WeekDaysNanosecs            populated
+	//			DateDays                    populated
+	//			DateDaysNanosecs            populated
+	//			Hours                       populated
+	//			HoursNanosecs               populated
+	//			Minutes                     populated
+	//			MinutesNanosecs             populated
+	//			Seconds                     populated
+	//			SecondsNanosecs             populated
+	//			Milliseconds                populated
+	//			MillisecondsNanosecs        populated
+	//			Microseconds                populated
+	//			MicrosecondsNanosecs        populated
+	//			Nanoseconds                 populated
+	//			TotSubSecNanoseconds        populated
+	//			TotDateNanoseconds          populated
+	//			TotTimeNanoseconds          populated
+	//	}
+	//
+	TDurCalcTypeFWDANNIVERSARY
+
+	// TDurCalcTypeBWDANNIVERSARY - Backward Anniversary Year, Month calculation. Years and
+	// months are counted backward from the ending date time. Each anniversary is computed
+	// directly from the ending date time, 'n' months earlier. If the day of the month does
+	// not exist in the target month, the last day of that month is used. A backward
+	// anniversary therefore never lands later than its target month, and
+	// 'TimeDurationDto.MonthEndOverflow' is ignored. Years and months are allocated from the
+	// earliest anniversary which does not precede the starting date time. The remainder, from
+	// the starting date time to that anniversary, is allocated over weeks, week days, date
+	// days, hours, minutes, seconds, milliseconds, microseconds and nanoseconds.
+	//
+	// Example: 2019-01-31 to 2019-03-30
+	// 		1-Month 28-Days   (Anniversary 2019-02-28)
+	//
+	// Example: 2026-03-01 to 2026-03-31
+	// 		4-Weeks 2-Days    (Anniversary 2026-02-28 precedes the starting date time)
+	//
+	// For the 'TDurCalcTypeBWDANNIVERSARY' calculation type, the following fields are
+	// populated:
+	//
+	//	type TimeDurationDto struct {
+	//			StartTimeDateTz							populated
+	//			EndTimeDateTz               populated
+	//			TimeDuration                populated
+	//			CalcType                    = TDurCalcTypeBWDANNIVERSARY
+	//			Years                       populated
+	//			YearsNanosecs               populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
+	//			Months                      populated
+	//			MonthsNanosecs              populated
+	//			Weeks                       populated
+	//			WeeksNanosecs               populated
+	//			WeekDays                    populated
+	//			WeekDaysNanosecs            populated
+	//			DateDays                    populated
+	//			DateDaysNanosecs            populated
+	//			Hours                       populated
+	//			HoursNanosecs               populated
+	//			Minutes                     populated
+	//			MinutesNanosecs             populated
+	//			Seconds                     populated
+	//			SecondsNanosecs             populated
+	//			Milliseconds                populated
+	//			MillisecondsNanosecs        populated
+	//			Microseconds                populated
+	//			MicrosecondsNanosecs        populated
+	//			Nanoseconds                 populated
+	//			TotSubSecNanoseconds        populated
+	//			TotDateNanoseconds          populated
+	//			TotTimeNanoseconds          populated
+	//	}
+	//
+	TDurCalcTypeBWDANNIVERSARY
+
+	// TDurCalcTypeEOMANNIVERSARY - End of Month Aware Year, Month calculation. Years and
+	// months are counted forward from the starting date time as in 'TDurCalcTypeFWDANNIVERSARY'.
+	// If the starting date is the last day of its month, every anniversary falls on the last
+	// day of the target month. Otherwise, the day of the month is limited to the last day of
+	// the target month. 'TimeDurationDto.MonthEndOverflow' is ignored.
+	//
+	// Example: 2019-01-31 to 2019-02-28   1-Month
+	//          2019-02-28 to 2019-03-31   1-Month   (TDurCalcTypeFWDANNIVERSARY: 1-Month 3-Days)
+	//
+	// For the 'TDurCalcTypeEOMANNIVERSARY' calculation type, the following fields are
+	// populated:
+	//
+	//	type TimeDurationDto struct {
+	//			StartTimeDateTz							populated
+	//			EndTimeDateTz               populated
+	//			TimeDuration                populated
+	//			CalcType                    = TDurCalcTypeEOMANNIVERSARY
+	//			Years                       populated
+	//			YearsNanosecs               populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
+	//			Months                      populated
+	//			MonthsNanosecs              populated
+	//			Weeks                       populated
+	//			WeeksNanosecs               populated
+	//			WeekDays                    populated
+	//			WeekDaysNanosecs            populated
+	//			DateDays                    populated
+	//			DateDaysNanosecs            populated
+	//			Hours                       populated
+	//			HoursNanosecs               populated
+	//			Minutes                     populated
+	//			MinutesNanosecs             populated
+	//			Seconds                     populated
+	//			SecondsNanosecs             populated
+	//			Milliseconds                populated
+	//			MillisecondsNanosecs        populated
+	//			Microseconds                populated
+	//			MicrosecondsNanosecs        populated
+	//			Nanoseconds                 populated
+	//			TotSubSecNanoseconds        populated
+	//			TotDateNanoseconds          populated
+	//			TotTimeNanoseconds          populated
+	//	}
+	//
+	TDurCalcTypeEOMANNIVERSARY
+
+	// TDurCalcType30DAYMONTHS - 30 Day Month calculation. Every month is counted as 30 days
+	// and every year as 360 days. Days are counted between the starting and ending dates under
+	// the 30E/360 day count convention: day 31 of a month is treated as day 30. See
+	// 'DayCount30E360' in source file 'daycountconventiontype.go'. The day count is allocated
+	// over years, months, weeks, week days and date days. The difference in wall clock time of
+	// day is allocated over hours, minutes, seconds, milliseconds, microseconds and nanoseconds.
+	//
+	// Years, months and days are nominal values. Their nanosecond fields are computed from
+	// 360 day years, 30 day months and 24 hour days. As a result, the sum of the element
+	// nanoseconds may differ from 'TimeDuration'.
+	//
+	// Example: 2019-01-31 to 2019-03-01   1-Month 1-Day
+	//
+	// For the 'TDurCalcType30DAYMONTHS' calculation type, the following fields are
+	// populated:
+	//
+	//	type TimeDurationDto struct {
+	//			StartTimeDateTz							populated
+	//			EndTimeDateTz               populated
+	//			TimeDuration                populated
+	//			CalcType                    = TDurCalcType30DAYMONTHS
+	//			Years                       populated
+	//			YearsNanosecs               populated - Nominal
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
+	//			Months                      populated
+	//			MonthsNanosecs              populated - Nominal
+	//			Weeks                       populated
+	//			WeeksNanosecs               populated - Nominal
+	//			WeekDays                    populated
+	//			WeekDaysNanosecs            populated - Nominal
+	//			DateDays                    populated
+	//			DateDaysNanosecs            populated - Nominal
+	//			Hours                       populated
+	//			HoursNanosecs               populated
+	//			Minutes                     populated
+	//			MinutesNanosecs             populated
+	//			Seconds                     populated
+	//			SecondsNanosecs             populated
+	//			Milliseconds                populated
+	//			MillisecondsNanosecs        populated
+	//			Microseconds                populated
+	//			MicrosecondsNanosecs        populated
+	//			Nanoseconds                 populated
+	//			TotSubSecNanoseconds        populated
+	//			TotDateNanoseconds          populated - Nominal
+	//			TotTimeNanoseconds          populated
+	//	}
+	//
+	TDurCalcType30DAYMONTHS
+
+	// TDurCalcTypeCUMQUARTERS - Cumulative Quarters calculation. Years are ignored. The
+	// duration is allocated to cumulative quarters of three calendar months each, counted
+	// forward from the starting date time in the same manner as 'TDurCalcTypeCUMMONTHS'.
+	// The months remaining after the last whole quarter (0-2) are allocated to 'Months'.
+	// The remainder is allocated over weeks, week days, date days, hours, minutes, seconds,
+	// milliseconds, microseconds and nanoseconds. The Data Fields for Years and Half-Years
+	// are set to zero.
+	//
+	// Example: 2019-01-15 to 2020-06-20   5-Quarters 2-Months 5-Days
+	//
+	// For the 'TDurCalcTypeCUMQUARTERS' calculation type, the following fields are
+	// populated:
+	//
+	//	type TimeDurationDto struct {
+	//			StartTimeDateTz							populated
+	//			EndTimeDateTz               populated
+	//			TimeDuration                populated
+	//			CalcType                    = TDurCalcTypeCUMQUARTERS
+	//			Years                       NOT-populated
+	//			YearsNanosecs               NOT-populated
+	//			HalfYears                   NOT-populated
+	//			HalfYearsNanosecs           NOT-populated
+	//			Quarters                    populated
+	//			QuartersNanosecs            populated
+	//			Months                      populated
+	//			MonthsNanosecs              populated
+	//			Weeks                       populated
+	//			WeeksNanosecs               populated
+	//			WeekDays                    populated
+	//			WeekDaysNanosecs            populated
+	//			DateDays                    populated
+	//			DateDaysNanosecs            populated
+	//			Hours                       populated
+	//			HoursNanosecs               populated
+	//			Minutes                     populated
+	//			MinutesNanosecs             populated
+	//			Seconds                     populated
+	//			SecondsNanosecs             populated
+	//			Milliseconds                populated
+	//			MillisecondsNanosecs        populated
+	//			Microseconds                populated
+	//			MicrosecondsNanosecs        populated
+	//			Nanoseconds                 populated
+	//			TotSubSecNanoseconds        populated
+	//			TotDateNanoseconds          populated
+	//			TotTimeNanoseconds          populated
+	//	}
+	//
+	TDurCalcTypeCUMQUARTERS
+
+
+	// TDurCalcTypeCUMHALFYEARS - Cumulative Half-Years calculation. Years are ignored. The
+	// duration is allocated to cumulative half-years of six calendar months each, counted
+	// forward from the starting date time in the same manner as 'TDurCalcTypeCUMMONTHS'.
+	// The months remaining after the last whole half-year (0-5) are allocated to 'Months'.
+	// The remainder is allocated over weeks, week days, date days, hours, minutes, seconds,
+	// milliseconds, microseconds and nanoseconds. The Data Fields for Years and Quarters
+	// are set to zero.
+	//
+	// Example: 2019-01-15 to 2020-06-20   2-Half-Years 5-Months 5-Days
+	//
+	// For the 'TDurCalcTypeCUMHALFYEARS' calculation type, the following fields are
+	// populated:
+	//
+	//	type TimeDurationDto struct {
+	//			StartTimeDateTz							populated
+	//			EndTimeDateTz               populated
+	//			TimeDuration                populated
+	//			CalcType                    = TDurCalcTypeCUMHALFYEARS
+	//			Years                       NOT-populated
+	//			YearsNanosecs               NOT-populated
+	//			HalfYears                   populated
+	//			HalfYearsNanosecs           populated
+	//			Quarters                    NOT-populated
+	//			QuartersNanosecs            NOT-populated
+	//			Months                      populated
+	//			MonthsNanosecs              populated
+	//			Weeks                       populated
+	//			WeeksNanosecs               populated
+	//			WeekDays                    populated
+	//			WeekDaysNanosecs            populated
+	//			DateDays                    populated
+	//			DateDaysNanosecs            populated
+	//			Hours                       populated
+	//			HoursNanosecs               populated
+	//			Minutes                     populated
+	//			MinutesNanosecs             populated
+	//			Seconds                     populated
+	//			SecondsNanosecs             populated
+	//			Milliseconds                populated
+	//			MillisecondsNanosecs        populated
+	//			Microseconds                populated
+	//			MicrosecondsNanosecs        populated
+	//			Nanoseconds                 populated
+	//			TotSubSecNanoseconds        populated
+	//			TotDateNanoseconds          populated
+	//			TotTimeNanoseconds          populated
+	//	}
+	//
+	TDurCalcTypeCUMHALFYEARS
 )
 
 // TDurCalcTypeLabels - Text Names associated with TDurCalcType types.
 var TDurCalcTypeLabels = [...]string{"StdYearMthCalc","CumMonthsCalc","CumWeeksCalc", "CumDaysCalc",
-																			"CumHoursCalc", "CumMinutesCalc","CumSecondsCalc", "GregorianYrsCalc"}
+																			"CumHoursCalc", "CumMinutesCalc","CumSecondsCalc", "GregorianYrsCalc",
+																			"WorkingHoursCalc", "FwdAnniversaryCalc", "BwdAnniversaryCalc",
+																			"EomAnniversaryCalc", "ThirtyDayMonthsCalc", "CumQuartersCalc",
+																			"CumHalfYearsCalc"}
 
 // TimeDurationDto - Is designed to work with incremental time or duration.
 type TimeDurationDto struct {
@@ -416,8 +816,14 @@ type TimeDurationDto struct {
 	TimeDuration         	time.Duration	// Elapsed time or duration between starting and ending date time
 	CalcType              TDurCalcType  // The calculation Type. This controls the allocation of time 
 																			// 		duration over years, months, weeks, days and hours.
+	MonthEndOverflow			MonthEndOverflowType	// Month end overflow policy used to allocate years and months.
+																			//		Default is MonthEndOverflowNORMALIZE.
 	Years                	int64					// Number of Years
 	YearsNanosecs        	int64					// Number of Years in Nanoseconds
+	HalfYears            	int64					// Number of Half-Years (6 months). TDurCalcTypeCUMHALFYEARS only
+	HalfYearsNanosecs    	int64					// Number of Half-Years in Nanoseconds
+	Quarters             	int64					// Number of Quarters (3 months). TDurCalcTypeCUMQUARTERS only
+	QuartersNanosecs     	int64					// Number of Quarters in Nanoseconds
 	Months               	int64					// Number of Months
 	MonthsNanosecs       	int64					// Number of Months in Nanoseconds
 	Weeks                	int64					// Number of Weeks: Date Days / 7
@@ -438,12 +844,35 @@ type TimeDurationDto struct {
 	MicrosecondsNanosecs 	int64					// Number of Microseconds in Nanoseconds
 	Nanoseconds          	int64					// Number of Nanoseconds (Remainder after Milliseconds & Microseconds) 
 	TotSubSecNanoseconds 	int64					// Equivalent Nanoseconds for Milliseconds + Microseconds + Nanoseconds
-	TotDateNanoseconds		int64					// Equal to Years + Months + DateDays in equivalent nanoseconds.
+	TotDateNanoseconds		int64					// Equal to Years + Half-Years + Quarters + Months + DateDays in
+																			//		equivalent nanoseconds.
 	TotTimeNanoseconds		int64					// Equal to Hours + Seconds + Milliseconds + Microseconds + Nanoseconds in
 																			// 		in equivalent nanoseconds
 
 }
 
+// CeilingToUnit - Rounds the time duration up to the next multiple of
+// 'step' units of 'unit' and returns the result as a new TimeDurationDto.
+// The starting date time is unchanged; the ending date time is adjusted.
+// The current TimeDurationDto is not altered.
+//
+// Example: 1-Hour 2-Minutes rounded up to 15 minutes = 1-Hour 15-Minutes
+//
+// See method RoundToUnit() for details.
+//
+func (tDur *TimeDurationDto) CeilingToUnit(unit TimeUnitType, step int) (TimeDurationDto, error) {
+
+	ePrefix := "TimeDurationDto.CeilingToUnit() "
+
+	t2Dur, err := tDur.RoundToUnit(unit, step, RoundCEILING)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return t2Dur, nil
+}
+
 // CopyIn - Receives a TimeDurationDto as an input parameters
 // and proceeds to set all data fields of the current TimeDurationDto
 // equal to the incoming TimeDurationDto.
@@ -458,8 +887,13 @@ func (tDur *TimeDurationDto) CopyIn(t2Dur TimeDurationDto) {
 	tDur.EndTimeDateTz 					=	t2Dur.EndTimeDateTz.CopyOut()
 	tDur.TimeDuration     			= t2Dur.TimeDuration
 	tDur.CalcType								= t2Dur.CalcType
+	tDur.MonthEndOverflow				= t2Dur.MonthEndOverflow
 	tDur.Years									= t2Dur.Years
 	tDur.YearsNanosecs    			= t2Dur.YearsNanosecs
+	tDur.HalfYears							= t2Dur.HalfYears
+	tDur.HalfYearsNanosecs			= t2Dur.HalfYearsNanosecs
+	tDur.Quarters								= t2Dur.Quarters
+	tDur.QuartersNanosecs				= t2Dur.QuartersNanosecs
 	tDur.Months           			= t2Dur.Months
 	tDur.MonthsNanosecs   			= t2Dur.MonthsNanosecs
 	tDur.Weeks            			= t2Dur.Weeks
@@ -494,8 +928,13 @@ func (tDur *TimeDurationDto) CopyOut() TimeDurationDto {
 	t2Dur.EndTimeDateTz 				=	tDur.EndTimeDateTz.CopyOut()
 	t2Dur.TimeDuration     			= tDur.TimeDuration
 	t2Dur.CalcType							= tDur.CalcType
+	t2Dur.MonthEndOverflow			= tDur.MonthEndOverflow
 	t2Dur.Years									= tDur.Years
 	t2Dur.YearsNanosecs    			= tDur.YearsNanosecs
+	t2Dur.HalfYears							= tDur.HalfYears
+	t2Dur.HalfYearsNanosecs			= tDur.HalfYearsNanosecs
+	t2Dur.Quarters							= tDur.Quarters
+	t2Dur.QuartersNanosecs			= tDur.QuartersNanosecs
 	t2Dur.Months           			= tDur.Months
 	t2Dur.MonthsNanosecs   			= tDur.MonthsNanosecs
 	t2Dur.Weeks            			= tDur.Weeks
@@ -529,8 +968,13 @@ func (tDur *TimeDurationDto) Empty() {
 	tDur.EndTimeDateTz 				=	DateTzDto{}
 	tDur.TimeDuration     		= time.Duration(0)
 	tDur.CalcType							= TDurCalcTypeSTDYEARMTH
+	tDur.MonthEndOverflow			= MonthEndOverflowNORMALIZE
 	tDur.Years								= 0
 	tDur.YearsNanosecs    		= 0
+	tDur.HalfYears						= 0
+	tDur.HalfYearsNanosecs		= 0
+	tDur.Quarters							= 0
+	tDur.QuartersNanosecs			= 0
 	tDur.Months           		= 0
 	tDur.MonthsNanosecs   		= 0
 	tDur.Weeks            		= 0
@@ -561,6 +1005,10 @@ func (tDur *TimeDurationDto) EmptyTimeFields() {
 
 	tDur.Years								= 0
 	tDur.YearsNanosecs    		= 0
+	tDur.HalfYears						= 0
+	tDur.HalfYearsNanosecs		= 0
+	tDur.Quarters							= 0
+	tDur.QuartersNanosecs			= 0
 	tDur.Months           		= 0
 	tDur.MonthsNanosecs   		= 0
 	tDur.Weeks            		= 0
@@ -594,8 +1042,13 @@ func (tDur *TimeDurationDto) Equal(t2Dur TimeDurationDto) bool {
 		 	!tDur.EndTimeDateTz.Equal(t2Dur.EndTimeDateTz)						||
 			tDur.TimeDuration 				!= 	t2Dur.TimeDuration					||
 			tDur.CalcType							!=	t2Dur.CalcType							||
+			tDur.MonthEndOverflow			!=	t2Dur.MonthEndOverflow			||
 			tDur.Years								!= 	t2Dur.Years									||
 			tDur.YearsNanosecs    		!= 	t2Dur.YearsNanosecs					||
+			tDur.HalfYears						!= 	t2Dur.HalfYears							||
+			tDur.HalfYearsNanosecs		!= 	t2Dur.HalfYearsNanosecs			||
+			tDur.Quarters							!= 	t2Dur.Quarters							||
+			tDur.QuartersNanosecs			!= 	t2Dur.QuartersNanosecs			||
 			tDur.Months           		!= 	t2Dur.Months 								||
 			tDur.MonthsNanosecs   		!= 	t2Dur.MonthsNanosecs				||
 			tDur.Weeks            		!= 	t2Dur.Weeks									||
@@ -626,6 +1079,28 @@ func (tDur *TimeDurationDto) Equal(t2Dur TimeDurationDto) bool {
 	
 }
 
+// FloorToUnit - Truncates the time duration to a multiple of 'step'
+// units of 'unit' and returns the result as a new TimeDurationDto.
+// The starting date time is unchanged; the ending date time is adjusted.
+// The current TimeDurationDto is not altered.
+//
+// Example: 1-Hour 14-Minutes truncated to 15 minutes = 1-Hour 0-Minutes
+//
+// See method RoundToUnit() for details.
+//
+func (tDur *TimeDurationDto) FloorToUnit(unit TimeUnitType, step int) (TimeDurationDto, error) {
+
+	ePrefix := "TimeDurationDto.FloorToUnit() "
+
+	t2Dur, err := tDur.RoundToUnit(unit, step, RoundFLOOR)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return t2Dur, nil
+}
+
 // IsEmpty() - Returns 'true' if the current TimeDurationDto
 // instance is uninitialized and consists entirely of zero values.
 func (tDur *TimeDurationDto) IsEmpty() bool {
@@ -636,6 +1111,10 @@ func (tDur *TimeDurationDto) IsEmpty() bool {
 			tDur.TimeDuration 						== 0 		&&
 			tDur.Years										== 0 		&&
 			tDur.YearsNanosecs   					== 0 		&&
+			tDur.HalfYears								== 0 		&&
+			tDur.HalfYearsNanosecs				== 0 		&&
+			tDur.Quarters									== 0 		&&
+			tDur.QuartersNanosecs					== 0 		&&
 			tDur.Months          					== 0 	 	&&
 			tDur.MonthsNanosecs 			 		== 0 		&&
 			tDur.Weeks 			          		== 0 		&&
@@ -760,64 +1239,11 @@ func (tDur TimeDurationDto) GetDurationFromSeconds(seconds int64) time.Duration
 //
 func (tDur *TimeDurationDto) GetElapsedTimeStr() string {
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds"
-	}
-
-	t2Dur := tDur.CopyOut()
-
-	if t2Dur.CalcType != TDurCalcTypeSTDYEARMTH {
-		t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeSTDYEARMTH)
-	}
-
-	str := ""
-
-	if t2Dur.Years > 0 {
-		str += fmt.Sprintf("%v-Years ", t2Dur.Years)
-	}
-
-	if t2Dur.Months > 0 || str != "" {
-		str += fmt.Sprintf("%v-Months ", t2Dur.Months)
-	}
-
-	if t2Dur.DateDays > 0 || str != "" {
-		str +=  fmt.Sprintf("%v-Days ", t2Dur.DateDays)
-	}
-
-	if t2Dur.Hours > 0 || str != "" {
-
-		str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
-
-	}
+	durFmt := DurationFormatDto{}.NewElapsedTimeFmt()
 
-	if t2Dur.Minutes > 0 || str != "" {
-
-		str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
-
-	}
-
-	if t2Dur.Seconds > 0 || str != "" {
-
-		str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
-
-	}
-
-	if t2Dur.Milliseconds > 0 || str != "" {
-
-		str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	}
-
-	if t2Dur.Microseconds > 0 || str != "" {
-
-		str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	}
-
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
+	str, _ := durFmt.Format(*tDur)
 
 	return str
-
 }
 
 // GetElapsedMinutesStr - Provides a quick means for formatting Years, Months,
@@ -837,99 +1263,29 @@ func (tDur *TimeDurationDto) GetElapsedTimeStr() string {
 //
 func (tDur *TimeDurationDto) GetElapsedMinutesStr() string {
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds"
-	}
+	durFmt := DurationFormatDto{}.NewElapsedMinutesFmt()
 
-	t2Dur := tDur.CopyOut()
-
-	if t2Dur.CalcType != TDurCalcTypeSTDYEARMTH {
-		t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeSTDYEARMTH)
-	}
-
-	str := ""
-
-	if t2Dur.Years > 0 {
-		str += fmt.Sprintf("%v-Years ", t2Dur.Years)
-	}
-
-	if t2Dur.Months > 0 || str != "" {
-		str += fmt.Sprintf("%v-Months ", t2Dur.Months)
-	}
-
-	if t2Dur.DateDays > 0 || str != "" {
-		str +=  fmt.Sprintf("%v-Days ", t2Dur.DateDays)
-	}
-
-	if t2Dur.Hours > 0 || str != "" {
-
-		str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
-
-	}
-
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
-
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
-
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
+	str, _ := durFmt.Format(*tDur)
 
 	return str
-
 }
 
 // GetYearMthDaysTimeAbbrvStr - Abbreviated formatting of Years, Months,
 // DateDays, Hours, Minutes, Seconds, Milliseconds, Microseconds and
 // Nanoseconds. At a minimum only Hours, Minutes, Seconds, Milliseconds,
 // Microseconds and Nanoseconds.
-//
-// Abbreviated Years Mths DateDays Time Duration - Example Return:
-//
-// 0-Hours 0-Minutes 0-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
-//
-func (tDur *TimeDurationDto) GetYearMthDaysTimeAbbrvStr() string {
-
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds"
-	}
-
-	t2Dur := tDur.CopyOut()
-	
-	if t2Dur.CalcType != TDurCalcTypeSTDYEARMTH {
-		t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeSTDYEARMTH)
-	}
-	
-	str := ""
-
-	if t2Dur.Years > 0 {
-		str += fmt.Sprintf("%v-Years ", t2Dur.Years)
-	}
-
-	if t2Dur.Months > 0 || str != "" {
-		str += fmt.Sprintf("%v-Months ", t2Dur.Months)
-	}
-
-	if t2Dur.DateDays > 0 || str != "" {
-		str +=  fmt.Sprintf("%v-Days ", t2Dur.DateDays)
-	}
-
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
-
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
-
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
-
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
+//
+// Abbreviated Years Mths DateDays Time Duration - Example Return:
+//
+// 0-Hours 0-Minutes 0-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
+//
+func (tDur *TimeDurationDto) GetYearMthDaysTimeAbbrvStr() string {
 
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
+	durFmt := DurationFormatDto{}.NewYearMthDaysTimeAbbrvFmt()
 
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
+	str, _ := durFmt.Format(*tDur)
 
 	return str
-
 }
 
 // GetYearMthDaysTimeStr - Calculates Duration and breakdowns
@@ -948,41 +1304,30 @@ func (tDur *TimeDurationDto) GetYearMthDaysTimeAbbrvStr() string {
 //		13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
 func (tDur *TimeDurationDto) GetYearMthDaysTimeStr() string {
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds"
-	}
-
-	t2Dur := tDur.CopyOut()
-
-	if t2Dur.CalcType != TDurCalcTypeSTDYEARMTH {
-		t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeSTDYEARMTH)
-	}	
-	
-	str := ""
-	
-	if t2Dur.Years > 0 {
-		str += fmt.Sprintf("%v-Years ", t2Dur.Years)	
-	}
-	
-	if t2Dur.Months > 0 || str != "" {
-		str += fmt.Sprintf("%v-Months ", t2Dur.Months)	
-	}
-
-	if t2Dur.DateDays > 0 || str!= "" {
-		str += fmt.Sprintf("%v-Days ", t2Dur.DateDays)
-	}
+	durFmt := DurationFormatDto{}.NewYearMthDaysTimeFmt()
 
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
+	str, _ := durFmt.Format(*tDur)
 
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
+	return str
+}
 
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
+// GetYearMthDaysTimeLocaleStr - Returns the same time element breakdown
+// as GetYearMthDaysTimeStr(). However, unit labels are displayed in the
+// language of input parameter 'locale' and singular or plural labels are
+// selected using the locale plural rule.
+//
+// Example DisplayStr - Spanish Locale
+// ===================================
+//
+// 12 años 3 meses 2 días 13 horas 26 minutos 46 segundos 864 milisegundos 197 microsegundos 832 nanosegundos
+//
+func (tDur *TimeDurationDto) GetYearMthDaysTimeLocaleStr(locale LocaleDto) string {
 
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
+	durFmt := DurationFormatDto{}.NewYearMthDaysTimeFmt()
 
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
+	durFmt.SetLocale(locale)
 
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
+	str, _ := durFmt.Format(*tDur)
 
 	return str
 }
@@ -999,45 +1344,9 @@ func (tDur *TimeDurationDto) GetYearMthDaysTimeStr() string {
 //
 func (tDur *TimeDurationDto) GetYearsMthsWeeksTimeAbbrvStr() string {
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds"
-	}
-
-	t2Dur := tDur.CopyOut()
-
-	if t2Dur.CalcType != TDurCalcTypeSTDYEARMTH {
-		t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeSTDYEARMTH)
-	}
-
-	str := ""
-	
-	if t2Dur.Years > 0 {
-		str += fmt.Sprintf("%v-Years ", t2Dur.Years)
-	}
-
-	if t2Dur.Months > 0 || str != "" {
-		str += fmt.Sprintf("%v-Months ", t2Dur.Months)
-	}
-
-	if t2Dur.Weeks > 0 || str != "" {
-		str += fmt.Sprintf("%v-Weeks ", t2Dur.Weeks)
-	}
-
-	if t2Dur.WeekDays > 0 || str != "" {
-		str += fmt.Sprintf("%v-WeekDays ", t2Dur.WeekDays)
-	}
-
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
+	durFmt := DurationFormatDto{}.NewYearsMthsWeeksTimeAbbrvFmt()
 
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
-
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
-
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
+	str, _ := durFmt.Format(*tDur)
 
 	return str
 }
@@ -1054,41 +1363,9 @@ func (tDur *TimeDurationDto) GetYearsMthsWeeksTimeAbbrvStr() string {
 //
 func (tDur *TimeDurationDto) GetYearsMthsWeeksTimeStr() string {
 
-	if int64(tDur.TimeDuration) == 0 {
-			return "0-Nanoseconds"
-	}
-	
-	t2Dur := tDur.CopyOut()
-
-	if t2Dur.CalcType != TDurCalcTypeSTDYEARMTH {
-		t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeSTDYEARMTH)
-	}
-
-	str := ""
-	
-	if t2Dur.Years > 0 {
-		str += fmt.Sprintf("%v-Years ", t2Dur.Years)	
-	}
-	
-	if t2Dur.Months > 0 || str != "" {
-		str+= fmt.Sprintf("%v-Months ", t2Dur.Months)
-	}
-
-	str+= fmt.Sprintf("%v-Weeks ", t2Dur.Weeks)	
-	
-	str+= fmt.Sprintf("%v-WeekDays ", t2Dur.WeekDays)
-
-	str+= fmt.Sprintf("%v-Hours ", t2Dur.Hours)
-
-	str+= fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
-
-	str+= fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
+	durFmt := DurationFormatDto{}.NewYearsMthsWeeksTimeFmt()
 
-	str+= fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	str+= fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	str+= fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
+	str, _ := durFmt.Format(*tDur)
 
 	return str
 }
@@ -1129,37 +1406,71 @@ func (tDur *TimeDurationDto) GetCumDaysCalcDto() (TimeDurationDto, error) {
 // 97-Days 13-Hours 26-Minutes 46-Seconds 864-Milliseconds 197-Microseconds 832-Nanoseconds
 //
 func (tDur *TimeDurationDto) GetCumDaysTimeStr() (string, error) {
+
 	ePrefix := "TimeDurationDto) GetCumDaysTimeStr() "
 
+	durFmt := DurationFormatDto{}.NewCumDaysTimeFmt()
+
+	str, err := durFmt.Format(*tDur)
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
+	}
+
+	return str, nil
+}
+
+// GetCumHalfYearsCalcDto - Returns a new TimeDurationDto calculated
+// for 'cumulative half-years'.
+//
+// The time values of the current TimeDurationDto are re-calculated and
+// returned in the new TimeDurationDTo as 'cumulative half-years'.
+// This means that Years are ignored and assigned a zero value. Instead,
+// Years and Months are consolidated and presented as 'cumulative half-years'
+// of six months each. Months remaining after the last whole half-year are
+// presented as Months.
+//
+func (tDur *TimeDurationDto) GetCumHalfYearsCalcDto() (TimeDurationDto, error) {
+
+	ePrefix := "TimeDurationDto.GetCumHalfYearsCalcDto() "
+
 	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds", nil
+		return TimeDurationDto{}, nil
 	}
 
 	t2Dur := tDur.CopyOut()
-	
-	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeCUMDAYS)
+
+	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeCUMHALFYEARS)
 
 	if err != nil {
-		return "", fmt.Errorf(ePrefix + "Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeCUMDAYS)" +
-			" Error='%v'", err.Error())
+		return TimeDurationDto{}, fmt.Errorf(ePrefix +
+			"Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeCUMHALFYEARS) " +
+			"Error='%v' ", err.Error())
 	}
-	
-	str := ""
 
-	str += fmt.Sprintf("%v-Days ", t2Dur.DateDays)
-
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
+	return t2Dur, nil
 
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
+}
 
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
+// GetCumHalfYearsTimeStr - Returns Cumulative Half-Years Display
+// showing Half-Years, Months, Days, Hours, Minutes, Seconds,
+// Milliseconds, Microseconds and Nanoseconds.
+//
+// Example: 2-Half-Years 5-Months 5-Days 13-Hours 26-Minutes 46-Seconds 0-Milliseconds 0-Microseconds 0-Nanoseconds
+//
+func (tDur *TimeDurationDto) GetCumHalfYearsTimeStr() (string, error) {
 
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
+	ePrefix := "TimeDurationDto.GetCumHalfYearsTimeStr() "
 
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
+	durFmt := DurationFormatDto{}.NewCumHalfYearsTimeFmt()
 
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
+	str, err := durFmt.Format(*tDur)
 
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
+	}
 
 	return str, nil
 }
@@ -1206,34 +1517,15 @@ func (tDur *TimeDurationDto) GetCumHoursTimeStr() (string, error) {
 
 	ePrefix := "TimeDurationDto) GetCumHoursTimeStr() "
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds", nil
-	}
-
-	t2Dur := tDur.CopyOut()
+	durFmt := DurationFormatDto{}.NewCumHoursTimeFmt()
 
-	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeCUMHOURS)
+	str, err := durFmt.Format(*tDur)
 
 	if err != nil {
-		return "", fmt.Errorf(ePrefix +
-			"Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeCUMHOURS) " +
-			"Error='%v' ", err.Error())
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
 	}
 
-	str := ""
-
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
-
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
-
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
-
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
-
 	return str, nil
 }
 
@@ -1283,34 +1575,16 @@ func (tDur *TimeDurationDto) GetCumMinutesStr() (string, error) {
 
 	ePrefix := "TimeDurationDto.GetCumMinutesStr() "
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds", nil
-	}
-
-	t2Dur := tDur.CopyOut()
+	durFmt := DurationFormatDto{}.NewCumMinutesFmt()
 
-	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeCUMMINUTES)
+	str, err := durFmt.Format(*tDur)
 
 	if err != nil {
-		return "", fmt.Errorf(ePrefix +
-			"Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeCUMMINUTES) " +
-			" Error='%v'", err.Error())
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
 	}
 
-	str := ""
-
-	str += fmt.Sprintf("%v-Minutes ", tDur.Minutes)
-
-	str += fmt.Sprintf("%v-Seconds ", tDur.Seconds)
-
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
-
 	return str, nil
-
 }
 
 
@@ -1356,41 +1630,70 @@ func (tDur *TimeDurationDto) GetCumMonthsDaysTimeStr() (string, error) {
 
 	ePrefix := "TimeDurationDto.GetCumMonthsDaysTimeStr() "
 
+	durFmt := DurationFormatDto{}.NewCumMonthsDaysTimeFmt()
+
+	str, err := durFmt.Format(*tDur)
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
+	}
+
+	return str, nil
+}
+
+// GetCumQuartersCalcDto - Returns a new TimeDurationDto calculated
+// for 'cumulative quarters'.
+//
+// The time values of the current TimeDurationDto are re-calculated and
+// returned in the new TimeDurationDTo as 'cumulative quarters'.
+// This means that Years are ignored and assigned a zero value. Instead,
+// Years and Months are consolidated and presented as 'cumulative quarters'
+// of three months each. Months remaining after the last whole quarter are
+// presented as Months.
+//
+func (tDur *TimeDurationDto) GetCumQuartersCalcDto() (TimeDurationDto, error) {
+
+	ePrefix := "TimeDurationDto.GetCumQuartersCalcDto() "
+
 	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds", nil
+		return TimeDurationDto{}, nil
 	}
 
 	t2Dur := tDur.CopyOut()
 
-	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeCUMMONTHS)
+	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeCUMQUARTERS)
 
 	if err != nil {
-		return "", fmt.Errorf(ePrefix +
-			"Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeCUMMONTHS) " +
+		return TimeDurationDto{}, fmt.Errorf(ePrefix +
+			"Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeCUMQUARTERS) " +
 			"Error='%v' ", err.Error())
 	}
 
+	return t2Dur, nil
 
-	str := ""
-
-	str += fmt.Sprintf("%v-Months ", t2Dur.Months)
-
-	str += fmt.Sprintf("%v-Days ", t2Dur.DateDays)
-
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
+}
 
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
+// GetCumQuartersTimeStr - Returns Cumulative Quarters Display
+// showing Quarters, Months, Days, Hours, Minutes, Seconds,
+// Milliseconds, Microseconds and Nanoseconds.
+//
+// Example: 5-Quarters 2-Months 5-Days 13-Hours 26-Minutes 46-Seconds 0-Milliseconds 0-Microseconds 0-Nanoseconds
+//
+func (tDur *TimeDurationDto) GetCumQuartersTimeStr() (string, error) {
 
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
+	ePrefix := "TimeDurationDto.GetCumQuartersTimeStr() "
 
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
+	durFmt := DurationFormatDto{}.NewCumQuartersTimeFmt()
 
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
+	str, err := durFmt.Format(*tDur)
 
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
+	}
 
 	return str, nil
-
 }
 
 // GetCumSecondsCalcDto - Returns a new TimeDurationDto calculated
@@ -1433,33 +1736,16 @@ func (tDur *TimeDurationDto) GetCumSecondsTimeStr() (string, error) {
 
 	ePrefix := "TimeDurationDto.GetCumSecondsTimeStr() "
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds", nil
-	}
-
-	t2Dur := tDur.CopyOut()
+	durFmt := DurationFormatDto{}.NewCumSecondsTimeFmt()
 
-	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeCUMSECONDS)
+	str, err := durFmt.Format(*tDur)
 
 	if err != nil {
-		return "", fmt.Errorf(ePrefix +
-			"Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeCUMSECONDS) " +
-			"Error='%v' ", err.Error())
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
 	}
 
-
-	str := ""
-
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
-
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
-
 	return str, nil
-
 }
 
 // GetCumNanosecondsDurationStr - Returns duration formatted as
@@ -1518,38 +1804,15 @@ func (tDur *TimeDurationDto) GetCumWeeksDaysTimeStr() (string, error) {
 
 	ePrefix := "TimeDurationDto.GetCumWeeksDaysTimeStr() "
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds", nil
-	}
-
-	t2Dur := tDur.CopyOut()
+	durFmt := DurationFormatDto{}.NewCumWeeksDaysTimeFmt()
 
-	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeCUMWEEKS)
+	str, err := durFmt.Format(*tDur)
 
 	if err != nil {
-		return "", fmt.Errorf(ePrefix +
-			"Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeCUMWEEKS) " +
-			" Error='%v' ", err.Error())
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
 	}
 
-	str := ""
-
-	str += fmt.Sprintf("%v-Weeks ", t2Dur.Weeks)
-
-	str += fmt.Sprintf("%v-WeekDays ", t2Dur.WeekDays)
-
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
-
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
-
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
-
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
-
 	return str, nil
 }
 
@@ -1558,35 +1821,66 @@ func (tDur *TimeDurationDto) GetCumWeeksDaysTimeStr() (string, error) {
 // Example: 3-Years 2-Months 3-Weeks 2-WeekDays 13-Hours 26-Minutes 46-Seconds 864197832-Nanoseconds
 func (tDur *TimeDurationDto) GetYrMthWkDayHrMinSecNanosecsStr() string {
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds"
-	}
-	
-	t2Dur := tDur.CopyOut()
-
-	if t2Dur.CalcType != TDurCalcTypeSTDYEARMTH {
-		t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeSTDYEARMTH)
-	}
-
-	str := ""
+	durFmt := DurationFormatDto{}.NewYrMthWkDayHrMinSecNanosecsFmt()
 
-	str += fmt.Sprintf("%v-Years ", t2Dur.Years)
+	str, _ := durFmt.Format(*tDur)
 
-	str += fmt.Sprintf("%v-Months ", t2Dur.Months)
+	return str
+}
 
-	str += fmt.Sprintf("%v-Weeks ", t2Dur.Weeks)
+// GetDecimalDuration - Returns the time duration expressed as an exact
+// decimal quantity of the time unit specified by input parameter 'unit'.
+// The result is rounded to 'precision' digits to the right of the decimal
+// point using rounding mode 'roundMode'.
+//
+// Days and Weeks are computed as 24-hour days. Months and Years are computed
+// using the average Gregorian Year of 365.2425 days. A Month is equal to
+// one twelfth of a Gregorian Year.
+//
+// Input Parameters:
+// =================
+//
+// unit      TimeUnitType     - The time unit in which duration will be expressed.
+//                              Valid values range from TimeUnitNANOSECONDS through
+//                              TimeUnitHALFYEARS.
+//
+// precision uint             - The number of digits to the right of the decimal point.
+//
+// roundMode RoundingModeType - The rounding mode used to round the result to
+//                              'precision' digits. Example: RoundHALFUP
+//
+// Example:
+//
+//	 Duration = 26-Hours 30-Minutes 20-Seconds
+//
+//	 dec, err := tDur.GetDecimalDuration(TimeUnitHOURS, 4, RoundHALFUP)
+//
+//	 dec.String() = "26.5056"
+//
+func (tDur *TimeDurationDto) GetDecimalDuration(
+	unit TimeUnitType,
+	precision uint,
+	roundMode RoundingModeType) (DecimalDto, error) {
 
-	str += fmt.Sprintf("%v-WeekDays ", t2Dur.WeekDays)
+	ePrefix := "TimeDurationDto.GetDecimalDuration() "
 
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
+	unitNanosecs, err := unit.nanoseconds()
 
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
+	if err != nil {
+		return DecimalDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
 
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
+	dec, err := DecimalDto{}.NewFrac(
+		big.NewInt(int64(tDur.TimeDuration)),
+		big.NewInt(unitNanosecs),
+		precision,
+		roundMode)
 
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.TotSubSecNanoseconds)
+	if err != nil {
+		return DecimalDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
 
-	return str
+	return dec, nil
 }
 
 // GetDefaultDurationStr - Returns duration formatted
@@ -1598,6 +1892,71 @@ func (tDur *TimeDurationDto) GetDefaultDurationStr() string {
 	return fmt.Sprintf("%v", tDur.TimeDuration)
 }
 
+// GetFormattedDurationStr - Returns the time duration formatted
+// according to the template and options contained in input
+// parameter 'durFmt'. The current TimeDurationDto is not altered.
+//
+// Input Parameters:
+// =================
+//
+// durFmt DurationFormatDto - Contains the format template and
+//                            formatting options. See source file
+//                            'durationformatdto.go' for details.
+//
+// Example:
+//
+//	durFmt, _ := DurationFormatDto{}.New("{CumHours} {Minutes} {Seconds!}")
+//	str, err := tDur.GetFormattedDurationStr(durFmt)
+//
+//	str = "26-Hours 0-Minutes 15-Seconds"
+//
+func (tDur *TimeDurationDto) GetFormattedDurationStr(durFmt DurationFormatDto) (string, error) {
+
+	ePrefix := "TimeDurationDto.GetFormattedDurationStr() "
+
+	str, err := durFmt.Format(*tDur)
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
+	}
+
+	return str, nil
+}
+
+// GetFormattedDurationLocaleStr - Returns the time duration formatted
+// according to the template and options contained in input parameter
+// 'durFmt'. Unit labels, plural rule and unit separator are taken from
+// input parameter 'locale'.
+//
+// Input Parameters:
+// =================
+//
+// durFmt DurationFormatDto - Contains the format template and
+//                            formatting options.
+//
+// locale LocaleDto         - Supplies language specific unit labels.
+//                            See source file 'localedto.go'.
+//
+func (tDur *TimeDurationDto) GetFormattedDurationLocaleStr(
+	durFmt DurationFormatDto, locale LocaleDto) (string, error) {
+
+	ePrefix := "TimeDurationDto.GetFormattedDurationLocaleStr() "
+
+	d2Fmt := durFmt.CopyOut()
+
+	d2Fmt.SetLocale(locale)
+
+	str, err := d2Fmt.Format(*tDur)
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix + "Error returned by d2Fmt.Format(*tDur). " +
+			"Error='%v'", err.Error())
+	}
+
+	return str, nil
+}
+
 // GetGregorianYearCalcDto - Returns a new TimeDurationDto in which years are
 // calculated as 'Gregorian Years'.
 //
@@ -1649,37 +2008,14 @@ func (tDur *TimeDurationDto) GetGregorianYearDurationStr() (string, error) {
 
 	ePrefix := "TimeDurationDto.GetGregorianYearDurationStr() "
 
-	if int64(tDur.TimeDuration) == 0 {
-		return "0-Nanoseconds", nil
-	}
-
-	t2Dur := tDur.CopyOut()
+	durFmt := DurationFormatDto{}.NewGregorianYearFmt()
 
-	err := t2Dur.ReCalcTimeDurationAllocation(TDurCalcTypeGregorianYrs)
+	str, err := durFmt.Format(*tDur)
 
 	if err != nil {
-		return "", fmt.Errorf(ePrefix +
-			"Error returned by ReCalcTimeDurationAllocation(TDurCalcTypeGregorianYrs) " +
+		return "", fmt.Errorf(ePrefix + "Error returned by durFmt.Format(*tDur). " +
 			"Error='%v'", err.Error())
 	}
-	
-	str := fmt.Sprintf("%v-Gregorian Years ", t2Dur.Years)
-
-	str += fmt.Sprintf("%v-Months ", t2Dur.Months)
-
-	str += fmt.Sprintf("%v-Days ", t2Dur.DateDays)
-
-	str += fmt.Sprintf("%v-Hours ", t2Dur.Hours)
-
-	str += fmt.Sprintf("%v-Minutes ", t2Dur.Minutes)
-
-	str += fmt.Sprintf("%v-Seconds ", t2Dur.Seconds)
-
-	str += fmt.Sprintf("%v-Milliseconds ", t2Dur.Milliseconds)
-
-	str += fmt.Sprintf("%v-Microseconds ", t2Dur.Microseconds)
-
-	str += fmt.Sprintf("%v-Nanoseconds", t2Dur.Nanoseconds)
 
 	return str, nil
 }
@@ -2911,6 +3247,90 @@ func (tDur TimeDurationDto) NewStartEndTimesDateTzDtoCalcTz(startDateTime,
 	return t2Dur, nil
 }
 
+// NewStartEndWorkingTime - Creates and returns a new TimeDurationDto where
+// the time duration is equal to the working time between 'startDateTime' and
+// 'endDateTime'. Working time is defined by the weekly work schedule,
+// 'schedule'. Time outside of scheduled work periods, including weekends and
+// holidays, is excluded.
+//
+// The calculation type is set to 'TDurCalcTypeWORKINGHOURS' and working time
+// is allocated over cumulative hours, minutes, seconds, milliseconds,
+// microseconds and nanoseconds.
+//
+// The starting and ending date times are converted to the time zone of
+// 'startDateTime'. If 'endDateTime' is earlier than 'startDateTime', the
+// two date times are swapped.
+//
+// Input Parameters:
+// =================
+//
+// startDateTime	DateTzDto	- Starting date time
+//
+// endDateTime		DateTzDto	- Ending date time
+//
+// schedule	WorkScheduleDto	- The weekly work schedule and optional holiday
+//														calendar. See source file 'workscheduledto.go'.
+//
+// dateTimeFmtStr string		- A date time format string which will be used
+//															to format and display 'dateTime'. Example:
+//															"2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//														If 'dateTimeFmtStr' is submitted as an
+//															'empty string', a default date time format
+//															string will be applied. The default date time
+//															format string is:
+//															FmtDateTimeYrMDayFmtStr = "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+// Example:
+//
+//	schedule, err := WorkScheduleDto{}.NewMonFri(TzIanaUsCentral, "09:00", "17:00", nil)
+//
+//	Start: Friday 2018-06-29 16:00   End: Monday 2018-07-02 10:30
+//
+//	tDur, err := TimeDurationDto{}.NewStartEndWorkingTime(startDtz, endDtz, schedule, "")
+//
+//	tDur.TimeDuration = 2-Hours 30-Minutes
+//
+func (tDur TimeDurationDto) NewStartEndWorkingTime(
+	startDateTime,
+	endDateTime DateTzDto,
+	schedule WorkScheduleDto,
+	dateTimeFmtStr string) (TimeDurationDto, error) {
+
+	ePrefix := "TimeDurationDto.NewStartEndWorkingTime() "
+
+	t2Dur := TimeDurationDto{}
+
+	err := t2Dur.SetStartEndTimesDateDtoCalcTz(startDateTime,
+						endDateTime,
+							TDurCalcTypeCUMHOURS,
+								startDateTime.TimeZone.LocationName,
+									dateTimeFmtStr)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "Error returned from " +
+			"SetStartEndTimesDateDtoCalcTz(). Error='%v'", err.Error())
+	}
+
+	workingTime, err := schedule.getWorkingDuration(t2Dur.StartTimeDateTz.DateTime,
+												t2Dur.EndTimeDateTz.DateTime)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "Error returned by " +
+			"schedule.getWorkingDuration(). Error='%v'", err.Error())
+	}
+
+	t2Dur.TimeDuration = workingTime
+
+	err = t2Dur.calcTypeWORKINGHOURS()
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return t2Dur, nil
+}
+
 // NewStartTimeDurationTz - Creates and returns a new TimeDurationDto based on input parameters
 // 'startDateTime' and time duration. 'startDateTime' is used to derive Time Zone Location.
 // The time duration value is added to 'startDateTime' in order to compute the ending date time.
@@ -4021,6 +4441,96 @@ func (tDur TimeDurationDto) NewStartTimePlusTimeDtoCalcTz(
 
 }
 
+// NewStartWorkingDuration - Creates and returns a new TimeDurationDto by
+// adding a quantity of working time to 'startDateTime'. The ending date time
+// is the instant at which 'workingTime' of scheduled work has elapsed. Time
+// outside of scheduled work periods, including weekends and holidays, is
+// skipped.
+//
+// If 'workingTime' is a negative value, 'startDateTime' is treated as the
+// ending date time and the starting date time is computed by subtracting
+// working time.
+//
+// The calculation type is set to 'TDurCalcTypeWORKINGHOURS' and the time
+// duration is equal to the absolute value of 'workingTime'. Results are
+// expressed in the time zone of 'startDateTime'.
+//
+// Input Parameters:
+// =================
+//
+// startDateTime	DateTzDto			- Starting date time
+//
+// workingTime		time.Duration	- The quantity of working time
+//
+// schedule	WorkScheduleDto			- The weekly work schedule and optional holiday
+//																calendar. See source file 'workscheduledto.go'.
+//
+// dateTimeFmtStr string				- A date time format string which will be used
+//																to format and display 'dateTime'. If
+//																'dateTimeFmtStr' is submitted as an
+//																'empty string', a default date time format
+//																string will be applied. The default date time
+//																format string is:
+//																FmtDateTimeYrMDayFmtStr = "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+// Example:
+//
+//	Sixteen working hours after Monday 2018-07-02 13:00 with a Monday
+//	through Friday 09:00 - 17:00 schedule and US holidays:
+//
+//	tDur, err := TimeDurationDto{}.NewStartWorkingDuration(startDtz, 16 * time.Hour,
+//	                  schedule, "")
+//
+//	tDur.EndTimeDateTz = Thursday 2018-07-05 13:00
+//
+func (tDur TimeDurationDto) NewStartWorkingDuration(
+	startDateTime DateTzDto,
+	workingTime time.Duration,
+	schedule WorkScheduleDto,
+	dateTimeFmtStr string) (TimeDurationDto, error) {
+
+	ePrefix := "TimeDurationDto.NewStartWorkingDuration() "
+
+	if startDateTime.DateTime.IsZero() {
+		return TimeDurationDto{},
+			errors.New(ePrefix + "Error: Input parameter 'startDateTime' is ZERO!")
+	}
+
+	endDateTime, err := schedule.addWorkingDuration(startDateTime.DateTime, workingTime)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "Error returned by " +
+			"schedule.addWorkingDuration(). Error='%v'", err.Error())
+	}
+
+	t2Dur := TimeDurationDto{}
+
+	err = t2Dur.SetStartEndTimesCalcTz(startDateTime.DateTime,
+						endDateTime,
+							TDurCalcTypeCUMHOURS,
+								startDateTime.TimeZone.LocationName,
+									dateTimeFmtStr)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "Error returned from " +
+			"SetStartEndTimesCalcTz(). Error='%v'", err.Error())
+	}
+
+	if workingTime < 0 {
+		workingTime = -workingTime
+	}
+
+	t2Dur.TimeDuration = workingTime
+
+	err = t2Dur.calcTypeWORKINGHOURS()
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return t2Dur, nil
+}
+
 // NewEndTimeMinusTimeDtoTz - Creates and returns a new TimeDurationDto setting
 // start date time, end date time and duration based on an ending date time
 // and the time components contained in a TimeDto.
@@ -4260,6 +4770,126 @@ func (tDur TimeDurationDto) NewEndTimeMinusTimeDtoCalcTz(
 
 }
 
+// RoundToUnit - Rounds the time duration to a multiple of 'step' units
+// of 'unit' and returns the result as a new TimeDurationDto. The starting
+// date time is unchanged; the ending date time is adjusted to reflect the
+// rounded duration. The calculation type, month end overflow policy, time
+// zone and date time format of the current TimeDurationDto are retained.
+// The current TimeDurationDto is not altered.
+//
+// Nanoseconds through Weeks are fixed length units; a day is 24 hours.
+// Months, Quarters, Half-Years and Years are calendar units measured
+// from the starting date time. Example: A duration starting on 2018-01-31
+// rounded to 1 month is measured against the boundaries 2018-01-31,
+// 2018-03-03 (Jan 31 + 1 month) etc. as computed by time.AddDate(). If
+// the MonthEndOverflow policy of the current TimeDurationDto is
+// MonthEndOverflowCLAMP, the boundaries are 2018-01-31, 2018-02-28,
+// 2018-03-31 etc.
+//
+// Input Parameters:
+// =================
+//
+// unit      TimeUnitType     - The time unit. Valid values are TimeUnitNANOSECONDS
+//                              through TimeUnitYEARS, TimeUnitQUARTERS and
+//                              TimeUnitHALFYEARS.
+//
+// step      int              - The number of units in each rounding increment.
+//                              Must be greater than zero. Example: 15 minutes.
+//
+// roundMode RoundingModeType - The rounding mode. Examples: RoundHALFUP,
+//                              RoundHALFEVEN, RoundTOWARDZERO.
+//
+// Example Usage:
+// ==============
+//
+//	Bill in 15 minute increments, rounding half up.
+//
+//	tDur = 1-Hour 7-Minutes 30-Seconds
+//	t2Dur, err := tDur.RoundToUnit(TimeUnitMINUTES, 15, RoundHALFUP)
+//	t2Dur = 1-Hour 15-Minutes
+//
+func (tDur *TimeDurationDto) RoundToUnit(unit TimeUnitType, step int,
+	roundMode RoundingModeType) (TimeDurationDto, error) {
+
+	ePrefix := "TimeDurationDto.RoundToUnit() "
+
+	if step < 1 {
+		return TimeDurationDto{},
+			fmt.Errorf(ePrefix + "Error: Input parameter 'step' must be greater than zero. step='%v'", step)
+	}
+
+	startDateTime := tDur.StartTimeDateTz.DateTime
+	endDateTime := tDur.EndTimeDateTz.DateTime
+
+	var lower, upper time.Time
+	var lowerIdx int64
+
+	switch unit {
+
+	case TimeUnitMONTHS, TimeUnitQUARTERS, TimeUnitHALFYEARS, TimeUnitYEARS:
+
+		stepMonths := unit.calendarMonths() * step
+
+		// Estimate the number of steps from the average Gregorian month,
+		// then adjust so that lower <= endDateTime < upper.
+		monthNanosecs, _ := TimeUnitMONTHS.nanoseconds()
+
+		k := int(int64(tDur.TimeDuration) / (monthNanosecs * int64(stepMonths)))
+
+		for k > 0 && addYearsMonthsOverflow(startDateTime, 0, k*stepMonths, tDur.MonthEndOverflow).After(endDateTime) {
+			k--
+		}
+
+		for !addYearsMonthsOverflow(startDateTime, 0, (k+1)*stepMonths, tDur.MonthEndOverflow).After(endDateTime) {
+			k++
+		}
+
+		lowerIdx = int64(k)
+		lower = addYearsMonthsOverflow(startDateTime, 0, k*stepMonths, tDur.MonthEndOverflow)
+		upper = addYearsMonthsOverflow(startDateTime, 0, (k+1)*stepMonths, tDur.MonthEndOverflow)
+
+	default:
+
+		unitNanosecs, err := unit.nanoseconds()
+
+		if err != nil {
+			return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		stepNanosecs := unitNanosecs * int64(step)
+
+		lowerIdx = int64(tDur.TimeDuration) / stepNanosecs
+		lower = startDateTime.Add(time.Duration(lowerIdx * stepNanosecs))
+		upper = lower.Add(time.Duration(stepNanosecs))
+	}
+
+	result, err := selectRoundedBoundary(endDateTime, lower, upper, lowerIdx, roundMode)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	t2Dur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(
+		startDateTime,
+		result,
+		tDur.CalcType,
+		tDur.StartTimeDateTz.TimeZone.LocationName,
+		tDur.StartTimeDateTz.DateTimeFmt)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix +
+			"Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+	}
+
+	err = t2Dur.SetMonthEndOverflow(tDur.MonthEndOverflow)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return t2Dur, nil
+}
+
 // ReCalcTimeDurationAllocation - Re-calculates and allocates time duration for the current
 // TimeDurationDto instance over the various time components (years, months, weeks, weekdays,
 // datedays, hour, minutes, seconds, milliseconds, microseconds and nanoseconds) depending
@@ -4506,11 +5136,21 @@ func (tDur *TimeDurationDto) SetEndTimeMinusTimeDtoCalcTz(endDateTime time.Time,
 			"dtFormat). Error='%v'", err.Error())
 	}
 
+	overflow := tDur.MonthEndOverflow
+
 	tDur.Empty()
 
+	tDur.MonthEndOverflow = overflow
+
 	tDur.EndTimeDateTz = eDateTime.TimeOut.CopyOut()
 
-	tDur.StartTimeDateTz, err = eDateTime.TimeOut.AddMinusTimeDto(minusTimeDto)
+	tDur.StartTimeDateTz, err = eDateTime.TimeOut.AddMinusTimeDtoOverflow(minusTimeDto, overflow)
+
+	if err != nil {
+		tDur.Empty()
+		return fmt.Errorf(ePrefix + "Error returned by eDateTime.TimeOut.AddMinusTimeDtoOverflow(minusTimeDto, overflow). " +
+			"Error='%v'", err.Error())
+	}
 
 	tDur.TimeDuration = tDur.EndTimeDateTz.DateTime.Sub(tDur.StartTimeDateTz.DateTime)
 
@@ -4525,6 +5165,50 @@ func (tDur *TimeDurationDto) SetEndTimeMinusTimeDtoCalcTz(endDateTime time.Time,
 	return nil
 }
 
+// SetMonthEndOverflow - Sets the month end overflow policy used to allocate
+// years and months and re-calculates the time duration allocation of the
+// current TimeDurationDto. The starting and ending date times and the
+// calculation type are unchanged.
+//
+// The policy determines the date which lies 'n' months after the starting
+// date time when the day of the month does not exist in the target month.
+// Example: For a duration from 2019-01-31 to 2019-03-01
+//
+//	MonthEndOverflowNORMALIZE  0-Months 29-Days  (Jan 31 + 1 month = Mar 3)
+//	MonthEndOverflowCLAMP      1-Month  1-Day    (Jan 31 + 1 month = Feb 28)
+//
+// The policy is retained by subsequent calls to the Set methods. Adding the
+// allocated years, months and days to the starting date time with the same
+// policy reproduces the ending date time. See DateTzDto.AddPlusTimeDtoOverflow().
+// Subtracting them from the ending date time reproduces the starting date
+// time, except where the policy clamped or rolled the day of the month.
+//
+func (tDur *TimeDurationDto) SetMonthEndOverflow(overflow MonthEndOverflowType) error {
+
+	ePrefix := "TimeDurationDto.SetMonthEndOverflow() "
+
+	err := validateMonthEndOverflow(overflow)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	tDur.MonthEndOverflow = overflow
+
+	if tDur.StartTimeDateTz.DateTime.IsZero() && tDur.EndTimeDateTz.DateTime.IsZero() {
+		return nil
+	}
+
+	err = tDur.calcTimeDurationAllocations(tDur.CalcType)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcTimeDurationAllocations(tDur.CalcType). " +
+			"Error='%v'", err.Error())
+	}
+
+	return nil
+}
+
 // SetStartEndTimesDateDtoCalcTz - Sets data field values for the current
 // TimeDurationDto instance using a Start Date Time, End Date Time and a
 // time zone specification.
@@ -4753,7 +5437,11 @@ endDateTime time.Time, tDurCalcType TDurCalcType, timeZoneLocation, dateTimeFmtS
 		eTime = s2.CopyOut()
 	}
 
+	overflow := tDur.MonthEndOverflow
+
 	tDur.Empty()
+
+	tDur.MonthEndOverflow = overflow
 	tDur.StartTimeDateTz = sTime.TimeOut.CopyOut()
 	tDur.EndTimeDateTz	= eTime.TimeOut.CopyOut()
 	tDur.TimeDuration = tDur.EndTimeDateTz.DateTime.Sub(tDur.StartTimeDateTz.DateTime)
@@ -4883,8 +5571,12 @@ func (tDur *TimeDurationDto) SetStartTimeDurationCalcTz(startDateTime time.Time,
 			"dtFormat). Error='%v'", err.Error())
 	}
 
+	overflow := tDur.MonthEndOverflow
+
 	tDur.Empty()
 
+	tDur.MonthEndOverflow = overflow
+
 	if duration < 0 {
 
 		tDur.EndTimeDateTz = xTime.TimeOut.CopyOut()
@@ -5172,23 +5864,150 @@ func (tDur *TimeDurationDto) SetStartTimePlusTimeDtoCalcTz(startDateTime time.Ti
 			"dtFormat). Error='%v'", err.Error())
 	}
 
-	tDur.Empty()
-	
-	tDur.StartTimeDateTz = sDateTime.TimeOut.CopyOut()
-	
-	tDur.EndTimeDateTz, err = sDateTime.TimeOut.AddPlusTimeDto(plusTimeDto)
-	
-	tDur.TimeDuration = tDur.EndTimeDateTz.DateTime.Sub(tDur.StartTimeDateTz.DateTime)
+	overflow := tDur.MonthEndOverflow
+
+	tDur.Empty()
+
+	tDur.MonthEndOverflow = overflow
+	
+	tDur.StartTimeDateTz = sDateTime.TimeOut.CopyOut()
+	
+	tDur.EndTimeDateTz, err = sDateTime.TimeOut.AddPlusTimeDtoOverflow(plusTimeDto, overflow)
+
+	if err != nil {
+		tDur.Empty()
+		return fmt.Errorf(ePrefix + "Error returned by sDateTime.TimeOut.AddPlusTimeDtoOverflow(plusTimeDto, overflow). " +
+			"Error='%v'", err.Error())
+	}
+	
+	tDur.TimeDuration = tDur.EndTimeDateTz.DateTime.Sub(tDur.StartTimeDateTz.DateTime)
+
+	err = tDur.calcTimeDurationAllocations(tDurCalcType)
+
+	if err != nil {
+		tDur.Empty()
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcTypeSTDYEARMTH(). " +
+			"Error='%v'", err.Error())
+	}
+
+	return nil		
+}
+
+// SplitAtBoundaries - Splits the current time duration at local calendar
+// boundaries and returns the pieces as a slice of TimeDurationDto
+// instances in chronological order. The current TimeDurationDto is not
+// altered.
+//
+// Boundaries are computed from local wall clock time in the time zone
+// specified by 'timeZoneLocation'. Each piece ends where the next piece
+// begins, so the sum of the pieces equals the original duration exactly.
+// Days containing a Daylight Savings Time transition yield pieces of 23
+// or 25 hours.
+//
+// Each piece is computed with the calculation type and month end overflow
+// policy of the current TimeDurationDto. Durations computed with TDurCalcTypeWORKINGHOURS
+// cannot be split because the work schedule is not retained.
+//
+// Input Parameters:
+// =================
+//
+// unit TimeUnitType             - The calendar period at whose boundaries the
+//                                 duration is split. Valid values are:
+//                                   TimeUnitDAYS
+//                                   TimeUnitWEEKS
+//                                   TimeUnitMONTHS
+//                                   TimeUnitQUARTERS
+//                                   TimeUnitHALFYEARS
+//                                   TimeUnitYEARS
+//
+// firstWeekDay time.Weekday     - The day on which weeks begin. Only used
+//                                 when 'unit' is TimeUnitWEEKS.
+//
+// timeZoneLocation string       - The IANA time zone in which boundaries are
+//                                 computed and pieces are expressed. If
+//                                 submitted as an empty string, it defaults
+//                                 to "Etc/UTC".
+//
+// Example:
+//
+//	Duration: 2026-03-28 22:00 to 2026-04-02 03:00 Europe/Berlin
+//	pieces, err := tDur.SplitAtBoundaries(TimeUnitDAYS, time.Monday, "Europe/Berlin")
+//
+//	pieces[0]: 2026-03-28 22:00 CET  to 2026-03-29 00:00 CET   2 hours
+//	pieces[1]: 2026-03-29 00:00 CET  to 2026-03-30 00:00 CEST  23 hours
+//	pieces[2]: 2026-03-30 00:00 CEST to 2026-03-31 00:00 CEST  24 hours
+//	pieces[3]: 2026-03-31 00:00 CEST to 2026-04-01 00:00 CEST  24 hours
+//	pieces[4]: 2026-04-01 00:00 CEST to 2026-04-02 00:00 CEST  24 hours
+//	pieces[5]: 2026-04-02 00:00 CEST to 2026-04-02 03:00 CEST  3 hours
+//
+func (tDur *TimeDurationDto) SplitAtBoundaries(
+	unit TimeUnitType,
+	firstWeekDay time.Weekday,
+	timeZoneLocation string) ([]TimeDurationDto, error) {
+
+	ePrefix := "TimeDurationDto.SplitAtBoundaries() "
+
+	if tDur.CalcType == TDurCalcTypeWORKINGHOURS {
+		return nil, errors.New(ePrefix +
+			"Error: Durations computed with TDurCalcTypeWORKINGHOURS cannot be split!")
+	}
+
+	tzLoc := tDur.preProcessTimeZoneLocation(timeZoneLocation)
+
+	loc, err := time.LoadLocation(tzLoc)
+
+	if err != nil {
+		return nil, fmt.Errorf(ePrefix +
+			"Error: Input Parameter 'timeZoneLocation' is INVALID! " +
+			"timeZoneLocation='%v' Error='%v'", timeZoneLocation, err.Error())
+	}
+
+	startTime := tDur.StartTimeDateTz.DateTime.In(loc)
+	endTime := tDur.EndTimeDateTz.DateTime.In(loc)
+
+	pieces := make([]TimeDurationDto, 0, 4)
+
+	for pieceStart := startTime; ; {
+
+		_, next, err := localPeriodStart(pieceStart, unit, firstWeekDay)
+
+		if err != nil {
+			return nil, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		pieceEnd := next
+
+		if !pieceEnd.Before(endTime) {
+			pieceEnd = endTime
+		}
+
+		piece, err := TimeDurationDto{}.NewStartEndTimesCalcTz(
+			pieceStart,
+			pieceEnd,
+			tDur.CalcType,
+			tzLoc,
+			tDur.StartTimeDateTz.DateTimeFmt)
+
+		if err != nil {
+			return nil, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+
+		err = piece.SetMonthEndOverflow(tDur.MonthEndOverflow)
 
-	err = tDur.calcTimeDurationAllocations(tDurCalcType)
+		if err != nil {
+			return nil, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
 
-	if err != nil {
-		tDur.Empty()
-		return fmt.Errorf(ePrefix + "Error returned by tDur.calcTypeSTDYEARMTH(). " +
-			"Error='%v'", err.Error())
+		pieces = append(pieces, piece)
+
+		if !pieceEnd.Before(endTime) {
+			break
+		}
+
+		pieceStart = pieceEnd
 	}
 
-	return nil		
+	return pieces, nil
 }
 
 // calcTimeDurationAllocations - Examines the input parameter 'calcType' and
@@ -5224,6 +6043,22 @@ func (tDur *TimeDurationDto) calcTimeDurationAllocations(calcType TDurCalcType)
 	case TDurCalcTypeGregorianYrs :
 		return tDur.calcTypeGregorianYears()
 
+	case TDurCalcTypeWORKINGHOURS :
+		return fmt.Errorf(ePrefix + "Error: TDurCalcTypeWORKINGHOURS requires a work schedule. " +
+			"Use TimeDurationDto.NewStartEndWorkingTime() or TimeDurationDto.NewStartWorkingDuration().")
+
+	case TDurCalcTypeFWDANNIVERSARY, TDurCalcTypeBWDANNIVERSARY, TDurCalcTypeEOMANNIVERSARY :
+		return tDur.calcTypeANNIVERSARY(calcType)
+
+	case TDurCalcType30DAYMONTHS :
+		return tDur.calcType30DAYMONTHS()
+
+	case TDurCalcTypeCUMQUARTERS :
+		return tDur.calcTypeCUMQUARTERS()
+
+	case TDurCalcTypeCUMHALFYEARS :
+		return tDur.calcTypeCUMHALFYEARS()
+
 	default:
 		return fmt.Errorf(ePrefix + "Error: Invalid TDurCalcType. calcType='%v'", calcType.String())
 	}
@@ -5405,7 +6240,7 @@ func (tDur *TimeDurationDto) calcTypeCUMMINUTES() error {
 
 	if rd >= MicroSecondNanoseconds {
 		tDur.Microseconds = rd / MicroSecondNanoseconds
-		tDur.MillisecondsNanosecs = tDur.Microseconds * MicroSecondNanoseconds
+		tDur.MicrosecondsNanosecs = tDur.Microseconds * MicroSecondNanoseconds
 		rd -= tDur.MicrosecondsNanosecs
 	}
 
@@ -5619,6 +6454,382 @@ func (tDur *TimeDurationDto) calcTypeCUMWEEKS() error {
 	return nil
 }
 
+// calcTypeWORKINGHOURS - Allocates working time. The working time must be
+// assigned to 'tDur.TimeDuration' before calling this method. Working time
+// is allocated over cumulative hours plus minutes, seconds, milliseconds,
+// microseconds and nanoseconds.
+func (tDur *TimeDurationDto) calcTypeWORKINGHOURS() error {
+
+	ePrefix := "TimeDurationDto.calcTypeWORKINGHOURS() "
+
+	err := tDur.calcTypeCUMHours()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	tDur.CalcType = TDurCalcTypeWORKINGHOURS
+
+	return nil
+}
+
+// calcTypeANNIVERSARY - Allocates years and months by anniversary for calculation
+// types 'TDurCalcTypeFWDANNIVERSARY', 'TDurCalcTypeBWDANNIVERSARY' and
+// 'TDurCalcTypeEOMANNIVERSARY'. The remainder is allocated over weeks, week days,
+// date days, hours, minutes, seconds, milliseconds, microseconds and nanoseconds.
+func (tDur *TimeDurationDto) calcTypeANNIVERSARY(calcType TDurCalcType) error {
+
+	ePrefix := "TimeDurationDto.calcTypeANNIVERSARY() "
+
+	tDur.EmptyTimeFields()
+
+	tDur.CalcType = calcType
+
+	err := tDur.calcAnniversaryYearsMonths()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcAnniversaryYearsMonths(). Error='%v'", err.Error())
+	}
+
+	err = tDur.calcDateDaysWeeksFromDuration()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcDateDaysWeeksFromDuration(). Error='%v'", err.Error())
+	}
+
+	err = tDur.calcHoursMinSecs()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcHoursMinSecs(). Error='%v'", err.Error())
+	}
+
+	err = tDur.calcNanoseconds()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcNanoseconds(). Error='%v'", err.Error())
+	}
+
+	err = tDur.calcSummaryTimeElements()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcSummaryTimeElements(). Error='%v'", err.Error())
+	}
+
+	return nil
+}
+
+// calcAnniversaryYearsMonths - Calculates 'tDur.Years', 'tDur.YearsNanosecs',
+// 'tDur.Months' and 'tDur.MonthsNanosecs' by counting anniversaries of the
+// starting date time or, for 'TDurCalcTypeBWDANNIVERSARY', of the ending
+// date time. 'tDur.CalcType' must be set before calling this method.
+//
+// NOTE:	Before calling this method, ensure that tDur.StartTimeDateTz,
+//				tDur.EndTimeDateTz and tDur.TimeDuration are properly initialized.
+//
+func (tDur *TimeDurationDto) calcAnniversaryYearsMonths() error {
+
+	ePrefix := "TimeDurationDto.calcAnniversaryYearsMonths() "
+
+	startTime := tDur.StartTimeDateTz.DateTime
+	endTime := tDur.EndTimeDateTz.DateTime
+
+	if endTime.Before(startTime) {
+		return errors.New(ePrefix + "Error: 'endTime' precedes, is less than, startTime!")
+	}
+
+	if startTime.Location().String() != endTime.Location().String() {
+		return fmt.Errorf(ePrefix + "Error: 'startTime' and 'endTime' Time Zone Location do NOT match! " +
+			"startTimeZoneLocation='%v'  endTimeZoneLocation='%v'",
+			startTime.Location().String(), endTime.Location().String())
+	}
+
+	tDur.Years = 0
+	tDur.YearsNanosecs = 0
+	tDur.Months = 0
+	tDur.MonthsNanosecs = 0
+
+	if tDur.TimeDuration == 0 {
+		return nil
+	}
+
+	anchor := startTime
+	direction := 1
+	overflow := tDur.MonthEndOverflow
+	isEndOfMonth := false
+
+	switch tDur.CalcType {
+
+	case TDurCalcTypeBWDANNIVERSARY :
+		anchor = endTime
+		direction = -1
+		overflow = MonthEndOverflowCLAMP
+
+	case TDurCalcTypeEOMANNIVERSARY :
+		overflow = MonthEndOverflowCLAMP
+		isEndOfMonth = startTime.AddDate(0, 0, 1).Day() == 1
+	}
+
+	// isWithin - Returns 'true' if the anniversary 'months' months from
+	// 'anchor' lies between startTime and endTime.
+	isWithin := func(months int) bool {
+
+		anniversary := anniversaryDate(anchor, direction*months, isEndOfMonth, overflow)
+
+		if direction < 0 {
+			return !anniversary.Before(startTime)
+		}
+
+		return !anniversary.After(endTime)
+	}
+
+	months := (endTime.Year()-startTime.Year())*12 + int(endTime.Month()) - int(startTime.Month())
+
+	if months < 0 {
+		months = 0
+	}
+
+	for months > 0 && !isWithin(months) {
+		months--
+	}
+
+	for isWithin(months + 1) {
+		months++
+	}
+
+	tDur.Years = int64(months / 12)
+	tDur.Months = int64(months % 12)
+
+	yearDateTime := anniversaryDate(anchor, direction*int(tDur.Years)*12, isEndOfMonth, overflow)
+	mthDateTime := anniversaryDate(anchor, direction*months, isEndOfMonth, overflow)
+
+	if direction < 0 {
+		tDur.YearsNanosecs = int64(anchor.Sub(yearDateTime))
+		tDur.MonthsNanosecs = int64(yearDateTime.Sub(mthDateTime))
+	} else {
+		tDur.YearsNanosecs = int64(yearDateTime.Sub(anchor))
+		tDur.MonthsNanosecs = int64(mthDateTime.Sub(yearDateTime))
+	}
+
+	return nil
+}
+
+// calcType30DAYMONTHS - Allocates the 30E/360 day count between the starting
+// and ending dates over 360 day years, 30 day months, weeks, week days and date
+// days. The difference in wall clock time of day is allocated over hours,
+// minutes, seconds, milliseconds, microseconds and nanoseconds.
+func (tDur *TimeDurationDto) calcType30DAYMONTHS() error {
+
+	ePrefix := "TimeDurationDto.calcType30DAYMONTHS() "
+
+	tDur.EmptyTimeFields()
+
+	tDur.CalcType = TDurCalcType30DAYMONTHS
+
+	startTime := tDur.StartTimeDateTz.DateTime
+	endTime := tDur.EndTimeDateTz.DateTime
+
+	if endTime.Before(startTime) {
+		return errors.New(ePrefix + "Error: 'endTime' precedes, is less than, startTime!")
+	}
+
+	if startTime.Location().String() != endTime.Location().String() {
+		return fmt.Errorf(ePrefix + "Error: 'startTime' and 'endTime' Time Zone Location do NOT match! " +
+			"startTimeZoneLocation='%v'  endTimeZoneLocation='%v'",
+			startTime.Location().String(), endTime.Location().String())
+	}
+
+	if tDur.TimeDuration == 0 {
+		return nil
+	}
+
+	loc := startTime.Location()
+
+	days := dayCount30360(dayCountCivilDate(startTime, loc), dayCountCivilDate(endTime, loc),
+		DayCount30E360, false, time.Time{})
+
+	rd := wallClockNanosecs(endTime) - wallClockNanosecs(startTime)
+
+	if rd < 0 {
+		days--
+		rd += DayNanoSeconds
+	}
+
+	if days < 0 {
+		// Example: 30th 12:00 to 31st 06:00. Day 31 counts as day 30.
+		days = 0
+		rd = int64(tDur.TimeDuration)
+	}
+
+	tDur.Years = days / 360
+	tDur.YearsNanosecs = tDur.Years * 360 * DayNanoSeconds
+
+	tDur.Months = (days % 360) / 30
+	tDur.MonthsNanosecs = tDur.Months * 30 * DayNanoSeconds
+
+	tDur.DateDays = days % 30
+	tDur.DateDaysNanosecs = tDur.DateDays * DayNanoSeconds
+
+	tDur.Weeks = tDur.DateDays / 7
+	tDur.WeeksNanosecs = tDur.Weeks * WeekNanoSeconds
+
+	tDur.WeekDays = tDur.DateDays - (tDur.Weeks * 7)
+	tDur.WeekDaysNanosecs = tDur.WeekDays * DayNanoSeconds
+
+	tDur.Hours = rd / HourNanoSeconds
+	tDur.HoursNanosecs = tDur.Hours * HourNanoSeconds
+	rd -= tDur.HoursNanosecs
+
+	tDur.Minutes = rd / MinuteNanoSeconds
+	tDur.MinutesNanosecs = tDur.Minutes * MinuteNanoSeconds
+	rd -= tDur.MinutesNanosecs
+
+	tDur.Seconds = rd / SecondNanoseconds
+	tDur.SecondsNanosecs = tDur.Seconds * SecondNanoseconds
+	rd -= tDur.SecondsNanosecs
+
+	tDur.Milliseconds = rd / MilliSecondNanoseconds
+	tDur.MillisecondsNanosecs = tDur.Milliseconds * MilliSecondNanoseconds
+	rd -= tDur.MillisecondsNanosecs
+
+	tDur.Microseconds = rd / MicroSecondNanoseconds
+	tDur.MicrosecondsNanosecs = tDur.Microseconds * MicroSecondNanoseconds
+	rd -= tDur.MicrosecondsNanosecs
+
+	tDur.Nanoseconds = rd
+
+	err := tDur.calcSummaryTimeElements()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcSummaryTimeElements(). Error='%v'", err.Error())
+	}
+
+	return nil
+}
+
+// calcTypeCUMQUARTERS - Calculates Cumulative Quarters. Years are ignored.
+// Years and Months are consolidated and counted as cumulative quarters of
+// three months each. Months remaining after the last whole quarter are
+// allocated to 'tDur.Months'. The Data Fields for Years and Half-Years are
+// set to zero.
+func (tDur *TimeDurationDto) calcTypeCUMQUARTERS() error {
+
+	ePrefix := "TimeDurationDto.calcTypeCUMQUARTERS() "
+
+	tDur.EmptyTimeFields()
+
+	tDur.CalcType = TDurCalcTypeCUMQUARTERS
+
+	err := tDur.calcMonthsFromDuration()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcMonthsFromDuration(). Error='%v'", err.Error())
+	}
+
+	tDur.Quarters, tDur.QuartersNanosecs = tDur.calcPeriodsFromMonths(3)
+
+	err = tDur.calcCumPeriodRemainder()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return nil
+}
+
+// calcTypeCUMHALFYEARS - Calculates Cumulative Half-Years. Years are ignored.
+// Years and Months are consolidated and counted as cumulative half-years of
+// six months each. Months remaining after the last whole half-year are
+// allocated to 'tDur.Months'. The Data Fields for Years and Quarters are set
+// to zero.
+func (tDur *TimeDurationDto) calcTypeCUMHALFYEARS() error {
+
+	ePrefix := "TimeDurationDto.calcTypeCUMHALFYEARS() "
+
+	tDur.EmptyTimeFields()
+
+	tDur.CalcType = TDurCalcTypeCUMHALFYEARS
+
+	err := tDur.calcMonthsFromDuration()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcMonthsFromDuration(). Error='%v'", err.Error())
+	}
+
+	tDur.HalfYears, tDur.HalfYearsNanosecs = tDur.calcPeriodsFromMonths(6)
+
+	err = tDur.calcCumPeriodRemainder()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return nil
+}
+
+// calcPeriodsFromMonths - Converts cumulative months allocated by
+// 'calcMonthsFromDuration' into whole periods of 'periodMonths' months.
+// Returns the number of periods and the nanoseconds they represent.
+// 'tDur.Months' and 'tDur.MonthsNanosecs' are reduced to the months
+// remaining after the last whole period.
+//
+// NOTE:	Before calling this method, ensure that tDur.Years is zero and
+//				that TimeDurationDto.calcMonthsFromDuration has been called.
+//
+func (tDur *TimeDurationDto) calcPeriodsFromMonths(periodMonths int64) (int64, int64) {
+
+	periods := tDur.Months / periodMonths
+
+	if periods == 0 {
+		return 0, 0
+	}
+
+	startTime := tDur.StartTimeDateTz.DateTime
+
+	periodDateTime := tDur.addAllocatedMonths(startTime, startTime, int(periods*periodMonths))
+
+	periodNanosecs := int64(periodDateTime.Sub(startTime))
+
+	tDur.Months -= periods * periodMonths
+	tDur.MonthsNanosecs -= periodNanosecs
+
+	return periods, periodNanosecs
+}
+
+// calcCumPeriodRemainder - Allocates the duration remaining after
+// cumulative quarters or half-years and months over weeks, week days,
+// date days, hours, minutes, seconds, milliseconds, microseconds and
+// nanoseconds.
+func (tDur *TimeDurationDto) calcCumPeriodRemainder() error {
+
+	ePrefix := "TimeDurationDto.calcCumPeriodRemainder() "
+
+	err := tDur.calcDateDaysWeeksFromDuration()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcDateDaysWeeksFromDuration(). Error='%v'", err.Error())
+	}
+
+	err = tDur.calcHoursMinSecs()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcHoursMinSecs(). Error='%v'", err.Error())
+	}
+
+	err = tDur.calcNanoseconds()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcNanoseconds(). Error='%v'", err.Error())
+	}
+
+	err = tDur.calcSummaryTimeElements()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tDur.calcSummaryTimeElements(). Error='%v'", err.Error())
+	}
+
+	return nil
+}
+
 // calcYearsFromDuration - Calculates number of years duration and nanoseconds
 // represented by years duration using input parameters 'tDur.StartTimeDateTz' and
 // 'tDur.EndTimeDateTz'.  
@@ -5653,7 +6864,7 @@ func (tDur *TimeDurationDto) calcYearsFromDuration() error {
 
 		i++
 
-		yearDateTime = startTime.AddDate(i, 0, 0)
+		yearDateTime = addYearsMonthsOverflow(startTime, i, 0, tDur.MonthEndOverflow)
 
 	}
 
@@ -5663,7 +6874,7 @@ func (tDur *TimeDurationDto) calcYearsFromDuration() error {
 
 		years = int64(i)
 
-		yearDateTime = startTime.AddDate(i, 0, 0)
+		yearDateTime = addYearsMonthsOverflow(startTime, i, 0, tDur.MonthEndOverflow)
 
 		duration := yearDateTime.Sub(startTime)
 
@@ -5729,7 +6940,7 @@ func (tDur *TimeDurationDto) calcMonthsFromDuration() error {
 
 		i++
 
-		mthDateTime = yearDateTime.AddDate(0,i,0)
+		mthDateTime = tDur.addAllocatedMonths(startTime, yearDateTime, i)
 
 	}
 
@@ -5739,7 +6950,7 @@ func (tDur *TimeDurationDto) calcMonthsFromDuration() error {
 
 		tDur.Months = int64(i)
 
-		mthDateTime = yearDateTime.AddDate( 0, i, 0)
+		mthDateTime = tDur.addAllocatedMonths(startTime, yearDateTime, i)
 
 		tDur.MonthsNanosecs = int64(mthDateTime.Sub(yearDateTime))
 
@@ -5751,6 +6962,24 @@ func (tDur *TimeDurationDto) calcMonthsFromDuration() error {
 	return nil
 }
 
+// addAllocatedMonths - Returns the date time which lies 'months' months
+// after the years allocated from 'startTime'. 'yearDateTime' is the date
+// time following the allocated years.
+//
+// With MonthEndOverflowNORMALIZE the months are added to 'yearDateTime' as
+// computed by time.AddDate(). Otherwise the allocated years and months are
+// added to 'startTime' in a single step so that the month end overflow
+// policy is applied only once. Adding the allocated years and months to
+// 'startTime' with the same policy then reproduces the allocation.
+func (tDur *TimeDurationDto) addAllocatedMonths(startTime, yearDateTime time.Time, months int) time.Time {
+
+	if tDur.MonthEndOverflow == MonthEndOverflowNORMALIZE {
+		return yearDateTime.AddDate(0, months, 0)
+	}
+
+	return addYearsMonthsOverflow(startTime, int(tDur.Years), months, tDur.MonthEndOverflow)
+}
+
 // calcDateDaysWeeksFromDuration - Calculates the Days associated
 // with the duration for this TimeDurationDto. 
 //
@@ -5773,7 +7002,8 @@ func (tDur *TimeDurationDto) calcDateDaysWeeksFromDuration() error {
 		return nil
 	}
 
-	rd -= tDur.YearsNanosecs + tDur.MonthsNanosecs
+	rd -= tDur.YearsNanosecs + tDur.HalfYearsNanosecs + tDur.QuartersNanosecs +
+		tDur.MonthsNanosecs
 
 	// Calculate DateDays
 	tDur.DateDays = 0
@@ -5831,11 +7061,11 @@ func (tDur *TimeDurationDto) calcHoursMinSecs() error {
 	}
 
 	if tDur.DateDays > 0 {
-		rd -= tDur.YearsNanosecs + tDur.MonthsNanosecs +
-			tDur.DateDaysNanosecs
+		rd -= tDur.YearsNanosecs + tDur.HalfYearsNanosecs + tDur.QuartersNanosecs +
+			tDur.MonthsNanosecs + tDur.DateDaysNanosecs
 	} else {
-		rd -= tDur.YearsNanosecs + tDur.MonthsNanosecs +
-			tDur.WeeksNanosecs + tDur.WeekDaysNanosecs
+		rd -= tDur.YearsNanosecs + tDur.HalfYearsNanosecs + tDur.QuartersNanosecs +
+			tDur.MonthsNanosecs + tDur.WeeksNanosecs + tDur.WeekDaysNanosecs
 	}
 
 	tDur.Hours 						= 0
@@ -5890,7 +7120,8 @@ func (tDur *TimeDurationDto) calcNanoseconds() error {
 		return nil
 	}
 
-	rd -= tDur.YearsNanosecs + tDur.MonthsNanosecs
+	rd -= tDur.YearsNanosecs + tDur.HalfYearsNanosecs + tDur.QuartersNanosecs +
+		tDur.MonthsNanosecs
 
 	if tDur.DateDaysNanosecs > 0 {
 		rd -= tDur.DateDaysNanosecs
@@ -5952,6 +7183,8 @@ func (tDur *TimeDurationDto) calcSummaryTimeElements() error {
 	tDur.TotSubSecNanoseconds = 0
 
 	tDur.TotDateNanoseconds = tDur.YearsNanosecs
+	tDur.TotDateNanoseconds += tDur.HalfYearsNanosecs
+	tDur.TotDateNanoseconds += tDur.QuartersNanosecs
 	tDur.TotDateNanoseconds += tDur.MonthsNanosecs
 
 	if tDur.DateDaysNanosecs == 0  {
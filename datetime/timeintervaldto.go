@@ -0,0 +1,292 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+/*
+ TimeIntervalDto
+ ===============
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\timeintervaldto.go
+
+ Overview and Usage
+ ==================
+ The 'TimeIntervalDto' Type models a half-open time interval:
+
+		[StartTime, EndTime)
+
+ The interval includes 'StartTime' and excludes 'EndTime'. As a result,
+ two intervals where the first ends at the moment the second starts are
+ adjacent, but do NOT overlap. An interval with equal start and end times
+ is empty. An empty interval contains no instants and overlaps nothing.
+
+ Comparisons are based on instants, not wall clock times. The start and
+ end times retain their own time zones.
+
+ Example:
+
+	shift, err := TimeIntervalDto{}.New(shiftStartDtz, shiftEndDtz)
+
+	if shift.Contains(outageDtz) {
+		...
+	}
+
+ Collections of intervals are normalized and merged by type 'IntervalSet'.
+ See source file intervalset.go.
+
+*/
+
+// TimeIntervalDto - A half-open time interval [StartTime, EndTime)
+type TimeIntervalDto struct {
+	StartTime DateTzDto // Inclusive starting date time
+	EndTime   DateTzDto // Exclusive ending date time
+}
+
+// Contains - Returns 'true' if 'dateTime' falls within the current
+// interval. 'StartTime' is included; 'EndTime' is excluded.
+func (tInterval *TimeIntervalDto) Contains(dateTime DateTzDto) bool {
+
+	t := dateTime.DateTime
+
+	return !t.Before(tInterval.StartTime.DateTime) && t.Before(tInterval.EndTime.DateTime)
+}
+
+// ContainsInterval - Returns 'true' if every instant of interval 'other'
+// falls within the current interval. An empty interval is contained if
+// its start time falls within [StartTime, EndTime].
+func (tInterval *TimeIntervalDto) ContainsInterval(other TimeIntervalDto) bool {
+
+	return !other.StartTime.DateTime.Before(tInterval.StartTime.DateTime) &&
+		!other.EndTime.DateTime.After(tInterval.EndTime.DateTime)
+}
+
+// CopyOut - Returns a deep copy of the current TimeIntervalDto.
+func (tInterval *TimeIntervalDto) CopyOut() TimeIntervalDto {
+
+	return TimeIntervalDto{
+		StartTime: tInterval.StartTime.CopyOut(),
+		EndTime:   tInterval.EndTime.CopyOut(),
+	}
+}
+
+// Difference - Returns the portions of the current interval which are
+// not covered by interval 'other'. Zero, one or two intervals are
+// returned in ascending order.
+func (tInterval *TimeIntervalDto) Difference(other TimeIntervalDto) []TimeIntervalDto {
+
+	if tInterval.IsEmpty() {
+		return []TimeIntervalDto{}
+	}
+
+	if !tInterval.Overlaps(other) {
+		return []TimeIntervalDto{tInterval.CopyOut()}
+	}
+
+	result := make([]TimeIntervalDto, 0, 2)
+
+	if tInterval.StartTime.DateTime.Before(other.StartTime.DateTime) {
+		result = append(result,
+			TimeIntervalDto{StartTime: tInterval.StartTime.CopyOut(), EndTime: other.StartTime.CopyOut()})
+	}
+
+	if other.EndTime.DateTime.Before(tInterval.EndTime.DateTime) {
+		result = append(result,
+			TimeIntervalDto{StartTime: other.EndTime.CopyOut(), EndTime: tInterval.EndTime.CopyOut()})
+	}
+
+	return result
+}
+
+// Equal - Returns 'true' if the current interval and interval 'other'
+// have the same start and end instants.
+func (tInterval *TimeIntervalDto) Equal(other TimeIntervalDto) bool {
+
+	return tInterval.StartTime.DateTime.Equal(other.StartTime.DateTime) &&
+		tInterval.EndTime.DateTime.Equal(other.EndTime.DateTime)
+}
+
+// Gap - Returns the interval separating the current interval and interval
+// 'other'. If the intervals overlap or are adjacent, there is no gap and
+// the boolean return value is 'false'.
+func (tInterval *TimeIntervalDto) Gap(other TimeIntervalDto) (TimeIntervalDto, bool) {
+
+	if tInterval.EndTime.DateTime.Before(other.StartTime.DateTime) {
+		return TimeIntervalDto{StartTime: tInterval.EndTime.CopyOut(), EndTime: other.StartTime.CopyOut()}, true
+	}
+
+	if other.EndTime.DateTime.Before(tInterval.StartTime.DateTime) {
+		return TimeIntervalDto{StartTime: other.EndTime.CopyOut(), EndTime: tInterval.StartTime.CopyOut()}, true
+	}
+
+	return TimeIntervalDto{}, false
+}
+
+// GetDuration - Returns the length of the current interval.
+func (tInterval *TimeIntervalDto) GetDuration() time.Duration {
+
+	return tInterval.EndTime.DateTime.Sub(tInterval.StartTime.DateTime)
+}
+
+// GetTimeDurationDto - Converts the current interval to a TimeDurationDto
+// using the time duration calculation type 'tDurCalcType'. The duration is
+// calculated in the time zone of 'StartTime'.
+//
+// Input Parameters:
+// =================
+//
+// tDurCalcType TDurCalcType  - Specifies the calculation type used to
+//                              allocate the duration. Example:
+//                              TDurCalcTypeSTDYEARMTH, TDurCalcTypeCUMDAYS
+//
+// dateTimeFmtStr string      - A date time format string. If submitted as an
+//                              empty string, a default format is applied.
+//
+func (tInterval *TimeIntervalDto) GetTimeDurationDto(
+	tDurCalcType TDurCalcType,
+	dateTimeFmtStr string) (TimeDurationDto, error) {
+
+	ePrefix := "TimeIntervalDto.GetTimeDurationDto() "
+
+	tDur, err := TimeDurationDto{}.NewStartEndDateTzDtoCalcTz(
+		tInterval.StartTime,
+		tInterval.EndTime,
+		tDurCalcType,
+		tInterval.StartTime.TimeZone.LocationName,
+		dateTimeFmtStr)
+
+	if err != nil {
+		return TimeDurationDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return tDur, nil
+}
+
+// Intersection - Returns the interval common to the current interval and
+// interval 'other'. If the intervals do not overlap, the boolean return
+// value is 'false'.
+func (tInterval *TimeIntervalDto) Intersection(other TimeIntervalDto) (TimeIntervalDto, bool) {
+
+	if !tInterval.Overlaps(other) {
+		return TimeIntervalDto{}, false
+	}
+
+	result := tInterval.CopyOut()
+
+	if other.StartTime.DateTime.After(result.StartTime.DateTime) {
+		result.StartTime = other.StartTime.CopyOut()
+	}
+
+	if other.EndTime.DateTime.Before(result.EndTime.DateTime) {
+		result.EndTime = other.EndTime.CopyOut()
+	}
+
+	return result, true
+}
+
+// IsAdjacent - Returns 'true' if the current interval ends at the instant
+// interval 'other' starts, or 'other' ends at the instant the current
+// interval starts.
+func (tInterval *TimeIntervalDto) IsAdjacent(other TimeIntervalDto) bool {
+
+	return tInterval.EndTime.DateTime.Equal(other.StartTime.DateTime) ||
+		other.EndTime.DateTime.Equal(tInterval.StartTime.DateTime)
+}
+
+// IsEmpty - Returns 'true' if the start and end times of the current
+// interval are equal.
+func (tInterval *TimeIntervalDto) IsEmpty() bool {
+
+	return !tInterval.StartTime.DateTime.Before(tInterval.EndTime.DateTime)
+}
+
+// New - Creates a new TimeIntervalDto. An error is returned if 'endTime'
+// is earlier than 'startTime'.
+func (tInterval TimeIntervalDto) New(startTime, endTime DateTzDto) (TimeIntervalDto, error) {
+
+	ePrefix := "TimeIntervalDto.New() "
+
+	if startTime.DateTime.IsZero() || endTime.DateTime.IsZero() {
+		return TimeIntervalDto{}, errors.New(ePrefix + "Error: 'startTime' and 'endTime' must be initialized!")
+	}
+
+	if endTime.DateTime.Before(startTime.DateTime) {
+		return TimeIntervalDto{}, fmt.Errorf(ePrefix +
+			"Error: 'endTime' is earlier than 'startTime'. startTime='%v' endTime='%v'",
+			startTime.String(), endTime.String())
+	}
+
+	return TimeIntervalDto{StartTime: startTime.CopyOut(), EndTime: endTime.CopyOut()}, nil
+}
+
+// NewStartDuration - Creates a new TimeIntervalDto beginning at
+// 'startTime' and extending for 'duration'. 'duration' must not be
+// negative. The end time is expressed in the time zone of 'startTime'.
+func (tInterval TimeIntervalDto) NewStartDuration(startTime DateTzDto, duration time.Duration) (TimeIntervalDto, error) {
+
+	ePrefix := "TimeIntervalDto.NewStartDuration() "
+
+	if duration < 0 {
+		return TimeIntervalDto{}, fmt.Errorf(ePrefix + "Error: 'duration' is negative. duration='%v'", duration)
+	}
+
+	endTime, err := DateTzDto{}.New(startTime.DateTime.Add(duration), startTime.DateTimeFmt)
+
+	if err != nil {
+		return TimeIntervalDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return TimeIntervalDto{}.New(startTime, endTime)
+}
+
+// Overlaps - Returns 'true' if the current interval and interval 'other'
+// share at least one instant. Adjacent intervals do not overlap. Empty
+// intervals overlap nothing.
+func (tInterval *TimeIntervalDto) Overlaps(other TimeIntervalDto) bool {
+
+	if tInterval.IsEmpty() || other.IsEmpty() {
+		return false
+	}
+
+	return tInterval.StartTime.DateTime.Before(other.EndTime.DateTime) &&
+		other.StartTime.DateTime.Before(tInterval.EndTime.DateTime)
+}
+
+// String - Returns the current interval formatted as "[StartTime, EndTime)".
+func (tInterval TimeIntervalDto) String() string {
+
+	return "[" + tInterval.StartTime.String() + ", " + tInterval.EndTime.String() + ")"
+}
+
+// Union - Returns the interval covering both the current interval and
+// interval 'other'. An error is returned if the intervals neither overlap
+// nor are adjacent, since the union would not be a single interval. Use
+// type 'IntervalSet' to combine disjoint intervals.
+func (tInterval *TimeIntervalDto) Union(other TimeIntervalDto) (TimeIntervalDto, error) {
+
+	ePrefix := "TimeIntervalDto.Union() "
+
+	if !tInterval.Overlaps(other) && !tInterval.IsAdjacent(other) {
+		return TimeIntervalDto{}, fmt.Errorf(ePrefix +
+			"Error: The intervals are disjoint. interval='%v' other='%v'",
+			tInterval.String(), other.String())
+	}
+
+	result := tInterval.CopyOut()
+
+	if other.StartTime.DateTime.Before(result.StartTime.DateTime) {
+		result.StartTime = other.StartTime.CopyOut()
+	}
+
+	if other.EndTime.DateTime.After(result.EndTime.DateTime) {
+		result.EndTime = other.EndTime.CopyOut()
+	}
+
+	return result, nil
+}
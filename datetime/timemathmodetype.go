@@ -0,0 +1,125 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+ TimeMathModeType
+ ================
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\timemathmodetype.go
+
+ Overview and Usage
+ ==================
+ 'TimeMathModeType' is an enumeration of the arithmetic modes used when
+ days and time components are added to a date time in a time zone which
+ observes daylight savings time.
+
+	TimeMathABSOLUTE  - Days and time components are added as elapsed
+	                    time. A day is exactly 24 hours. Across a
+	                    daylight savings transition the local wall clock
+	                    time of the result shifts by the size of the
+	                    transition.
+
+	                    2026-03-07 09:00 CST + 1 day = 2026-03-08 10:00 CDT
+
+	TimeMathWALLCLOCK - Days and time components are added to the local
+	                    wall clock reading. The result is the instant at
+	                    which the local clock shows the computed reading.
+
+	                    2026-03-07 09:00 CST + 1 day = 2026-03-08 09:00 CDT
+	                    2026-03-07 09:00 CST + 24 hours = 2026-03-08 09:00 CDT
+
+ In both modes, years and months are calendar units which are added to
+ the local date before days and time components are added.
+
+ In TimeMathWALLCLOCK mode, a wall clock reading which falls in a
+ daylight savings gap resolves to the first instant after the gap. A
+ reading which occurs twice during a daylight savings overlap resolves
+ to the first occurrence.
+
+ TimeMathABSOLUTE is the zero value. Methods which do not accept an
+ arithmetic mode use TimeMathABSOLUTE.
+
+*/
+
+// TimeMathModeType - Specifies whether days and time components are added
+// as elapsed time or to the local wall clock reading.
+type TimeMathModeType int
+
+// String - Returns a string equivalent to the
+// integer value of TimeMathModeType
+func (mode TimeMathModeType) String() string {
+
+	if mode < 0 || int(mode) >= len(TimeMathModeTypeLabels) {
+		return ""
+	}
+
+	return TimeMathModeTypeLabels[mode]
+}
+
+// IsValid - Returns 'true' if the current TimeMathModeType
+// identifies a valid arithmetic mode.
+func (mode TimeMathModeType) IsValid() bool {
+
+	if mode < 0 || int(mode) >= len(TimeMathModeTypeLabels) {
+		return false
+	}
+
+	return true
+}
+
+// Time Math Modes
+const (
+
+	// TimeMathABSOLUTE - Days and time components are added as
+	// elapsed time. A day is 24 hours.
+	TimeMathABSOLUTE TimeMathModeType = iota
+
+	// TimeMathWALLCLOCK - Days and time components are added to
+	// the local wall clock reading.
+	TimeMathWALLCLOCK
+)
+
+// TimeMathModeTypeLabels - Text Names associated with
+// TimeMathModeType types.
+var TimeMathModeTypeLabels = [...]string{"Absolute", "WallClock"}
+
+// addTimeMathMode - Adds 'years' and 'months' to the local date of 't',
+// applies month end overflow policy 'overflow', then adds 'days' and
+// 'nanosecs' according to arithmetic mode 'mode'. The result is expressed
+// in the time zone of 't'.
+func addTimeMathMode(t time.Time, years, months, days int, nanosecs int64,
+	mode TimeMathModeType, overflow MonthEndOverflowType) time.Time {
+
+	if mode != TimeMathWALLCLOCK {
+
+		return addYearsMonthsOverflow(t, years, months, overflow).
+			Add(time.Duration(int64(days)*DayNanoSeconds + nanosecs))
+	}
+
+	wall := addYearsMonthsOverflow(wallClockAsUTC(t), years, months, overflow)
+
+	wall = wall.AddDate(0, 0, days).Add(time.Duration(nanosecs))
+
+	year, month, day := wall.Date()
+
+	return resolveWallClock(year, month, day, wallClockNanosecs(wall), t.Location())[0]
+}
+
+// validateTimeMathMode - Returns an error if 'mode' is not a
+// valid TimeMathModeType.
+func validateTimeMathMode(mode TimeMathModeType) error {
+
+	if !mode.IsValid() {
+		return fmt.Errorf("Error: Invalid time math mode. TimeMathModeType='%v'", int(mode))
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package datetime
+
+import (
+	"fmt"
+)
+
+/*
+ TimeUnitType
+ ============
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\timeunittype.go
+
+ Overview and Usage
+ ==================
+ 'TimeUnitType' is an enumeration of the time units used throughout
+ the 'datetime' package. Time units are used to specify the largest
+ and smallest elements displayed by duration formatters and the
+ granularity of duration and date time calculations.
+
+*/
+
+// TimeUnitType - Identifies a unit of time ranging from
+// nanoseconds through years.
+type TimeUnitType int
+
+// String - Returns a string equivalent to the
+// integer value of TimeUnitType
+func (tUnit TimeUnitType) String() string {
+
+	if tUnit < 0 || int(tUnit) >= len(TimeUnitTypeLabels) {
+		return ""
+	}
+
+	return TimeUnitTypeLabels[tUnit]
+}
+
+// IsValid - Returns 'true' if the current TimeUnitType
+// identifies a valid time unit. 'TimeUnitNONE' is NOT
+// a valid time unit.
+func (tUnit TimeUnitType) IsValid() bool {
+
+	if tUnit <= TimeUnitNONE || int(tUnit) >= len(TimeUnitTypeLabels) {
+		return false
+	}
+
+	return true
+}
+
+// calendarMonths - Returns the number of calendar months in one unit
+// of the current TimeUnitType. Returns zero for units which are not
+// measured in calendar months (Nanoseconds through Weeks).
+func (tUnit TimeUnitType) calendarMonths() int {
+
+	switch tUnit {
+	case TimeUnitMONTHS:
+		return 1
+	case TimeUnitQUARTERS:
+		return 3
+	case TimeUnitHALFYEARS:
+		return 6
+	case TimeUnitYEARS:
+		return 12
+	}
+
+	return 0
+}
+
+// nanoseconds - Returns the number of nanoseconds in one unit of
+// the current TimeUnitType. Days are 24-hour days. Months, Quarters,
+// Half-Years and Years are computed from the average Gregorian Year
+// of 365.2425 days. A Gregorian Month is one twelfth of a Gregorian
+// Year, 30.436875 days.
+func (tUnit TimeUnitType) nanoseconds() (int64, error) {
+
+	switch tUnit {
+	case TimeUnitNANOSECONDS:
+		return 1, nil
+	case TimeUnitMICROSECONDS:
+		return MicroSecondNanoseconds, nil
+	case TimeUnitMILLISECONDS:
+		return MilliSecondNanoseconds, nil
+	case TimeUnitSECONDS:
+		return SecondNanoseconds, nil
+	case TimeUnitMINUTES:
+		return MinuteNanoSeconds, nil
+	case TimeUnitHOURS:
+		return HourNanoSeconds, nil
+	case TimeUnitDAYS:
+		return DayNanoSeconds, nil
+	case TimeUnitWEEKS:
+		return WeekNanoSeconds, nil
+	case TimeUnitMONTHS:
+		return GregorianYearNanoSeconds / 12, nil
+	case TimeUnitQUARTERS:
+		return GregorianYearNanoSeconds / 4, nil
+	case TimeUnitHALFYEARS:
+		return GregorianYearNanoSeconds / 2, nil
+	case TimeUnitYEARS:
+		return GregorianYearNanoSeconds, nil
+	}
+
+	return 0, fmt.Errorf("Error: Invalid time unit. TimeUnitType='%v'", int(tUnit))
+}
+
+// rank - Returns an integer used to compare the relative
+// size of two time units. Larger time units return larger
+// rank values. TimeUnitNONE and invalid values return zero.
+func (tUnit TimeUnitType) rank() int {
+
+	switch tUnit {
+	case TimeUnitNANOSECONDS:
+		return 1
+	case TimeUnitMICROSECONDS:
+		return 2
+	case TimeUnitMILLISECONDS:
+		return 3
+	case TimeUnitSECONDS:
+		return 4
+	case TimeUnitMINUTES:
+		return 5
+	case TimeUnitHOURS:
+		return 6
+	case TimeUnitDAYS:
+		return 7
+	case TimeUnitWEEKS:
+		return 8
+	case TimeUnitMONTHS:
+		return 9
+	case TimeUnitQUARTERS:
+		return 10
+	case TimeUnitHALFYEARS:
+		return 11
+	case TimeUnitYEARS:
+		return 12
+	}
+
+	return 0
+}
+
+// Time Unit Types
+const (
+
+	// TimeUnitNONE - No time unit specified.
+	TimeUnitNONE TimeUnitType = iota
+
+	// TimeUnitNANOSECONDS - Nanoseconds
+	TimeUnitNANOSECONDS
+
+	// TimeUnitMICROSECONDS - Microseconds
+	TimeUnitMICROSECONDS
+
+	// TimeUnitMILLISECONDS - Milliseconds
+	TimeUnitMILLISECONDS
+
+	// TimeUnitSECONDS - Seconds
+	TimeUnitSECONDS
+
+	// TimeUnitMINUTES - Minutes
+	TimeUnitMINUTES
+
+	// TimeUnitHOURS - Hours
+	TimeUnitHOURS
+
+	// TimeUnitDAYS - Days
+	TimeUnitDAYS
+
+	// TimeUnitWEEKS - Weeks
+	TimeUnitWEEKS
+
+	// TimeUnitMONTHS - Months
+	TimeUnitMONTHS
+
+	// TimeUnitYEARS - Years
+	TimeUnitYEARS
+
+	// TimeUnitQUARTERS - Quarters. Three months. Calendar quarters
+	// begin on January 1st, April 1st, July 1st and October 1st.
+	TimeUnitQUARTERS
+
+	// TimeUnitHALFYEARS - Half-Years. Six months. Calendar half-years
+	// begin on January 1st and July 1st.
+	TimeUnitHALFYEARS
+)
+
+// TimeUnitTypeLabels - Text Names associated with TimeUnitType types.
+var TimeUnitTypeLabels = [...]string{"None", "Nanoseconds", "Microseconds", "Milliseconds",
+	"Seconds", "Minutes", "Hours", "Days", "Weeks", "Months", "Years",
+	"Quarters", "HalfYears"}
@@ -85,6 +85,10 @@ type TimeZoneDto struct {
 // AddDate - Adds specified years, months and days values to the
 // current time values maintained by this TimeZoneDto
 //
+// Arithmetic Mode: Years and months are calendar units. Days are added as
+// elapsed 24-hour days (TimeMathABSOLUTE). For wall clock arithmetic, use
+// method AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 // years		int		- Number of years to add to current TimeZoneDto instance
@@ -142,9 +146,71 @@ func (tzdto *TimeZoneDto) AddDate(years, months, days int) error {
 }
 
 
+// AddDateOverflow - Adds specified years, months and days values to the
+// current time values maintained by this TimeZoneDto. Month end overflow
+// is resolved according to input parameter 'overflow'.
+//
+// Arithmetic Mode: TimeMathABSOLUTE. See method AddDateTimeMode() for
+// wall clock arithmetic.
+//
+// The calendar arithmetic is performed on 'TimeIn' in its own time zone.
+// 'TimeOut', 'TimeUTC' and 'TimeLocal' are then recomputed from the
+// updated 'TimeIn' so that all four values identify the same instant.
+//
+// Input Parameters
+// ================
+// years		int		- Number of years to add to current TimeZoneDto instance
+// months		int		- Number of months to add to current TimeZoneDto instance
+// days			int		- Number of days to add to current TimeZoneDto instance
+//
+// overflow	MonthEndOverflowType - Specifies the treatment of a day of the
+//						month which does not exist in the target month. Example:
+//						2019-01-31 plus 1 month yields 2019-03-03 (NORMALIZE),
+//						2019-02-28 (CLAMP) or 2019-03-01 (ROLLNEXT).
+//
+// Returns
+// ======
+// There only one return: An 'error' type.
+//
+// error	- If errors are encountered, this method returns an error object.
+// 					Otherwise, the error value is 'nil'.
+//
+func (tzdto *TimeZoneDto) AddDateOverflow(years, months, days int, overflow MonthEndOverflowType) error {
+
+	ePrefix := "TimeZoneDto.AddDateOverflow() "
+
+	err := tzdto.IsTimeZoneDtoValid()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error: This Time Zone Utility is INVALID!  Error='%v'", err.Error())
+	}
+
+	dateTzIn, err := tzdto.TimeIn.AddDateOverflow(years, months, days, overflow, tzdto.TimeIn.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tzdto.TimeIn.AddDateOverflow(...). Error='%v'", err.Error())
+	}
+
+	tz2Dto, err := TimeZoneDto{}.NewDateTz(dateTzIn, tzdto.TimeOut.TimeZone.LocationName, tzdto.TimeOut.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix +
+			"Error returned by TimeZoneDto{}.NewDateTz(dateTzIn, timeZoneLocation, fmtStr) " +
+			"Error='%v'", err.Error())
+	}
+
+	tzdto.CopyIn(tz2Dto)
+
+	return nil
+}
+
 // AddDateTime - Adds input time elements to the time
 // value of the current TimeZoneDto instance.
 //
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// elements are added as elapsed time (TimeMathABSOLUTE). For wall clock
+// arithmetic, use method AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 // years				int		- Number of years added to current TimeZoneDto
@@ -223,9 +289,111 @@ func (tzdto *TimeZoneDto) AddDateTime(years, months, days, hours, minutes,
 	return nil
 }
 
+// AddDateTimeMode - Adds input time elements to the time value of the
+// current TimeZoneDto instance. Days and time components are added
+// according to arithmetic mode 'mode' and month end overflow is resolved
+// according to input parameter 'overflow'.
+//
+// The arithmetic is performed on 'TimeIn' in its own time zone. In
+// TimeMathWALLCLOCK mode, the wall clock of the 'TimeIn' time zone is
+// used. 'TimeOut', 'TimeUTC' and 'TimeLocal' are then recomputed from the
+// updated 'TimeIn'.
+//
+// Input Parameters
+// ================
+// years, months, days, hours, minutes, seconds, milliseconds,
+// microseconds, nanoseconds	int	- The time elements to add. Values
+//						may be negative or positive.
+//
+// mode			TimeMathModeType - TimeMathABSOLUTE or TimeMathWALLCLOCK.
+//
+// overflow	MonthEndOverflowType - Specifies the treatment of a day of the
+//						month which does not exist in the target month. See
+//						method AddDateOverflow().
+//
+// Returns
+// =======
+// There is only one return: an 'error' type.
+//
+// error - 	If errors are encountered, this method returns an 'error'
+//					instance populated with an error message. If the method completes
+//					successfully, this error value is set to 'nil'
+//
+func (tzdto *TimeZoneDto) AddDateTimeMode(years, months, days, hours, minutes,
+	seconds, milliseconds, microseconds, nanoseconds int, mode TimeMathModeType,
+	overflow MonthEndOverflowType) error {
+
+	ePrefix := "TimeZoneDto.AddDateTimeMode() "
+
+	err := tzdto.IsTimeZoneDtoValid()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error: This Time Zone Utility is INVALID!  Error='%v'", err.Error())
+	}
+
+	dateTzIn, err := tzdto.TimeIn.AddDateTimeMode(years, months, days, hours, minutes,
+		seconds, milliseconds, microseconds, nanoseconds, mode, overflow, tzdto.TimeIn.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tzdto.TimeIn.AddDateTimeMode(...). Error='%v'", err.Error())
+	}
+
+	tz2Dto, err := TimeZoneDto{}.NewDateTz(dateTzIn, tzdto.TimeOut.TimeZone.LocationName, tzdto.TimeOut.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix +
+			"Error returned by TimeZoneDto{}.NewDateTz(dateTzIn, timeZoneLocation, fmtStr) " +
+			"Error='%v'", err.Error())
+	}
+
+	tzdto.CopyIn(tz2Dto)
+
+	return nil
+}
+
+// AddDateTimeOverflow - Adds input time elements to the time value of the
+// current TimeZoneDto instance. Month end overflow is resolved according
+// to input parameter 'overflow'.
+//
+// Arithmetic Mode: TimeMathABSOLUTE. See method AddDateTimeMode() for
+// wall clock arithmetic.
+//
+// The calendar arithmetic is performed on 'TimeIn' in its own time zone.
+// 'TimeOut', 'TimeUTC' and 'TimeLocal' are then recomputed from the
+// updated 'TimeIn'.
+//
+// Input Parameters
+// ================
+// years, months, days, hours, minutes, seconds, milliseconds,
+// microseconds, nanoseconds	int	- The time elements to add. Values
+//						may be negative or positive.
+//
+// overflow	MonthEndOverflowType - Specifies the treatment of a day of the
+//						month which does not exist in the target month. See
+//						method AddDateOverflow().
+//
+// Returns
+// =======
+// There is only one return: an 'error' type.
+//
+// error - 	If errors are encountered, this method returns an 'error'
+//					instance populated with an error message. If the method completes
+//					successfully, this error value is set to 'nil'
+//
+func (tzdto *TimeZoneDto) AddDateTimeOverflow(years, months, days, hours, minutes,
+	seconds, milliseconds, microseconds, nanoseconds int, overflow MonthEndOverflowType) error {
+
+	return tzdto.AddDateTimeMode(years, months, days, hours, minutes,
+		seconds, milliseconds, microseconds, nanoseconds, TimeMathABSOLUTE, overflow)
+}
+
 // AddDuration - Adds 'duration' to the time values maintained by the
 // current TimeZoneDto.
 //
+// Arithmetic Mode: 'duration' is added as elapsed time (TimeMathABSOLUTE).
+// For wall clock arithmetic, use method AddDurationMode() with
+// TimeMathWALLCLOCK.
+//
 // Input Parameters
 // ================
 //
@@ -298,11 +466,67 @@ func (tzdto *TimeZoneDto) AddDuration(duration time.Duration) error {
 	return nil
 }
 
+// AddDurationMode - Adds 'duration' to the time values maintained by the
+// current TimeZoneDto according to arithmetic mode 'mode'.
+//
+// With TimeMathABSOLUTE, the result is identical to that of method
+// AddDuration(). With TimeMathWALLCLOCK, 'duration' is added to the wall
+// clock reading of 'TimeIn' in its own time zone. 'TimeOut', 'TimeUTC'
+// and 'TimeLocal' are then recomputed from the updated 'TimeIn'.
+//
+// Input Parameters
+// ================
+//
+// duration		time.Duration		- May be a positive or negative duration.
+//
+// mode				TimeMathModeType	- TimeMathABSOLUTE or TimeMathWALLCLOCK.
+//
+// Returns
+// =======
+// There is only one return: an 'error' type.
+//
+// error - 	If errors are encountered, this method returns an 'error'
+//					instance populated with an error message. If the method completes
+//					successfully, this error value is set to 'nil'
+//
+func (tzdto *TimeZoneDto) AddDurationMode(duration time.Duration, mode TimeMathModeType) error {
+
+	ePrefix := "TimeZoneDto.AddDurationMode() "
+
+	err := tzdto.IsTimeZoneDtoValid()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "This current TimeZoneDto instance is INVALID! Error='%v'", err.Error())
+	}
+
+	dateTzIn, err := tzdto.TimeIn.AddDurationMode(duration, mode, tzdto.TimeIn.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error returned by tzdto.TimeIn.AddDurationMode(duration, mode). Error='%v'", err.Error())
+	}
+
+	tz2Dto, err := TimeZoneDto{}.NewDateTz(dateTzIn, tzdto.TimeOut.TimeZone.LocationName, tzdto.TimeOut.DateTimeFmt)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix +
+			"Error returned by TimeZoneDto{}.NewDateTz(dateTzIn, timeZoneLocation, fmtStr) " +
+			"Error='%v'", err.Error())
+	}
+
+	tzdto.CopyIn(tz2Dto)
+
+	return nil
+}
+
 // AddMinusTimeDto - This method receives a TimeDto input parameter. It
 // then proceeds to convert all time components to negative values and
 // subtracts those time components from the time values of the current
 // TimeZoneDto.
 //
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// components are subtracted as elapsed time (TimeMathABSOLUTE). For wall
+// clock arithmetic, use method AddMinusTimeDtoMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters:
 // =================
 //
@@ -337,16 +561,32 @@ func (tzdto *TimeZoneDto) AddDuration(duration time.Duration) error {
 //
 func (tzdto *TimeZoneDto) AddMinusTimeDto(timeDto TimeDto) error {
 
-	ePrefix := "TimeZoneDto.AddMinusTimeDto() "
+	return tzdto.AddMinusTimeDtoOverflow(timeDto, MonthEndOverflowNORMALIZE)
+}
+
+// AddMinusTimeDtoMode - Subtracts the time components of input
+// parameter 'timeDto' from the time values of the current TimeZoneDto.
+// Days and time components are subtracted according to arithmetic mode
+// 'mode' and month end overflow is resolved according to 'overflow'.
+//
+// The arithmetic is performed on 'TimeIn' in its own time zone. In
+// TimeMathWALLCLOCK mode, the wall clock of the 'TimeIn' time zone is
+// used.
+//
+// See method AddMinusTimeDto() for details.
+//
+func (tzdto *TimeZoneDto) AddMinusTimeDtoMode(timeDto TimeDto, mode TimeMathModeType,
+	overflow MonthEndOverflowType) error {
 
+	ePrefix := "TimeZoneDto.AddMinusTimeDtoMode() "
 
 	dateTzIn := tzdto.TimeIn.CopyOut()
 
-	err := dateTzIn.AddMinusTimeDtoToThis(timeDto)
+	err := dateTzIn.AddMinusTimeDtoModeToThis(timeDto, mode, overflow)
 
 	if err != nil {
 		return fmt.Errorf(ePrefix +
-			"Error returned by dateTzIn.AddMinusTimeDtoToThis(timeDto) " +
+			"Error returned by dateTzIn.AddMinusTimeDtoModeToThis(timeDto, mode, overflow) " +
 			"Error='%v'", err.Error())
 	}
 
@@ -367,10 +607,30 @@ func (tzdto *TimeZoneDto) AddMinusTimeDto(timeDto TimeDto) error {
 	return nil
 }
 
+// AddMinusTimeDtoOverflow - Subtracts the time components of input
+// parameter 'timeDto' from the time values of the current TimeZoneDto.
+// Month end overflow is resolved according to input parameter 'overflow'.
+// Example: 2019-03-31 minus 1 month with MonthEndOverflowCLAMP yields
+// 2019-02-28.
+//
+// Arithmetic Mode: TimeMathABSOLUTE. See method AddMinusTimeDtoMode()
+// for wall clock arithmetic.
+//
+// See method AddMinusTimeDto() for details.
+//
+func (tzdto *TimeZoneDto) AddMinusTimeDtoOverflow(timeDto TimeDto, overflow MonthEndOverflowType) error {
+
+	return tzdto.AddMinusTimeDtoMode(timeDto, TimeMathABSOLUTE, overflow)
+}
+
 // AddPlusTimeDto - This method receives a TimeDto input parameter. It
 // then proceeds to convert all time components to positive values and
 // adds those time components to the time values of the current TimeZoneDto.
 //
+// Arithmetic Mode: Years and months are calendar units. Days and time
+// components are added as elapsed time (TimeMathABSOLUTE). For wall clock
+// arithmetic, use method AddPlusTimeDtoMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters:
 // =================
 //
@@ -405,16 +665,32 @@ func (tzdto *TimeZoneDto) AddMinusTimeDto(timeDto TimeDto) error {
 //
 func (tzdto *TimeZoneDto) AddPlusTimeDto(timeDto TimeDto) error {
 
-	ePrefix := "TimeZoneDto.AddPlusTimeDto() "
+	return tzdto.AddPlusTimeDtoOverflow(timeDto, MonthEndOverflowNORMALIZE)
+}
+
+// AddPlusTimeDtoMode - Adds the time components of input
+// parameter 'timeDto' to the time values of the current TimeZoneDto.
+// Days and time components are added according to arithmetic mode
+// 'mode' and month end overflow is resolved according to 'overflow'.
+//
+// The arithmetic is performed on 'TimeIn' in its own time zone. In
+// TimeMathWALLCLOCK mode, the wall clock of the 'TimeIn' time zone is
+// used.
+//
+// See method AddPlusTimeDto() for details.
+//
+func (tzdto *TimeZoneDto) AddPlusTimeDtoMode(timeDto TimeDto, mode TimeMathModeType,
+	overflow MonthEndOverflowType) error {
 
+	ePrefix := "TimeZoneDto.AddPlusTimeDtoMode() "
 
 	dateTzIn := tzdto.TimeIn.CopyOut()
 
-	err := dateTzIn.AddPlusTimeDtoToThis(timeDto)
+	err := dateTzIn.AddPlusTimeDtoModeToThis(timeDto, mode, overflow)
 
 	if err != nil {
 		return fmt.Errorf(ePrefix +
-			"Error returned by dateTzIn.AddPlusTimeDtoToThis(timeDto) " +
+			"Error returned by dateTzIn.AddPlusTimeDtoModeToThis(timeDto, mode, overflow) " +
 			"Error='%v'", err.Error())
 	}
 
@@ -435,9 +711,29 @@ func (tzdto *TimeZoneDto) AddPlusTimeDto(timeDto TimeDto) error {
 	return nil
 }
 
+// AddPlusTimeDtoOverflow - Adds the time components of input
+// parameter 'timeDto' to the time values of the current TimeZoneDto.
+// Month end overflow is resolved according to input parameter 'overflow'.
+// Example: 2019-01-31 plus 1 month with MonthEndOverflowCLAMP yields
+// 2019-02-28.
+//
+// Arithmetic Mode: TimeMathABSOLUTE. See method AddPlusTimeDtoMode()
+// for wall clock arithmetic.
+//
+// See method AddPlusTimeDto() for details.
+//
+func (tzdto *TimeZoneDto) AddPlusTimeDtoOverflow(timeDto TimeDto, overflow MonthEndOverflowType) error {
+
+	return tzdto.AddPlusTimeDtoMode(timeDto, TimeMathABSOLUTE, overflow)
+}
+
 // AddTime - Adds time elements to the time value of the current
 // TimeZoneDto instance.
 //
+// Arithmetic Mode: Time elements are added as elapsed time
+// (TimeMathABSOLUTE). For wall clock arithmetic, use method
+// AddDateTimeMode() with TimeMathWALLCLOCK.
+//
 // Input Parameters:
 // =================
 //
@@ -512,6 +808,9 @@ func (tzdto *TimeZoneDto) AddTime(hours, minutes, seconds, milliseconds, microse
 // AddTimeDurationDto - Adds time duration as expressed by input type 'TimeDurationDto'
 // to the time values maintained by the current TimeZoneDto.
 //
+// Arithmetic Mode: The duration is added as elapsed time
+// (TimeMathABSOLUTE). See method AddDurationMode().
+//
 // Input Parameters
 // ================
 //
@@ -0,0 +1,499 @@
+package datetime
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+ WorkScheduleDto
+ ===============
+
+	This source file is located in source code repository:
+		https://github.com/MikeAustin71/datetimeopsgo.git
+
+	The location of this source file is:
+			MikeAustin71\datetimeopsgo\datetime\workscheduledto.go
+
+ Overview and Usage
+ ==================
+ The 'WorkScheduleDto' Type defines a weekly work schedule in a specific
+ time zone. Each day of the week has zero or more work periods expressed
+ as local wall clock times of day. Holidays supplied through the
+ 'HolidayCalendar' interface are excluded from working time.
+
+ 'WorkScheduleDto' is used to compute working time:
+
+	TimeDurationDto.NewStartEndWorkingTime()  - Working time between two date times
+	TimeDurationDto.NewStartWorkingDuration() - End date time after a quantity of
+	                                            working time
+	DateTzDto.AddWorkingDuration()            - Adds working time to a date time
+
+ Example: Monday through Friday 09:00 - 17:00 in New York with US federal
+ holidays.
+
+	schedule, err := WorkScheduleDto{}.NewMonFri(TzIanaUsEast, "09:00", "17:00",
+	                    HolidayCalendarDto{}.NewUS())
+
+ Work periods are evaluated using local wall clock times. On days when
+ Daylight Savings Time begins or ends, a work period may be longer or
+ shorter than its wall clock length.
+
+*/
+
+// WorkPeriodDto - A single work period within a day. Times of day are
+// expressed as the elapsed wall clock time since local midnight.
+// Example: 09:00 = 9 * time.Hour
+type WorkPeriodDto struct {
+	StartTimeOfDay time.Duration // Wall clock time at which work begins
+	EndTimeOfDay   time.Duration // Wall clock time at which work ends. Maximum
+	                             //   value is 24 hours (midnight ending the day).
+}
+
+// WorkScheduleDto - A weekly work schedule in a time zone.
+type WorkScheduleDto struct {
+	TimeZoneLocation string             // IANA Time Zone Location of the schedule
+	WorkPeriods      [7][]WorkPeriodDto // Work periods indexed by time.Weekday
+	Holidays         HolidayCalendar    // Optional. If nil, no holidays are observed.
+}
+
+// AddWorkPeriod - Adds a work period to the day of the week specified by
+// 'weekDay'. Times of day are formatted as "15:04" or "15:04:05". "24:00"
+// signals the end of the day. Work periods on the same day may not overlap.
+//
+// Example: A lunch break is scheduled by adding two work periods.
+//
+//	err := schedule.AddWorkPeriod(time.Monday, "08:00", "12:00")
+//	err = schedule.AddWorkPeriod(time.Monday, "13:00", "17:00")
+//
+func (wSched *WorkScheduleDto) AddWorkPeriod(weekDay time.Weekday, startTimeOfDay, endTimeOfDay string) error {
+
+	ePrefix := "WorkScheduleDto.AddWorkPeriod() "
+
+	if weekDay < time.Sunday || weekDay > time.Saturday {
+		return fmt.Errorf(ePrefix + "Error: Invalid weekDay. weekDay='%v'", int(weekDay))
+	}
+
+	startTod, err := parseTimeOfDayStr(startTimeOfDay)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "'startTimeOfDay' Error='%v'", err.Error())
+	}
+
+	endTod, err := parseTimeOfDayStr(endTimeOfDay)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "'endTimeOfDay' Error='%v'", err.Error())
+	}
+
+	if endTod <= startTod {
+		return fmt.Errorf(ePrefix + "Error: 'endTimeOfDay' must be later than 'startTimeOfDay'. " +
+			"startTimeOfDay='%v' endTimeOfDay='%v'", startTimeOfDay, endTimeOfDay)
+	}
+
+	for _, period := range wSched.WorkPeriods[weekDay] {
+
+		if startTod < period.EndTimeOfDay && period.StartTimeOfDay < endTod {
+			return fmt.Errorf(ePrefix + "Error: Work period overlaps an existing work period. " +
+				"weekDay='%v' startTimeOfDay='%v' endTimeOfDay='%v'",
+				weekDay.String(), startTimeOfDay, endTimeOfDay)
+		}
+	}
+
+	periods := append(wSched.WorkPeriods[weekDay], WorkPeriodDto{StartTimeOfDay: startTod, EndTimeOfDay: endTod})
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].StartTimeOfDay < periods[j].StartTimeOfDay
+	})
+
+	wSched.WorkPeriods[weekDay] = periods
+
+	return nil
+}
+
+// CopyOut - Returns a deep copy of the current WorkScheduleDto. The
+// Holidays calendar is shared, not copied.
+func (wSched *WorkScheduleDto) CopyOut() WorkScheduleDto {
+
+	wSched2 := WorkScheduleDto{}
+
+	wSched2.TimeZoneLocation = wSched.TimeZoneLocation
+
+	for i := 0; i < 7; i++ {
+
+		if wSched.WorkPeriods[i] == nil {
+			continue
+		}
+
+		wSched2.WorkPeriods[i] = make([]WorkPeriodDto, len(wSched.WorkPeriods[i]))
+
+		copy(wSched2.WorkPeriods[i], wSched.WorkPeriods[i])
+	}
+
+	wSched2.Holidays = wSched.Holidays
+
+	return wSched2
+}
+
+// GetWeeklyWorkingTime - Returns the scheduled working time in one week
+// computed from wall clock times. Holidays are not considered.
+func (wSched *WorkScheduleDto) GetWeeklyWorkingTime() time.Duration {
+
+	var total time.Duration
+
+	for i := 0; i < 7; i++ {
+
+		for _, period := range wSched.WorkPeriods[i] {
+			total += period.EndTimeOfDay - period.StartTimeOfDay
+		}
+	}
+
+	return total
+}
+
+// IsValid - Returns an error if the current WorkScheduleDto is invalid.
+// At least one work period must be scheduled and the time zone location
+// must be valid.
+func (wSched *WorkScheduleDto) IsValid() error {
+
+	ePrefix := "WorkScheduleDto.IsValid() "
+
+	_, err := time.LoadLocation(wSched.TimeZoneLocation)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix + "Error: Invalid TimeZoneLocation. TimeZoneLocation='%v' Error='%v'",
+			wSched.TimeZoneLocation, err.Error())
+	}
+
+	if wSched.GetWeeklyWorkingTime() <= 0 {
+		return fmt.Errorf(ePrefix + "Error: The work schedule contains no work periods!")
+	}
+
+	for i := 0; i < 7; i++ {
+
+		for _, period := range wSched.WorkPeriods[i] {
+
+			if period.StartTimeOfDay < 0 || period.EndTimeOfDay > 24*time.Hour ||
+				period.EndTimeOfDay <= period.StartTimeOfDay {
+
+				return fmt.Errorf(ePrefix + "Error: Invalid work period. weekDay='%v' " +
+					"StartTimeOfDay='%v' EndTimeOfDay='%v'", time.Weekday(i).String(),
+					period.StartTimeOfDay, period.EndTimeOfDay)
+			}
+		}
+	}
+
+	return nil
+}
+
+// New - Creates a new WorkScheduleDto with one work period on each of the
+// days of the week listed in 'workDays'. Additional work periods may be
+// added with method AddWorkPeriod().
+//
+// Input Parameters:
+// =================
+//
+// timeZoneLocation string   - The IANA time zone in which the schedule is
+//                             evaluated. If 'timeZoneLocation' is submitted
+//                             as an empty string, it will default to "Etc/UTC".
+//                             The value "Local" signals the local time zone of
+//                             the host computer.
+//
+// workDays  []time.Weekday  - The days of the week on which work is scheduled.
+//
+// startTimeOfDay string     - Wall clock time at which work begins. Formatted as
+//                             "15:04" or "15:04:05". Example: "09:00"
+//
+// endTimeOfDay   string     - Wall clock time at which work ends. Formatted as
+//                             "15:04" or "15:04:05". Example: "17:00"
+//                             "24:00" signals the end of the day.
+//
+// holidays HolidayCalendar  - Optional. The holiday calendar. If nil, no holidays
+//                             are observed.
+//
+func (wSched WorkScheduleDto) New(
+	timeZoneLocation string,
+	workDays []time.Weekday,
+	startTimeOfDay,
+	endTimeOfDay string,
+	holidays HolidayCalendar) (WorkScheduleDto, error) {
+
+	ePrefix := "WorkScheduleDto.New() "
+
+	wSched2 := WorkScheduleDto{}
+
+	wSched2.TimeZoneLocation = wSched2.preProcessTimeZoneLocation(timeZoneLocation)
+
+	wSched2.Holidays = holidays
+
+	for _, wd := range workDays {
+
+		err := wSched2.AddWorkPeriod(wd, startTimeOfDay, endTimeOfDay)
+
+		if err != nil {
+			return WorkScheduleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+		}
+	}
+
+	err := wSched2.IsValid()
+
+	if err != nil {
+		return WorkScheduleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return wSched2, nil
+}
+
+// NewMonFri - Creates a new WorkScheduleDto with one work period on
+// Monday through Friday. See method New() for a description of the
+// input parameters.
+func (wSched WorkScheduleDto) NewMonFri(
+	timeZoneLocation,
+	startTimeOfDay,
+	endTimeOfDay string,
+	holidays HolidayCalendar) (WorkScheduleDto, error) {
+
+	ePrefix := "WorkScheduleDto.NewMonFri() "
+
+	workDays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	wSched2, err := WorkScheduleDto{}.New(timeZoneLocation, workDays, startTimeOfDay, endTimeOfDay, holidays)
+
+	if err != nil {
+		return WorkScheduleDto{}, fmt.Errorf(ePrefix + "%v", err.Error())
+	}
+
+	return wSched2, nil
+}
+
+// addWorkingDuration - Returns the date time at which 'workingTime' of
+// working time has elapsed after date time 't'. If 'workingTime' is
+// negative, the date time at which the working time began before 't' is
+// returned. The result is returned in the location of 't'. If the working
+// time is exhausted exactly at the end of a work period, the end of that
+// work period is returned.
+func (wSched *WorkScheduleDto) addWorkingDuration(t time.Time, workingTime time.Duration) (time.Time, error) {
+
+	err := wSched.IsValid()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if workingTime == 0 {
+		return t, nil
+	}
+
+	loc, _ := time.LoadLocation(wSched.TimeZoneLocation)
+
+	tLocal := t.In(loc)
+
+	dayNum := civilDayNumber(tLocal)
+
+	step := int64(1)
+
+	remaining := workingTime
+
+	if workingTime < 0 {
+		step = -1
+		remaining = -workingTime
+	}
+
+	idleDays := 0
+
+	for ; ; dayNum += step {
+
+		periods := wSched.getWorkPeriodInstants(dayNum, loc)
+
+		if len(periods) == 0 {
+
+			idleDays++
+
+			if idleDays > maxConsecutiveNonBusinessDays {
+				return time.Time{},
+					fmt.Errorf("Error: No working time found within %v days.", maxConsecutiveNonBusinessDays)
+			}
+
+			continue
+		}
+
+		idleDays = 0
+
+		for i := 0; i < len(periods); i++ {
+
+			if step > 0 {
+
+				period := periods[i]
+
+				if !period[1].After(tLocal) {
+					continue
+				}
+
+				periodStart := period[0]
+
+				if periodStart.Before(tLocal) {
+					periodStart = tLocal
+				}
+
+				available := period[1].Sub(periodStart)
+
+				if remaining <= available {
+					return periodStart.Add(remaining).In(t.Location()), nil
+				}
+
+				remaining -= available
+
+			} else {
+
+				period := periods[len(periods)-1-i]
+
+				if !period[0].Before(tLocal) {
+					continue
+				}
+
+				periodEnd := period[1]
+
+				if periodEnd.After(tLocal) {
+					periodEnd = tLocal
+				}
+
+				available := periodEnd.Sub(period[0])
+
+				if remaining <= available {
+					return periodEnd.Add(-remaining).In(t.Location()), nil
+				}
+
+				remaining -= available
+			}
+		}
+	}
+}
+
+// getWorkPeriodInstants - Returns the starting and ending instants of the
+// work periods on the local calendar day identified by civil day number
+// 'dayNum'. No work periods are returned for holidays.
+func (wSched *WorkScheduleDto) getWorkPeriodInstants(dayNum int64, loc *time.Location) [][2]time.Time {
+
+	y, m, d := civilDate(dayNum)
+
+	weekDay := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Weekday()
+
+	if len(wSched.WorkPeriods[weekDay]) == 0 {
+		return nil
+	}
+
+	if wSched.Holidays != nil && wSched.Holidays.IsHoliday(y, m, d) {
+		return nil
+	}
+
+	instants := make([][2]time.Time, 0, len(wSched.WorkPeriods[weekDay]))
+
+	for _, period := range wSched.WorkPeriods[weekDay] {
+
+		starts := resolveWallClock(y, m, d, int64(period.StartTimeOfDay), loc)
+		ends := resolveWallClock(y, m, d, int64(period.EndTimeOfDay), loc)
+
+		start := starts[0]
+		end := ends[len(ends)-1]
+
+		if end.After(start) {
+			instants = append(instants, [2]time.Time{start, end})
+		}
+	}
+
+	return instants
+}
+
+// getWorkingDuration - Returns the working time between date times
+// 't1' and 't2'. 't1' must be less than or equal to 't2'.
+func (wSched *WorkScheduleDto) getWorkingDuration(t1, t2 time.Time) (time.Duration, error) {
+
+	err := wSched.IsValid()
+
+	if err != nil {
+		return 0, err
+	}
+
+	loc, _ := time.LoadLocation(wSched.TimeZoneLocation)
+
+	t1Local := t1.In(loc)
+	t2Local := t2.In(loc)
+
+	var total time.Duration
+
+	for dayNum := civilDayNumber(t1Local); dayNum <= civilDayNumber(t2Local); dayNum++ {
+
+		for _, period := range wSched.getWorkPeriodInstants(dayNum, loc) {
+
+			start := period[0]
+			end := period[1]
+
+			if start.Before(t1Local) {
+				start = t1Local
+			}
+
+			if end.After(t2Local) {
+				end = t2Local
+			}
+
+			if end.After(start) {
+				total += end.Sub(start)
+			}
+		}
+	}
+
+	return total, nil
+}
+
+// preProcessTimeZoneLocation - Converts an empty time zone location to
+// "Etc/UTC" and standardizes the value "Local".
+func (wSched *WorkScheduleDto) preProcessTimeZoneLocation(timeZoneLocation string) string {
+
+	if len(timeZoneLocation) == 0 {
+		return TzIanaUTC
+	}
+
+	if strings.ToLower(timeZoneLocation) == "local" {
+		return "Local"
+	}
+
+	return timeZoneLocation
+}
+
+// parseTimeOfDayStr - Parses a wall clock time of day formatted as
+// "15:04" or "15:04:05" and returns the elapsed time since midnight.
+// "24:00" is accepted as the end of the day.
+func parseTimeOfDayStr(timeOfDay string) (time.Duration, error) {
+
+	elements := strings.Split(strings.TrimSpace(timeOfDay), ":")
+
+	if len(elements) < 2 || len(elements) > 3 {
+		return 0, fmt.Errorf("Error: Invalid time of day. Expected \"15:04\" or \"15:04:05\". " +
+			"timeOfDay='%v'", timeOfDay)
+	}
+
+	limits := []int{24, 59, 59}
+	units := []time.Duration{time.Hour, time.Minute, time.Second}
+
+	var tod time.Duration
+
+	for i, element := range elements {
+
+		val, err := strconv.Atoi(element)
+
+		if err != nil || val < 0 || val > limits[i] {
+			return 0, fmt.Errorf("Error: Invalid time of day. timeOfDay='%v'", timeOfDay)
+		}
+
+		tod += time.Duration(val) * units[i]
+	}
+
+	if tod > 24*time.Hour {
+		return 0, fmt.Errorf("Error: Time of day exceeds 24:00. timeOfDay='%v'", timeOfDay)
+	}
+
+	return tod, nil
+}
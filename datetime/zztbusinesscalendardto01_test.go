@@ -0,0 +1,160 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+// testHolidayCalendar - A HolidayCalendar used for testing.
+type testHolidayCalendar map[string]bool
+
+func (hCal testHolidayCalendar) IsHoliday(year int, month time.Month, day int) bool {
+	return hCal[time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format("2006-01-02")]
+}
+
+func TestDateTzDto_AddBusinessDays_01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	holidays := testHolidayCalendar{"2018-07-04": true}
+
+	bCal := BusinessCalendarDto{}.NewSatSunWeekend(holidays)
+
+	// Friday
+	dtz, err := DateTzDto{}.NewDateTimeElements(2018, 6, 29, 14, 30, 0, 0, TzIanaUsCentral, fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTimeElements(). Error='%v'", err.Error())
+		return
+	}
+
+	tests := []struct {
+		days     int
+		expected string
+	}{
+		{0, "2018-06-29 14:30:00 -0500 CDT"},
+		{1, "2018-07-02 14:30:00 -0500 CDT"},
+		{5, "2018-07-09 14:30:00 -0500 CDT"},
+		{-1, "2018-06-28 14:30:00 -0500 CDT"},
+		{-5, "2018-06-22 14:30:00 -0500 CDT"},
+	}
+
+	for _, test := range tests {
+
+		dtz2, err := dtz.AddBusinessDays(test.days, bCal, "")
+
+		if err != nil {
+			t.Errorf("Error returned by dtz.AddBusinessDays(%v). Error='%v'", test.days, err.Error())
+			continue
+		}
+
+		if test.expected != dtz2.String() {
+			t.Errorf("Error: Expected AddBusinessDays(%v)='%v'. Instead, result='%v'",
+				test.days, test.expected, dtz2.String())
+		}
+
+		days, err := dtz.GetBusinessDaysBetween(dtz2, bCal)
+
+		if err != nil {
+			t.Errorf("Error returned by dtz.GetBusinessDaysBetween(). Error='%v'", err.Error())
+			continue
+		}
+
+		if test.days != days {
+			t.Errorf("Error: Expected business days between='%v'. Instead, days='%v'", test.days, days)
+		}
+	}
+
+	// Saturday plus one business day is Monday.
+	dtz, _ = DateTzDto{}.NewDateTimeElements(2018, 6, 30, 9, 0, 0, 0, TzIanaUsCentral, fmtStr)
+
+	if dtz.IsBusinessDay(bCal) {
+		t.Error("Error: Expected Saturday 2018-06-30 to be a non-business day.")
+	}
+
+	dtz2, _ := dtz.NextBusinessDay(bCal)
+
+	if "2018-07-02 09:00:00 -0500 CDT" != dtz2.String() {
+		t.Errorf("Error: Expected NextBusinessDay='2018-07-02 09:00:00 -0500 CDT'. Instead, result='%v'",
+			dtz2.String())
+	}
+
+	dtz2, _ = dtz.PreviousBusinessDay(bCal)
+
+	if "2018-06-29 09:00:00 -0500 CDT" != dtz2.String() {
+		t.Errorf("Error: Expected PreviousBusinessDay='2018-06-29 09:00:00 -0500 CDT'. Instead, result='%v'",
+			dtz2.String())
+	}
+
+}
+
+func TestDateTzDto_AddBusinessDays_02(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	// Friday/Saturday weekend
+	bCal, err := BusinessCalendarDto{}.New([]time.Weekday{time.Friday, time.Saturday}, nil)
+
+	if err != nil {
+		t.Errorf("Error returned by BusinessCalendarDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	// Thursday
+	dtz, _ := DateTzDto{}.NewDateTimeElements(2018, 6, 28, 8, 0, 0, 0, "Asia/Dubai", fmtStr)
+
+	err = dtz.AddBusinessDaysToThis(1, bCal)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddBusinessDaysToThis(). Error='%v'", err.Error())
+		return
+	}
+
+	if "2018-07-01 08:00:00 +0400 +04" != dtz.String() {
+		t.Errorf("Error: Expected '2018-07-01 08:00:00 +0400 +04'. Instead, result='%v'", dtz.String())
+	}
+
+	dtz2, _ := DateTzDto{}.NewDateTimeElements(2018, 7, 31, 8, 0, 0, 0, "Asia/Dubai", fmtStr)
+
+	// July 2 - July 31: 30 days less 8 Fridays and Saturdays = 22
+	days, _ := dtz.GetBusinessDaysBetween(dtz2, bCal)
+
+	if 22 != days {
+		t.Errorf("Error: Expected 22 business days. Instead, days='%v'", days)
+	}
+
+	_, err = BusinessCalendarDto{}.New([]time.Weekday{0, 1, 2, 3, 4, 5, 6}, nil)
+
+	if err == nil {
+		t.Error("Error: Expected an error for a seven day weekend. No error was returned.")
+	}
+
+}
+
+func TestDateTzDto_AddBusinessDays_03(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	// Every day is a business day.
+	bCal, err := BusinessCalendarDto{}.New([]time.Weekday{}, nil)
+
+	if err != nil {
+		t.Errorf("Error returned by BusinessCalendarDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	// 02:30 does not exist on 2026-03-08 in America/New_York. The result
+	// is the first instant following the Daylight Savings Time gap.
+	dtz, _ := DateTzDto{}.NewDateTimeElements(2026, 3, 7, 2, 30, 0, 0, TzIanaUsEast, fmtStr)
+
+	err = dtz.AddBusinessDaysToThis(1, bCal)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddBusinessDaysToThis(). Error='%v'", err.Error())
+		return
+	}
+
+	if "2026-03-08 03:00:00 -0400 EDT" != dtz.String() {
+		t.Errorf("Error: Expected '2026-03-08 03:00:00 -0400 EDT'. Instead, result='%v'", dtz.String())
+	}
+}
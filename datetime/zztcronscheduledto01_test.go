@@ -0,0 +1,363 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func testCronNextFireTimes(
+	t *testing.T,
+	expression,
+	tzLocation string,
+	ref time.Time,
+	expected []string) {
+
+	cron, err := CronScheduleDto{}.New(expression, tzLocation)
+
+	if err != nil {
+		t.Errorf("Error returned by CronScheduleDto{}.New(%v). Error='%v'", expression, err.Error())
+		return
+	}
+
+	refDtz, err := DateTzDto{}.New(ref, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(ref). Error='%v'", err.Error())
+		return
+	}
+
+	fireTimes, err := cron.GetNextFireTimes(refDtz, len(expected), "2006-01-02 15:04:05 -0700 MST")
+
+	if err != nil {
+		t.Errorf("Error returned by cron.GetNextFireTimes(). expression='%v' Error='%v'",
+			expression, err.Error())
+		return
+	}
+
+	for i, dtz := range fireTimes {
+
+		if dtz.String() != expected[i] {
+			t.Errorf("Error: expression='%v' Expected fire time[%v]='%v'. Instead, fire time='%v'",
+				expression, i, expected[i], dtz.String())
+		}
+	}
+}
+
+func TestCronScheduleDto_GetNextFireTime_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	ref := time.Date(2026, 10, 19, 8, 10, 0, 0, loc)
+
+	testCronNextFireTimes(t, "*/15 9-17 * * MON-FRI", TzIanaUsCentral, ref,
+		[]string{
+			"2026-10-19 09:00:00 -0500 CDT",
+			"2026-10-19 09:15:00 -0500 CDT",
+			"2026-10-19 09:30:00 -0500 CDT",
+		})
+}
+
+func TestCronScheduleDto_GetNextFireTime_02(t *testing.T) {
+
+	// 6 field expression with seconds
+	ref := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCronNextFireTimes(t, "30 0 12 * JAN,jul ?", TzIanaUTC, ref,
+		[]string{
+			"2026-01-01 12:00:30 +0000 UTC",
+			"2026-01-02 12:00:30 +0000 UTC",
+		})
+
+	ref = time.Date(2026, 1, 31, 13, 0, 0, 0, time.UTC)
+
+	testCronNextFireTimes(t, "30 0 12 * JAN,jul ?", TzIanaUTC, ref,
+		[]string{
+			"2026-07-01 12:00:30 +0000 UTC",
+		})
+}
+
+func TestCronScheduleDto_GetNextFireTime_03(t *testing.T) {
+
+	// Macros
+	ref := time.Date(2026, 10, 19, 8, 10, 0, 0, time.UTC)
+
+	testCronNextFireTimes(t, "@daily", TzIanaUTC, ref,
+		[]string{
+			"2026-10-20 00:00:00 +0000 UTC",
+			"2026-10-21 00:00:00 +0000 UTC",
+		})
+
+	testCronNextFireTimes(t, "@hourly", TzIanaUTC, ref,
+		[]string{
+			"2026-10-19 09:00:00 +0000 UTC",
+			"2026-10-19 10:00:00 +0000 UTC",
+		})
+
+	testCronNextFireTimes(t, "@yearly", TzIanaUTC, ref,
+		[]string{
+			"2027-01-01 00:00:00 +0000 UTC",
+		})
+
+	testCronNextFireTimes(t, "@weekly", TzIanaUTC, ref,
+		[]string{
+			"2026-10-25 00:00:00 +0000 UTC",
+		})
+}
+
+func TestCronScheduleDto_GetNextFireTime_04(t *testing.T) {
+
+	// L, LW, L-n and W modifiers
+	ref := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	testCronNextFireTimes(t, "0 0 L * *", TzIanaUTC, ref,
+		[]string{
+			"2026-01-31 00:00:00 +0000 UTC",
+			"2026-02-28 00:00:00 +0000 UTC",
+			"2026-03-31 00:00:00 +0000 UTC",
+		})
+
+	// January 31, 2026 is a Saturday. May 31, 2026 is a Sunday.
+	testCronNextFireTimes(t, "0 0 LW * *", TzIanaUTC, ref,
+		[]string{
+			"2026-01-30 00:00:00 +0000 UTC",
+			"2026-02-27 00:00:00 +0000 UTC",
+			"2026-03-31 00:00:00 +0000 UTC",
+			"2026-04-30 00:00:00 +0000 UTC",
+			"2026-05-29 00:00:00 +0000 UTC",
+		})
+
+	testCronNextFireTimes(t, "0 0 L-2 * *", TzIanaUTC, ref,
+		[]string{
+			"2026-01-29 00:00:00 +0000 UTC",
+			"2026-02-26 00:00:00 +0000 UTC",
+		})
+
+	// August 1, 2026 is a Saturday. August 15, 2026 is a Saturday.
+	ref = time.Date(2026, 7, 31, 0, 0, 0, 0, time.UTC)
+
+	testCronNextFireTimes(t, "0 0 1W,15W * *", TzIanaUTC, ref,
+		[]string{
+			"2026-08-03 00:00:00 +0000 UTC",
+			"2026-08-14 00:00:00 +0000 UTC",
+			"2026-09-01 00:00:00 +0000 UTC",
+			"2026-09-15 00:00:00 +0000 UTC",
+		})
+}
+
+func TestCronScheduleDto_GetNextFireTime_05(t *testing.T) {
+
+	// # and L Day-of-Week modifiers
+	ref := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCronNextFireTimes(t, "0 10 * * THU#4", TzIanaUTC, ref,
+		[]string{
+			"2026-01-22 10:00:00 +0000 UTC",
+			"2026-02-26 10:00:00 +0000 UTC",
+		})
+
+	testCronNextFireTimes(t, "0 10 ? * 5L", TzIanaUTC, ref,
+		[]string{
+			"2026-01-30 10:00:00 +0000 UTC",
+			"2026-02-27 10:00:00 +0000 UTC",
+		})
+
+	// Sunday as 7
+	testCronNextFireTimes(t, "0 10 * * 7", TzIanaUTC, ref,
+		[]string{
+			"2026-01-04 10:00:00 +0000 UTC",
+		})
+}
+
+func TestCronScheduleDto_GetNextFireTime_06(t *testing.T) {
+
+	// Day-of-Month and Day-of-Week both restricted: either matches.
+	ref := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	testCronNextFireTimes(t, "0 0 13 * FRI", TzIanaUTC, ref,
+		[]string{
+			"2026-10-02 00:00:00 +0000 UTC",
+			"2026-10-09 00:00:00 +0000 UTC",
+			"2026-10-13 00:00:00 +0000 UTC",
+			"2026-10-16 00:00:00 +0000 UTC",
+		})
+}
+
+func TestCronScheduleDto_GetNextFireTime_07(t *testing.T) {
+
+	// Daylight Savings Time begins: 02:30 fires once at 03:00.
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	ref := time.Date(2026, 3, 7, 12, 0, 0, 0, loc)
+
+	testCronNextFireTimes(t, "30 2 * * *", TzIanaUsEast, ref,
+		[]string{
+			"2026-03-08 03:00:00 -0400 EDT",
+			"2026-03-09 02:30:00 -0400 EDT",
+		})
+
+	ref = time.Date(2026, 3, 8, 1, 40, 0, 0, loc)
+
+	testCronNextFireTimes(t, "*/15 * * * *", TzIanaUsEast, ref,
+		[]string{
+			"2026-03-08 01:45:00 -0500 EST",
+			"2026-03-08 03:00:00 -0400 EDT",
+			"2026-03-08 03:15:00 -0400 EDT",
+		})
+}
+
+func TestCronScheduleDto_GetNextFireTime_08(t *testing.T) {
+
+	// Daylight Savings Time ends: the doubled hour runs once.
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	ref := time.Date(2026, 11, 1, 0, 50, 0, 0, loc)
+
+	testCronNextFireTimes(t, "*/30 * * * *", TzIanaUsEast, ref,
+		[]string{
+			"2026-11-01 01:00:00 -0400 EDT",
+			"2026-11-01 01:30:00 -0400 EDT",
+			"2026-11-01 02:00:00 -0500 EST",
+		})
+
+	// A reference time in the repeated hour: 01:10 EST
+	ref = time.Date(2026, 11, 1, 6, 10, 0, 0, time.UTC)
+
+	testCronNextFireTimes(t, "*/30 * * * *", TzIanaUsEast, ref,
+		[]string{
+			"2026-11-01 02:00:00 -0500 EST",
+		})
+}
+
+func TestCronScheduleDto_GetPreviousFireTime_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	cron, err := CronScheduleDto{}.New("*/15 * * * *", TzIanaUsEast)
+
+	if err != nil {
+		t.Errorf("Error returned by CronScheduleDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	testCases := []struct {
+		ref      time.Time
+		expected string
+	}{
+		{time.Date(2026, 10, 19, 8, 10, 0, 0, loc), "2026-10-19 08:00:00 -0400 EDT"},
+		{time.Date(2026, 10, 19, 8, 0, 0, 0, loc), "2026-10-19 07:45:00 -0400 EDT"},
+		{time.Date(2026, 10, 19, 8, 0, 0, 500, loc), "2026-10-19 08:00:00 -0400 EDT"},
+		// Daylight Savings Time gap
+		{time.Date(2026, 3, 8, 3, 10, 0, 0, loc), "2026-03-08 03:00:00 -0400 EDT"},
+		{time.Date(2026, 3, 8, 3, 0, 0, 0, loc), "2026-03-08 01:45:00 -0500 EST"},
+		// Repeated hour: 01:10 EST
+		{time.Date(2026, 11, 1, 6, 10, 0, 0, time.UTC), "2026-11-01 01:45:00 -0400 EDT"},
+	}
+
+	for _, tc := range testCases {
+
+		refDtz, err := DateTzDto{}.New(tc.ref, fmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.New(). Error='%v'", err.Error())
+			return
+		}
+
+		dtz, err := cron.GetPreviousFireTime(refDtz, "")
+
+		if err != nil {
+			t.Errorf("Error returned by cron.GetPreviousFireTime(). Error='%v'", err.Error())
+			continue
+		}
+
+		if dtz.String() != tc.expected {
+			t.Errorf("Error: ref='%v' Expected previous fire time='%v'. Instead, previous fire time='%v'",
+				refDtz.String(), tc.expected, dtz.String())
+		}
+	}
+}
+
+func TestCronScheduleDto_IsFireTime_01(t *testing.T) {
+
+	cron, err := CronScheduleDto{}.New("0 9 * * MON", TzIanaUsCentral)
+
+	if err != nil {
+		t.Errorf("Error returned by CronScheduleDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	dtz, _ := DateTzDto{}.New(time.Date(2026, 10, 19, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	if !cron.IsFireTime(dtz) {
+		t.Errorf("Error: Expected IsFireTime('%v')='true'. Instead, IsFireTime='false'", dtz.String())
+	}
+
+	dtz, _ = DateTzDto{}.New(time.Date(2026, 10, 20, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	if cron.IsFireTime(dtz) {
+		t.Errorf("Error: Expected IsFireTime('%v')='false'. Instead, IsFireTime='true'", dtz.String())
+	}
+}
+
+func TestCronScheduleDto_New_01(t *testing.T) {
+
+	badExpressions := []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * FOO *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"* * * * MON#6",
+		"* * 32W * *",
+		"@fortnightly",
+	}
+
+	for _, expr := range badExpressions {
+
+		_, err := CronScheduleDto{}.New(expr, TzIanaUTC)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error for expression='%v'. Instead, no error was returned.", expr)
+		}
+	}
+
+	_, err := CronScheduleDto{}.New("@daily", "Invalid/Zone")
+
+	if err == nil {
+		t.Error("Error: Expected an error for an invalid time zone. Instead, no error was returned.")
+	}
+}
+
+func TestCronScheduleDto_New_02(t *testing.T) {
+
+	// February 30th never occurs.
+	cron, err := CronScheduleDto{}.New("0 0 30 2 *", TzIanaUTC)
+
+	if err != nil {
+		t.Errorf("Error returned by CronScheduleDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	refDtz, _ := DateTzDto{}.New(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	_, err = cron.GetNextFireTime(refDtz, "")
+
+	if err == nil {
+		t.Error("Error: Expected an error from GetNextFireTime() for February 30th. Instead, no error was returned.")
+	}
+
+	_, err = cron.GetPreviousFireTime(refDtz, "")
+
+	if err == nil {
+		t.Error("Error: Expected an error from GetPreviousFireTime() for February 30th. Instead, no error was returned.")
+	}
+}
@@ -0,0 +1,278 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+// testDateRangeDtz - Returns a DateTzDto for the local date time in 'loc'.
+func testDateRangeDtz(year int, month time.Month, day, hour int, loc *time.Location) DateTzDto {
+
+	dtz, _ := DateTzDto{}.New(time.Date(year, month, day, hour, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	return dtz
+}
+
+// testDateRangeCompare - Compares the values of 'dtIter' with the
+// expected values formatted with 'layout'.
+func testDateRangeCompare(t *testing.T, label string, dtIter *DateRangeIteratorDto, layout string, expected []string) {
+
+	values, err := dtIter.GetAll()
+
+	if err != nil {
+		t.Errorf("Error returned by %v dtIter.GetAll(). Error='%v'", label, err.Error())
+		return
+	}
+
+	if len(values) != len(expected) {
+		t.Errorf("Error: %v Expected %v values. Instead, values='%v'", label, len(expected), len(values))
+		return
+	}
+
+	for i, dtz := range values {
+
+		actual := dtz.DateTime.Format(layout)
+
+		if actual != expected[i] {
+			t.Errorf("Error: %v Value %v Expected='%v'. Instead, value='%v'", label, i, expected[i], actual)
+		}
+	}
+}
+
+func TestDateRangeIteratorDto_Months_01(t *testing.T) {
+
+	start := testDateRangeDtz(2026, 1, 31, 9, time.UTC)
+	end := testDateRangeDtz(2026, 5, 31, 9, time.UTC)
+
+	dtIter, err := DateRangeIteratorDto{}.New(start, end, 1, TimeUnitMONTHS, DateRangeStepANCHORED, true, false)
+
+	if err != nil {
+		t.Errorf("Error returned by DateRangeIteratorDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	testDateRangeCompare(t, "Anchored", dtIter, "2006-01-02",
+		[]string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31"})
+
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitMONTHS, DateRangeStepCHAINED, true, false)
+
+	testDateRangeCompare(t, "Chained", dtIter, "2006-01-02",
+		[]string{"2026-01-31", "2026-02-28", "2026-03-28", "2026-04-28", "2026-05-28"})
+
+	// Exclusive end
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitMONTHS, DateRangeStepANCHORED, false, false)
+
+	testDateRangeCompare(t, "Exclusive", dtIter, "2006-01-02",
+		[]string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"})
+
+	// Reverse returns the series computed from the start in reverse order
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitMONTHS, DateRangeStepANCHORED, true, true)
+
+	testDateRangeCompare(t, "Reverse", dtIter, "2006-01-02",
+		[]string{"2026-05-31", "2026-04-30", "2026-03-31", "2026-02-28", "2026-01-31"})
+
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitMONTHS, DateRangeStepCHAINED, false, true)
+
+	testDateRangeCompare(t, "Reverse Chained", dtIter, "2006-01-02",
+		[]string{"2026-05-28", "2026-04-28", "2026-03-28", "2026-02-28", "2026-01-31"})
+
+	// The end bound does not fall on the series
+	end = testDateRangeDtz(2026, 4, 15, 9, time.UTC)
+
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitMONTHS, DateRangeStepANCHORED, true, false)
+
+	testDateRangeCompare(t, "Forward Mid-Month End", dtIter, "2006-01-02",
+		[]string{"2026-01-31", "2026-02-28", "2026-03-31"})
+
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitMONTHS, DateRangeStepANCHORED, true, true)
+
+	testDateRangeCompare(t, "Reverse Mid-Month End", dtIter, "2006-01-02",
+		[]string{"2026-03-31", "2026-02-28", "2026-01-31"})
+
+	// Exclusive end: the end is excluded and the start is included in
+	// both directions.
+	end = testDateRangeDtz(2026, 3, 31, 9, time.UTC)
+
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitMONTHS, DateRangeStepANCHORED, false, true)
+
+	testDateRangeCompare(t, "Reverse Exclusive", dtIter, "2006-01-02",
+		[]string{"2026-02-28", "2026-01-31"})
+
+	dtIter.Reset()
+
+	testDateRangeCompare(t, "Reverse Exclusive Reset", dtIter, "2006-01-02",
+		[]string{"2026-02-28", "2026-01-31"})
+
+	// Quarters over a leap year
+	start = testDateRangeDtz(2027, 11, 30, 0, time.UTC)
+	end = testDateRangeDtz(2028, 12, 31, 0, time.UTC)
+
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitQUARTERS, DateRangeStepANCHORED, true, false)
+
+	testDateRangeCompare(t, "Quarters", dtIter, "2006-01-02",
+		[]string{"2027-11-30", "2028-02-29", "2028-05-30", "2028-08-30", "2028-11-30"})
+}
+
+func TestDateRangeIteratorDto_Days_01(t *testing.T) {
+
+	// Daylight Savings Time begins in America/Chicago on 2026-03-08.
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	start := testDateRangeDtz(2026, 3, 6, 9, loc)
+	end := testDateRangeDtz(2026, 3, 10, 9, loc)
+
+	dtIter, err := DateRangeIteratorDto{}.New(start, end, 1, TimeUnitDAYS, DateRangeStepANCHORED, true, false)
+
+	if err != nil {
+		t.Errorf("Error returned by DateRangeIteratorDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	// Daily steps preserve the wall clock time across the transition.
+	testDateRangeCompare(t, "Days", dtIter, "2006-01-02 15:04 MST",
+		[]string{"2026-03-06 09:00 CST", "2026-03-07 09:00 CST", "2026-03-08 09:00 CDT",
+			"2026-03-09 09:00 CDT", "2026-03-10 09:00 CDT"})
+
+	// Hourly steps are exact durations.
+	start = testDateRangeDtz(2026, 3, 8, 0, loc)
+	end = testDateRangeDtz(2026, 3, 8, 4, loc)
+
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitHOURS, DateRangeStepANCHORED, false, false)
+
+	testDateRangeCompare(t, "Hours", dtIter, "15:04 MST",
+		[]string{"00:00 CST", "01:00 CST", "03:00 CDT"})
+
+	// Two week steps which do not land on the end bound
+	start = testDateRangeDtz(2026, 10, 1, 0, time.UTC)
+	end = testDateRangeDtz(2026, 11, 1, 0, time.UTC)
+
+	dtIter, _ = DateRangeIteratorDto{}.New(start, end, 2, TimeUnitWEEKS, DateRangeStepCHAINED, true, false)
+
+	testDateRangeCompare(t, "Weeks", dtIter, "2006-01-02",
+		[]string{"2026-10-01", "2026-10-15", "2026-10-29"})
+
+	// Start equals end
+	dtIter, _ = DateRangeIteratorDto{}.New(start, start, 1, TimeUnitYEARS, DateRangeStepANCHORED, false, false)
+
+	testDateRangeCompare(t, "Single", dtIter, "2006-01-02", []string{"2026-10-01"})
+
+	dtIter.Reset()
+
+	testDateRangeCompare(t, "Reset", dtIter, "2006-01-02", []string{"2026-10-01"})
+}
+
+func TestDateRangeIteratorDto_Reverse_01(t *testing.T) {
+
+	// A reversed nanosecond step over 100 years steps down from the last
+	// value without computing the series.
+	start := testDateRangeDtz(2026, 1, 1, 0, time.UTC)
+	end := testDateRangeDtz(2126, 1, 1, 0, time.UTC)
+
+	dtIter, err := DateRangeIteratorDto{}.New(start, end, 1, TimeUnitNANOSECONDS, DateRangeStepCHAINED, false, true)
+
+	if err != nil {
+		t.Errorf("Error returned by DateRangeIteratorDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	for i := 1; i <= 3; i++ {
+
+		dtz, ok := dtIter.Next()
+
+		expected := end.DateTime.Add(time.Duration(-i))
+
+		if !ok || !dtz.DateTime.Equal(expected) {
+			t.Errorf("Error: Reverse Nanoseconds Value %v Expected='%v'. Instead, value='%v' ok='%v'",
+				i, expected.Format(FmtDateTimeYrMDayFmtStr), dtz.DateTime.Format(FmtDateTimeYrMDayFmtStr), ok)
+		}
+	}
+
+	// Reversed iterations return the forward series in reverse order.
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	tests := []struct {
+		start    DateTzDto
+		end      DateTzDto
+		step     int
+		unit     TimeUnitType
+		stepMode DateRangeStepModeType
+	}{
+		{testDateRangeDtz(2026, 1, 31, 9, loc), testDateRangeDtz(2046, 4, 15, 9, loc), 1,
+			TimeUnitMONTHS, DateRangeStepANCHORED},
+		{testDateRangeDtz(2026, 1, 31, 9, loc), testDateRangeDtz(2046, 4, 15, 9, loc), 1,
+			TimeUnitMONTHS, DateRangeStepCHAINED},
+		{testDateRangeDtz(2024, 2, 29, 0, loc), testDateRangeDtz(2044, 2, 29, 0, loc), 1,
+			TimeUnitYEARS, DateRangeStepANCHORED},
+		{testDateRangeDtz(2026, 3, 1, 2, loc), testDateRangeDtz(2027, 3, 31, 2, loc), 3,
+			TimeUnitDAYS, DateRangeStepANCHORED},
+		{testDateRangeDtz(2026, 3, 1, 2, loc), testDateRangeDtz(2027, 3, 31, 2, loc), 3,
+			TimeUnitDAYS, DateRangeStepCHAINED},
+		{testDateRangeDtz(2026, 3, 1, 0, loc), testDateRangeDtz(2026, 11, 30, 0, loc), 7,
+			TimeUnitHOURS, DateRangeStepANCHORED},
+	}
+
+	for i, test := range tests {
+
+		for _, includeEnd := range []bool{true, false} {
+
+			fwdIter, _ := DateRangeIteratorDto{}.New(test.start, test.end, test.step, test.unit,
+				test.stepMode, includeEnd, false)
+
+			forward, _ := fwdIter.GetAll()
+
+			revIter, _ := DateRangeIteratorDto{}.New(test.start, test.end, test.step, test.unit,
+				test.stepMode, includeEnd, true)
+
+			reverse, _ := revIter.GetAll()
+
+			if len(forward) == 0 || len(forward) != len(reverse) {
+				t.Errorf("Error: Test %v includeEnd=%v Expected %v reversed values. Instead, values='%v'",
+					i, includeEnd, len(forward), len(reverse))
+				continue
+			}
+
+			for j := range forward {
+
+				if !forward[j].DateTime.Equal(reverse[len(reverse)-1-j].DateTime) {
+					t.Errorf("Error: Test %v includeEnd=%v Value %v Expected='%v'. Instead, value='%v'",
+						i, includeEnd, j, forward[j].String(), reverse[len(reverse)-1-j].String())
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestDateRangeIteratorDto_New_01(t *testing.T) {
+
+	start := testDateRangeDtz(2026, 1, 1, 0, time.UTC)
+	end := testDateRangeDtz(2026, 2, 1, 0, time.UTC)
+
+	_, err := DateRangeIteratorDto{}.New(end, start, 1, TimeUnitDAYS, DateRangeStepANCHORED, true, false)
+
+	if err == nil {
+		t.Error("Error: Expected an error when the end precedes the start. Instead, no error was returned.")
+	}
+
+	_, err = DateRangeIteratorDto{}.New(start, end, 0, TimeUnitDAYS, DateRangeStepANCHORED, true, false)
+
+	if err == nil {
+		t.Error("Error: Expected an error for a zero step. Instead, no error was returned.")
+	}
+
+	_, err = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitNONE, DateRangeStepANCHORED, true, false)
+
+	if err == nil {
+		t.Error("Error: Expected an error for TimeUnitNONE. Instead, no error was returned.")
+	}
+
+	_, err = DateRangeIteratorDto{}.New(start, end, 1, TimeUnitDAYS, DateRangeStepModeType(7), true, false)
+
+	if err == nil {
+		t.Error("Error: Expected an error for an invalid step mode. Instead, no error was returned.")
+	}
+
+	if DateRangeStepCHAINED.String() != "Chained" {
+		t.Errorf("Error: Expected String()='Chained'. Instead, String()='%v'", DateRangeStepCHAINED.String())
+	}
+}
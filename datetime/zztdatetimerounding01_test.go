@@ -0,0 +1,274 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTzDto_RoundToUnit_01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05.000000000 -0700 MST"
+
+	t1 := time.Date(2018, time.Month(3), 6, 20, 7, 30, 0, time.UTC)
+
+	dtz, err := DateTzDto{}.New(t1, fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(t1). Error='%v'", err.Error())
+		return
+	}
+
+	tests := []struct {
+		roundMode RoundingModeType
+		expected  string
+	}{
+		{RoundHALFUP, "2018-03-06 20:15:00.000000000 +0000 UTC"},
+		{RoundHALFDOWN, "2018-03-06 20:00:00.000000000 +0000 UTC"},
+		{RoundHALFEVEN, "2018-03-06 20:00:00.000000000 +0000 UTC"},
+		{RoundTOWARDZERO, "2018-03-06 20:00:00.000000000 +0000 UTC"},
+		{RoundCEILING, "2018-03-06 20:15:00.000000000 +0000 UTC"},
+	}
+
+	for _, test := range tests {
+
+		dtz2, err := dtz.RoundToUnit(TimeUnitMINUTES, 15, test.roundMode)
+
+		if err != nil {
+			t.Errorf("Error returned by dtz.RoundToUnit(). Error='%v'", err.Error())
+			continue
+		}
+
+		if test.expected != dtz2.String() {
+			t.Errorf("Error: Expected %v result='%v'. Instead, result='%v'",
+				test.roundMode.String(), test.expected, dtz2.String())
+		}
+	}
+
+	// 20:22:30 is a tie between 20:15 and 20:30. Index of 20:15 is odd.
+	t2 := time.Date(2018, time.Month(3), 6, 20, 22, 30, 0, time.UTC)
+
+	dtz, _ = DateTzDto{}.New(t2, fmtStr)
+
+	dtz2, _ := dtz.RoundToUnit(TimeUnitMINUTES, 15, RoundHALFEVEN)
+
+	expected := "2018-03-06 20:30:00.000000000 +0000 UTC"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected HalfEven result='%v'. Instead, result='%v'", expected, dtz2.String())
+	}
+
+	_, err = dtz.RoundToUnit(TimeUnitMINUTES, 0, RoundHALFUP)
+
+	if err == nil {
+		t.Error("Error: Expected an error for step=0. No error was returned.")
+	}
+
+}
+
+func TestDateTzDto_FloorToUnit_01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	locKolkata, err := time.LoadLocation("Asia/Kolkata")
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation(\"Asia/Kolkata\"). Error='%v'", err.Error())
+		return
+	}
+
+	// Hour boundaries in a +05:30 zone fall on the local hour.
+	t1 := time.Date(2018, time.Month(3), 6, 20, 42, 18, 0, locKolkata)
+
+	dtz, _ := DateTzDto{}.New(t1, fmtStr)
+
+	dtz2, err := dtz.FloorToUnit(TimeUnitHOURS, 1)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.FloorToUnit(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := "2018-03-06 20:00:00 +0530 IST"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected hour floor='%v'. Instead, result='%v'", expected, dtz2.String())
+	}
+
+	locChicago, err := time.LoadLocation(TzIanaUsCentral)
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation(TzIanaUsCentral). Error='%v'", err.Error())
+		return
+	}
+
+	// 2018-03-11 is a 23-hour day in Chicago.
+	t2 := time.Date(2018, time.Month(3), 11, 18, 30, 0, 0, locChicago)
+
+	dtz, _ = DateTzDto{}.New(t2, fmtStr)
+
+	dtz2, _ = dtz.FloorToUnit(TimeUnitDAYS, 1)
+
+	expected = "2018-03-11 00:00:00 -0600 CST"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected day floor='%v'. Instead, result='%v'", expected, dtz2.String())
+	}
+
+	dtz2, _ = dtz.CeilingToUnit(TimeUnitDAYS, 1)
+
+	expected = "2018-03-12 00:00:00 -0500 CDT"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected day ceiling='%v'. Instead, result='%v'", expected, dtz2.String())
+	}
+
+	dtz2, _ = dtz.FloorToUnit(TimeUnitMONTHS, 3)
+
+	expected = "2018-01-01 00:00:00 -0600 CST"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected quarter floor='%v'. Instead, result='%v'", expected, dtz2.String())
+	}
+
+	dtz2, _ = dtz.CeilingToUnit(TimeUnitMONTHS, 1)
+
+	expected = "2018-04-01 00:00:00 -0500 CDT"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected month ceiling='%v'. Instead, result='%v'", expected, dtz2.String())
+	}
+
+	dtz2, _ = dtz.FloorToUnit(TimeUnitWEEKS, 1)
+
+	expected = "2018-03-05 00:00:00 -0600 CST"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected week floor='%v'. Instead, result='%v'", expected, dtz2.String())
+	}
+
+}
+
+func TestDateTzDto_FloorToUnit_02(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	locSaoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation(\"America/Sao_Paulo\"). Error='%v'", err.Error())
+		return
+	}
+
+	// On 2018-11-04 local midnight did not exist in Sao Paulo. Clocks
+	// moved from 00:00 to 01:00. The day began at 01:00.
+	t1 := time.Date(2018, time.Month(11), 4, 10, 0, 0, 0, locSaoPaulo)
+
+	dtz, _ := DateTzDto{}.New(t1, fmtStr)
+
+	dtz2, err := dtz.FloorToUnit(TimeUnitDAYS, 1)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.FloorToUnit(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := "2018-11-04 01:00:00 -0200 -02"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected day floor='%v'. Instead, result='%v'", expected, dtz2.String())
+	}
+
+}
+
+func TestTimeDurationDto_RoundToUnit_01(t *testing.T) {
+
+	t1 := time.Date(2018, time.Month(1), 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Duration(HourNanoSeconds + 7*MinuteNanoSeconds + 30*SecondNanoseconds))
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	tests := []struct {
+		roundMode RoundingModeType
+		expected  time.Duration
+	}{
+		{RoundHALFUP, time.Duration(HourNanoSeconds + 15*MinuteNanoSeconds)},
+		{RoundHALFDOWN, time.Duration(HourNanoSeconds)},
+		{RoundHALFEVEN, time.Duration(HourNanoSeconds)},
+		{RoundTOWARDZERO, time.Duration(HourNanoSeconds)},
+		{RoundCEILING, time.Duration(HourNanoSeconds + 15*MinuteNanoSeconds)},
+	}
+
+	for _, test := range tests {
+
+		t2Dur, err := tDur.RoundToUnit(TimeUnitMINUTES, 15, test.roundMode)
+
+		if err != nil {
+			t.Errorf("Error returned by tDur.RoundToUnit(). Error='%v'", err.Error())
+			continue
+		}
+
+		if test.expected != t2Dur.TimeDuration {
+			t.Errorf("Error: Expected %v duration='%v'. Instead, duration='%v'",
+				test.roundMode.String(), test.expected, t2Dur.TimeDuration)
+		}
+
+		if !t1.Equal(t2Dur.StartTimeDateTz.DateTime) {
+			t.Errorf("Error: Expected start time='%v'. Instead, start time='%v'",
+				t1, t2Dur.StartTimeDateTz.DateTime)
+		}
+	}
+
+}
+
+func TestTimeDurationDto_RoundToUnit_02(t *testing.T) {
+
+	t1 := time.Date(2018, time.Month(1), 15, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2018, time.Month(3), 2, 0, 0, 0, 0, time.UTC)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	t2Dur, err := tDur.FloorToUnit(TimeUnitMONTHS, 1)
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.FloorToUnit(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := time.Date(2018, time.Month(2), 15, 0, 0, 0, 0, time.UTC)
+
+	if !expected.Equal(t2Dur.EndTimeDateTz.DateTime) {
+		t.Errorf("Error: Expected month floor end time='%v'. Instead, end time='%v'",
+			expected, t2Dur.EndTimeDateTz.DateTime)
+	}
+
+	t2Dur, _ = tDur.RoundToUnit(TimeUnitMONTHS, 1, RoundHALFUP)
+
+	expected = time.Date(2018, time.Month(3), 15, 0, 0, 0, 0, time.UTC)
+
+	if !expected.Equal(t2Dur.EndTimeDateTz.DateTime) {
+		t.Errorf("Error: Expected month round end time='%v'. Instead, end time='%v'",
+			expected, t2Dur.EndTimeDateTz.DateTime)
+	}
+
+	t2Dur, _ = tDur.CeilingToUnit(TimeUnitYEARS, 1)
+
+	expected = time.Date(2019, time.Month(1), 15, 0, 0, 0, 0, time.UTC)
+
+	if !expected.Equal(t2Dur.EndTimeDateTz.DateTime) {
+		t.Errorf("Error: Expected year ceiling end time='%v'. Instead, end time='%v'",
+			expected, t2Dur.EndTimeDateTz.DateTime)
+	}
+
+}
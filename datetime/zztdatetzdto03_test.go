@@ -0,0 +1,187 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTzDto_StartOf_01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05.000000000 -0700 MST"
+
+	locChicago, err := time.LoadLocation(TzIanaUsCentral)
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation(TzIanaUsCentral). Error='%v'", err.Error())
+		return
+	}
+
+	// Wednesday
+	t1 := time.Date(2018, time.Month(5), 16, 14, 22, 5, 0, locChicago)
+
+	dtz, err := DateTzDto{}.New(t1, fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(t1). Error='%v'", err.Error())
+		return
+	}
+
+	tests := []struct {
+		unit          TimeUnitType
+		expectedStart string
+		expectedEnd   string
+	}{
+		{TimeUnitDAYS,
+			"2018-05-16 00:00:00.000000000 -0500 CDT",
+			"2018-05-16 23:59:59.999999999 -0500 CDT"},
+		{TimeUnitWEEKS,
+			"2018-05-14 00:00:00.000000000 -0500 CDT",
+			"2018-05-20 23:59:59.999999999 -0500 CDT"},
+		{TimeUnitMONTHS,
+			"2018-05-01 00:00:00.000000000 -0500 CDT",
+			"2018-05-31 23:59:59.999999999 -0500 CDT"},
+		{TimeUnitQUARTERS,
+			"2018-04-01 00:00:00.000000000 -0500 CDT",
+			"2018-06-30 23:59:59.999999999 -0500 CDT"},
+		{TimeUnitHALFYEARS,
+			"2018-01-01 00:00:00.000000000 -0600 CST",
+			"2018-06-30 23:59:59.999999999 -0500 CDT"},
+		{TimeUnitYEARS,
+			"2018-01-01 00:00:00.000000000 -0600 CST",
+			"2018-12-31 23:59:59.999999999 -0600 CST"},
+	}
+
+	for _, test := range tests {
+
+		dtzStart, err := dtz.StartOf(test.unit)
+
+		if err != nil {
+			t.Errorf("Error returned by dtz.StartOf(%v). Error='%v'", test.unit.String(), err.Error())
+			continue
+		}
+
+		if test.expectedStart != dtzStart.String() {
+			t.Errorf("Error: Expected StartOf(%v)='%v'. Instead, StartOf='%v'",
+				test.unit.String(), test.expectedStart, dtzStart.String())
+		}
+
+		dtzEnd, err := dtz.EndOf(test.unit)
+
+		if err != nil {
+			t.Errorf("Error returned by dtz.EndOf(%v). Error='%v'", test.unit.String(), err.Error())
+			continue
+		}
+
+		if test.expectedEnd != dtzEnd.String() {
+			t.Errorf("Error: Expected EndOf(%v)='%v'. Instead, EndOf='%v'",
+				test.unit.String(), test.expectedEnd, dtzEnd.String())
+		}
+	}
+
+	_, err = dtz.StartOf(TimeUnitHOURS)
+
+	if err == nil {
+		t.Error("Error: Expected an error for StartOf(TimeUnitHOURS). No error was returned.")
+	}
+
+}
+
+func TestDateTzDto_StartOfWeek_01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	// Wednesday
+	t1 := time.Date(2018, time.Month(5), 16, 14, 22, 5, 0, time.UTC)
+
+	dtz, _ := DateTzDto{}.New(t1, fmtStr)
+
+	dtz2, err := dtz.StartOfWeek(time.Sunday)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.StartOfWeek(time.Sunday). Error='%v'", err.Error())
+		return
+	}
+
+	expected := "2018-05-13 00:00:00 +0000 UTC"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected StartOfWeek='%v'. Instead, StartOfWeek='%v'", expected, dtz2.String())
+	}
+
+	dtz2, _ = dtz.EndOfWeek(time.Sunday)
+
+	expected = "2018-05-19 23:59:59 +0000 UTC"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected EndOfWeek='%v'. Instead, EndOfWeek='%v'", expected, dtz2.String())
+	}
+
+	// A Wednesday week starting on Wednesday begins the same day.
+	dtz2, _ = dtz.StartOfWeek(time.Wednesday)
+
+	expected = "2018-05-16 00:00:00 +0000 UTC"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected StartOfWeek='%v'. Instead, StartOfWeek='%v'", expected, dtz2.String())
+	}
+
+	_, err = dtz.StartOfWeek(time.Weekday(7))
+
+	if err == nil {
+		t.Error("Error: Expected an error for an invalid week day. No error was returned.")
+	}
+
+}
+
+func TestDateTzDto_StartOf_02(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	locSaoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation(\"America/Sao_Paulo\"). Error='%v'", err.Error())
+		return
+	}
+
+	// Local midnight did not exist on 2018-11-04 in Sao Paulo.
+	t1 := time.Date(2018, time.Month(11), 4, 15, 0, 0, 0, locSaoPaulo)
+
+	dtz, _ := DateTzDto{}.New(t1, fmtStr)
+
+	dtz2, err := dtz.StartOf(TimeUnitDAYS)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.StartOf(TimeUnitDAYS). Error='%v'", err.Error())
+		return
+	}
+
+	expected := "2018-11-04 01:00:00 -0200 -02"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected StartOf(Days)='%v'. Instead, StartOf='%v'", expected, dtz2.String())
+	}
+
+	// The previous day ends one nanosecond before the transition.
+	t2 := time.Date(2018, time.Month(11), 3, 12, 0, 0, 0, locSaoPaulo)
+
+	dtz, _ = DateTzDto{}.New(t2, "2006-01-02 15:04:05.000000000 -0700 MST")
+
+	dtz2, _ = dtz.EndOf(TimeUnitDAYS)
+
+	expected = "2018-11-03 23:59:59.999999999 -0300 -03"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected EndOf(Days)='%v'. Instead, EndOf='%v'", expected, dtz2.String())
+	}
+
+	// Week beginning Sunday 2018-11-04 begins at 01:00.
+	dtz2, _ = dtz.EndOfWeek(time.Sunday)
+
+	expected = "2018-11-03 23:59:59.999999999 -0300 -03"
+
+	if expected != dtz2.String() {
+		t.Errorf("Error: Expected EndOfWeek='%v'. Instead, EndOfWeek='%v'", expected, dtz2.String())
+	}
+
+}
@@ -0,0 +1,202 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTzDto_GetAge_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	tests := []struct {
+		birth    time.Time
+		ref      time.Time
+		leapDay  MonthEndOverflowType
+		years    int
+		months   int
+		dateDays int
+	}{
+		{time.Date(2000, 2, 29, 9, 0, 0, 0, loc), time.Date(2021, 2, 28, 8, 0, 0, 0, loc),
+			MonthEndOverflowCLAMP, 21, 0, 0},
+		{time.Date(2000, 2, 29, 9, 0, 0, 0, loc), time.Date(2021, 2, 28, 8, 0, 0, 0, loc),
+			MonthEndOverflowROLLNEXT, 20, 11, 30},
+		{time.Date(2000, 2, 29, 9, 0, 0, 0, loc), time.Date(2021, 2, 28, 8, 0, 0, 0, loc),
+			MonthEndOverflowNORMALIZE, 20, 11, 30},
+		{time.Date(2000, 2, 29, 9, 0, 0, 0, loc), time.Date(2021, 3, 1, 8, 0, 0, 0, loc),
+			MonthEndOverflowROLLNEXT, 21, 0, 0},
+		{time.Date(1990, 5, 15, 0, 0, 0, 0, loc), time.Date(2026, 10, 19, 0, 0, 0, 0, loc),
+			MonthEndOverflowCLAMP, 36, 5, 4},
+		{time.Date(1990, 5, 15, 0, 0, 0, 0, loc), time.Date(2026, 5, 15, 0, 0, 0, 0, loc),
+			MonthEndOverflowCLAMP, 36, 0, 0},
+		{time.Date(1995, 1, 31, 0, 0, 0, 0, loc), time.Date(2026, 3, 30, 0, 0, 0, 0, loc),
+			MonthEndOverflowCLAMP, 31, 1, 30},
+	}
+
+	for i, test := range tests {
+
+		birthDtz, _ := DateTzDto{}.New(test.birth, FmtDateTimeYrMDayFmtStr)
+		refDtz, _ := DateTzDto{}.New(test.ref, FmtDateTimeYrMDayFmtStr)
+
+		age, err := birthDtz.GetAge(refDtz, test.leapDay)
+
+		if err != nil {
+			t.Errorf("Error returned by birthDtz.GetAge() test %v. Error='%v'", i, err.Error())
+			continue
+		}
+
+		if age.Years != test.years || age.Months != test.months || age.DateDays != test.dateDays {
+			t.Errorf("Error: Test %v Expected %v-Years %v-Months %v-Days. Instead, %v-Years %v-Months %v-Days",
+				i, test.years, test.months, test.dateDays, age.Years, age.Months, age.DateDays)
+		}
+
+		if age.Weeks*7+age.WeekDays != age.DateDays {
+			t.Errorf("Error: Test %v Expected Weeks and WeekDays to total DateDays. Instead, Weeks='%v' WeekDays='%v'",
+				i, age.Weeks, age.WeekDays)
+		}
+	}
+}
+
+func TestDateTzDto_GetAge_02(t *testing.T) {
+
+	// GetAge matches TDurCalcTypeSTDYEARMTH allocation.
+	t1 := time.Date(1995, 1, 31, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	birthDtz, _ := DateTzDto{}.New(t1, FmtDateTimeYrMDayFmtStr)
+	refDtz, _ := DateTzDto{}.New(t2, FmtDateTimeYrMDayFmtStr)
+
+	for _, leapDay := range []MonthEndOverflowType{MonthEndOverflowNORMALIZE, MonthEndOverflowCLAMP} {
+
+		err = tDur.SetMonthEndOverflow(leapDay)
+
+		if err != nil {
+			t.Errorf("Error returned by tDur.SetMonthEndOverflow(). Error='%v'", err.Error())
+			return
+		}
+
+		age, _ := birthDtz.GetAge(refDtz, leapDay)
+
+		if int64(age.Years) != tDur.Years || int64(age.Months) != tDur.Months || int64(age.DateDays) != tDur.DateDays {
+			t.Errorf("Error: %v Expected %v-Years %v-Months %v-Days. Instead, %v-Years %v-Months %v-Days",
+				leapDay.String(), tDur.Years, tDur.Months, tDur.DateDays, age.Years, age.Months, age.DateDays)
+		}
+	}
+
+	// The reference date may not precede the date of birth.
+	_, err = refDtz.GetAge(birthDtz, MonthEndOverflowCLAMP)
+
+	if err == nil {
+		t.Error("Error: Expected an error when 'referenceDate' precedes the date of birth. Instead, no error was returned.")
+	}
+
+	_, err = birthDtz.GetAge(refDtz, MonthEndOverflowType(9))
+
+	if err == nil {
+		t.Error("Error: Expected an error for an invalid leap day policy. Instead, no error was returned.")
+	}
+}
+
+func TestDateTzDto_GetNextAnniversary_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	layout := "2006-01-02 15:04 MST"
+
+	birthDtz, _ := DateTzDto{}.New(time.Date(2000, 2, 29, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+	refDtz, _ := DateTzDto{}.New(time.Date(2026, 10, 19, 17, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	tests := []struct {
+		leapDay  MonthEndOverflowType
+		next     string
+		previous string
+		days     int
+	}{
+		{MonthEndOverflowCLAMP, "2027-02-28 09:00 CST", "2026-02-28 09:00 CST", 132},
+		{MonthEndOverflowROLLNEXT, "2027-03-01 09:00 CST", "2026-03-01 09:00 CST", 133},
+	}
+
+	for _, test := range tests {
+
+		next, err := birthDtz.GetNextAnniversary(refDtz, test.leapDay)
+
+		if err != nil {
+			t.Errorf("Error returned by birthDtz.GetNextAnniversary(). Error='%v'", err.Error())
+			return
+		}
+
+		if next.DateTime.Format(layout) != test.next {
+			t.Errorf("Error: %v Expected next='%v'. Instead, next='%v'",
+				test.leapDay.String(), test.next, next.DateTime.Format(layout))
+		}
+
+		previous, err := birthDtz.GetPreviousAnniversary(refDtz, test.leapDay)
+
+		if err != nil {
+			t.Errorf("Error returned by birthDtz.GetPreviousAnniversary(). Error='%v'", err.Error())
+			return
+		}
+
+		if previous.DateTime.Format(layout) != test.previous {
+			t.Errorf("Error: %v Expected previous='%v'. Instead, previous='%v'",
+				test.leapDay.String(), test.previous, previous.DateTime.Format(layout))
+		}
+
+		days, err := birthDtz.GetDaysUntilNextAnniversary(refDtz, test.leapDay)
+
+		if err != nil {
+			t.Errorf("Error returned by birthDtz.GetDaysUntilNextAnniversary(). Error='%v'", err.Error())
+			return
+		}
+
+		if days != test.days {
+			t.Errorf("Error: %v Expected days='%v'. Instead, days='%v'", test.leapDay.String(), test.days, days)
+		}
+	}
+
+	// On a leap year anniversary, next and previous are the same date.
+	refDtz, _ = DateTzDto{}.New(time.Date(2028, 2, 29, 23, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	next, _ := birthDtz.GetNextAnniversary(refDtz, MonthEndOverflowCLAMP)
+	previous, _ := birthDtz.GetPreviousAnniversary(refDtz, MonthEndOverflowCLAMP)
+	days, _ := birthDtz.GetDaysUntilNextAnniversary(refDtz, MonthEndOverflowCLAMP)
+
+	if next.DateTime.Format(layout) != "2028-02-29 09:00 CST" || !previous.DateTime.Equal(next.DateTime) || days != 0 {
+		t.Errorf("Error: Expected next=previous='2028-02-29 09:00 CST' days=0. Instead, next='%v' previous='%v' days='%v'",
+			next.DateTime.Format(layout), previous.DateTime.Format(layout), days)
+	}
+
+	// The reference date is converted to the time zone of the date of birth.
+	// 2027-03-01 03:00 UTC is 2027-02-28 21:00 CST.
+	refDtz, _ = DateTzDto{}.New(time.Date(2027, 3, 1, 3, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	days, _ = birthDtz.GetDaysUntilNextAnniversary(refDtz, MonthEndOverflowCLAMP)
+
+	if days != 0 {
+		t.Errorf("Error: Expected days='0'. Instead, days='%v'", days)
+	}
+
+	// Reference date before the date of birth
+	refDtz, _ = DateTzDto{}.New(time.Date(1999, 12, 31, 0, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	next, _ = birthDtz.GetNextAnniversary(refDtz, MonthEndOverflowCLAMP)
+
+	if !next.DateTime.Equal(birthDtz.DateTime) {
+		t.Errorf("Error: Expected next='%v'. Instead, next='%v'",
+			birthDtz.DateTime.Format(layout), next.DateTime.Format(layout))
+	}
+
+	_, err := birthDtz.GetPreviousAnniversary(refDtz, MonthEndOverflowCLAMP)
+
+	if err == nil {
+		t.Error("Error: Expected an error when 'referenceDate' precedes the date of birth. Instead, no error was returned.")
+	}
+}
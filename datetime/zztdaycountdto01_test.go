@@ -0,0 +1,196 @@
+package datetime
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestDayCountDto_ActAct_01(t *testing.T) {
+
+	// Actual/Actual examples from the ISDA memorandum
+	// "EMU and Market Conventions: Recent Developments".
+	tests := []struct {
+		name      string
+		start     time.Time
+		end       time.Time
+		frequency int
+		isda      string
+		icma      string
+	}{
+		{"Regular period", time.Date(2003, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2004, 5, 1, 0, 0, 0, 0, time.UTC),
+			2, "0.497724380567", "0.500000000000"},
+		{"Short first period", time.Date(1999, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(1999, 7, 1, 0, 0, 0, 0, time.UTC),
+			1, "0.410958904110", "0.410958904110"},
+		{"Long first period", time.Date(2002, 8, 15, 0, 0, 0, 0, time.UTC), time.Date(2003, 7, 15, 0, 0, 0, 0, time.UTC),
+			2, "0.915068493151", "0.915760869565"},
+		{"Short first period EOM", time.Date(2000, 1, 30, 0, 0, 0, 0, time.UTC), time.Date(2000, 6, 30, 0, 0, 0, 0, time.UTC),
+			2, "0.415300546448", "0.417582417582"},
+	}
+
+	for _, test := range tests {
+
+		startDtz, _ := DateTzDto{}.New(test.start, FmtDateTimeYrMDayFmtStr)
+		endDtz, _ := DateTzDto{}.New(test.end, FmtDateTimeYrMDayFmtStr)
+
+		dayCnt, err := DayCountDto{}.New(startDtz, endDtz, DayCountACTACTISDA, DayCountParamsDto{})
+
+		if err != nil {
+			t.Errorf("Error returned by DayCountDto{}.New(ACT/ACT ISDA) %v. Error='%v'", test.name, err.Error())
+			continue
+		}
+
+		yearFrac, _ := dayCnt.GetYearFraction(12, RoundHALFUP)
+
+		if yearFrac.String() != test.isda {
+			t.Errorf("Error: %v Expected ACT/ACT ISDA='%v'. Instead, yearFrac='%v'", test.name, test.isda, yearFrac.String())
+		}
+
+		dayCnt, err = DayCountDto{}.New(startDtz, endDtz, DayCountACTACTICMA,
+			DayCountParamsDto{CouponFrequency: test.frequency})
+
+		if err != nil {
+			t.Errorf("Error returned by DayCountDto{}.New(ACT/ACT ICMA) %v. Error='%v'", test.name, err.Error())
+			continue
+		}
+
+		yearFrac, _ = dayCnt.GetYearFraction(12, RoundHALFUP)
+
+		if yearFrac.String() != test.icma {
+			t.Errorf("Error: %v Expected ACT/ACT ICMA='%v'. Instead, yearFrac='%v'", test.name, test.icma, yearFrac.String())
+		}
+	}
+}
+
+func TestDayCountDto_30360_01(t *testing.T) {
+
+	feb28 := time.Date(2007, 2, 28, 0, 0, 0, 0, time.UTC)
+	aug31 := time.Date(2007, 8, 31, 0, 0, 0, 0, time.UTC)
+	feb29 := time.Date(2008, 2, 29, 0, 0, 0, 0, time.UTC)
+	jan15 := time.Date(2007, 1, 15, 0, 0, 0, 0, time.UTC)
+	jan31 := time.Date(2007, 1, 31, 0, 0, 0, 0, time.UTC)
+	mar31 := time.Date(2007, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	feb29Dtz, _ := DateTzDto{}.New(feb29, FmtDateTimeYrMDayFmtStr)
+
+	tests := []struct {
+		start      time.Time
+		end        time.Time
+		convention DayCountConventionType
+		params     DayCountParamsDto
+		dayCount   int64
+	}{
+		{feb28, aug31, DayCount30360US, DayCountParamsDto{EndOfMonth: true}, 180},
+		{feb28, aug31, DayCount30360US, DayCountParamsDto{}, 183},
+		{feb28, aug31, DayCount30E360, DayCountParamsDto{}, 182},
+		{feb28, aug31, DayCount30E360ISDA, DayCountParamsDto{}, 180},
+		{feb28, feb29, DayCount30360US, DayCountParamsDto{EndOfMonth: true}, 360},
+		{feb28, feb29, DayCount30360US, DayCountParamsDto{}, 361},
+		{feb28, feb29, DayCount30E360, DayCountParamsDto{}, 361},
+		{feb28, feb29, DayCount30E360ISDA, DayCountParamsDto{}, 360},
+		{feb28, feb29, DayCount30E360ISDA, DayCountParamsDto{TerminationDate: feb29Dtz}, 359},
+		{jan31, mar31, DayCount30360US, DayCountParamsDto{}, 60},
+		{jan31, mar31, DayCount30E360, DayCountParamsDto{}, 60},
+		{jan15, mar31, DayCount30360US, DayCountParamsDto{}, 76},
+		{jan15, mar31, DayCount30E360, DayCountParamsDto{}, 75},
+		{jan15, mar31, DayCount30E360ISDA, DayCountParamsDto{}, 75},
+	}
+
+	for i, test := range tests {
+
+		startDtz, _ := DateTzDto{}.New(test.start, FmtDateTimeYrMDayFmtStr)
+		endDtz, _ := DateTzDto{}.New(test.end, FmtDateTimeYrMDayFmtStr)
+
+		dayCnt, err := DayCountDto{}.New(startDtz, endDtz, test.convention, test.params)
+
+		if err != nil {
+			t.Errorf("Error returned by DayCountDto{}.New() test %v. Error='%v'", i, err.Error())
+			continue
+		}
+
+		if dayCnt.DayCount != test.dayCount {
+			t.Errorf("Error: Test %v %v Expected DayCount='%v'. Instead, DayCount='%v'",
+				i, test.convention.String(), test.dayCount, dayCnt.DayCount)
+		}
+
+		if dayCnt.GetYearFractionRat().Cmp(big.NewRat(test.dayCount, 360)) != 0 {
+			t.Errorf("Error: Test %v %v Expected year fraction='%v/360'. Instead, year fraction='%v'",
+				i, test.convention.String(), test.dayCount, dayCnt.GetYearFractionRat().String())
+		}
+	}
+}
+
+func TestDayCountDto_Actual_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	// 2026-07-16 04:00 UTC is 2026-07-15 23:00 CDT.
+	startDtz, _ := DateTzDto{}.New(time.Date(2026, 1, 15, 23, 30, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+	endDtz, _ := DateTzDto{}.New(time.Date(2026, 7, 16, 4, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	tests := []struct {
+		convention DayCountConventionType
+		expected   string
+	}{
+		{DayCountACT360, "0.502778"},
+		{DayCountACT365F, "0.495890"},
+		{DayCountACTACTISDA, "0.495890"},
+		{DayCount30E360, "0.500000"},
+	}
+
+	for _, test := range tests {
+
+		dayCnt, err := DayCountDto{}.New(startDtz, endDtz, test.convention, DayCountParamsDto{})
+
+		if err != nil {
+			t.Errorf("Error returned by DayCountDto{}.New(%v). Error='%v'", test.convention.String(), err.Error())
+			continue
+		}
+
+		if dayCnt.ActualDays != 181 {
+			t.Errorf("Error: %v Expected ActualDays='181'. Instead, ActualDays='%v'",
+				test.convention.String(), dayCnt.ActualDays)
+		}
+
+		yearFrac, _ := dayCnt.GetYearFraction(6, RoundHALFUP)
+
+		if yearFrac.String() != test.expected {
+			t.Errorf("Error: %v Expected yearFrac='%v'. Instead, yearFrac='%v'",
+				test.convention.String(), test.expected, yearFrac.String())
+		}
+	}
+
+	// The end date precedes the start date.
+	dayCnt, _ := DayCountDto{}.New(endDtz, startDtz, DayCountACT360, DayCountParamsDto{})
+
+	yearFrac, _ := dayCnt.GetYearFraction(6, RoundHALFUP)
+
+	if dayCnt.ActualDays != -181 || yearFrac.String() != "-0.502778" {
+		t.Errorf("Error: Expected ActualDays='-181' yearFrac='-0.502778'. Instead, ActualDays='%v' yearFrac='%v'",
+			dayCnt.ActualDays, yearFrac.String())
+	}
+
+	if dayCnt.EndDate.DateTime.Location() != time.UTC {
+		t.Errorf("Error: Expected EndDate Location='UTC'. Instead, Location='%v'",
+			dayCnt.EndDate.DateTime.Location().String())
+	}
+
+	_, err := DayCountDto{}.New(startDtz, endDtz, DayCountNONE, DayCountParamsDto{})
+
+	if err == nil {
+		t.Error("Error: Expected an error for DayCountNONE. Instead, no error was returned.")
+	}
+
+	for _, frequency := range []int{0, 5, 24} {
+
+		_, err = DayCountDto{}.New(startDtz, endDtz, DayCountACTACTICMA, DayCountParamsDto{CouponFrequency: frequency})
+
+		if err == nil {
+			t.Errorf("Error: Expected an error for CouponFrequency='%v'. Instead, no error was returned.", frequency)
+		}
+	}
+
+	if DayCountACTACTICMA.String() != "ACT/ACT ICMA" {
+		t.Errorf("Error: Expected String()='ACT/ACT ICMA'. Instead, String()='%v'", DayCountACTACTICMA.String())
+	}
+}
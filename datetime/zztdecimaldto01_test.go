@@ -0,0 +1,142 @@
+package datetime
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestDecimalDto_NewFrac_01(t *testing.T) {
+
+	// value = numerator / 10 rounded to zero decimal places
+	tests := []struct {
+		numerator int64
+		roundMode RoundingModeType
+		expected  string
+	}{
+		{25, RoundHALFUP, "3"},
+		{-25, RoundHALFUP, "-3"},
+		{25, RoundHALFEVEN, "2"},
+		{35, RoundHALFEVEN, "4"},
+		{-25, RoundHALFEVEN, "-2"},
+		{25, RoundHALFDOWN, "2"},
+		{26, RoundHALFDOWN, "3"},
+		{27, RoundTOWARDZERO, "2"},
+		{-27, RoundTOWARDZERO, "-2"},
+		{21, RoundAWAYFROMZERO, "3"},
+		{-21, RoundAWAYFROMZERO, "-3"},
+		{27, RoundFLOOR, "2"},
+		{-21, RoundFLOOR, "-3"},
+		{21, RoundCEILING, "3"},
+		{-27, RoundCEILING, "-2"},
+	}
+
+	for _, test := range tests {
+
+		dec, err := DecimalDto{}.NewFrac(big.NewInt(test.numerator), big.NewInt(10), 0, test.roundMode)
+
+		if err != nil {
+			t.Errorf("Error returned by DecimalDto{}.NewFrac(). Error='%v'", err.Error())
+			continue
+		}
+
+		if test.expected != dec.String() {
+			t.Errorf("Error: Expected %v/10 rounded %v='%v'. Instead, result='%v'",
+				test.numerator, test.roundMode.String(), test.expected, dec.String())
+		}
+	}
+
+	dec, _ := DecimalDto{}.NewFrac(big.NewInt(-1), big.NewInt(8), 4, RoundHALFEVEN)
+
+	if "-0.1250" != dec.String() {
+		t.Errorf("Error: Expected dec='-0.1250'. Instead, dec='%v'", dec.String())
+	}
+
+	_, err := DecimalDto{}.NewFrac(big.NewInt(1), big.NewInt(0), 4, RoundHALFUP)
+
+	if err == nil {
+		t.Error("Error: Expected an error for a zero denominator. No error was returned.")
+	}
+
+}
+
+func TestTimeDurationDto_GetDecimalDuration_01(t *testing.T) {
+
+	t1 := time.Date(2018, time.Month(1), 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Duration(26*HourNanoSeconds + 30*MinuteNanoSeconds + 20*SecondNanoseconds))
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	tests := []struct {
+		unit      TimeUnitType
+		precision uint
+		roundMode RoundingModeType
+		expected  string
+	}{
+		{TimeUnitHOURS, 4, RoundHALFUP, "26.5056"},
+		{TimeUnitHOURS, 4, RoundTOWARDZERO, "26.5055"},
+		{TimeUnitDAYS, 6, RoundHALFUP, "1.104398"},
+		{TimeUnitMINUTES, 2, RoundHALFEVEN, "1590.33"},
+		{TimeUnitNANOSECONDS, 0, RoundHALFUP, "95420000000000"},
+		{TimeUnitYEARS, 12, RoundHALFUP, "0.003023739428"},
+		{TimeUnitMONTHS, 8, RoundHALFUP, "0.03628487"},
+	}
+
+	for _, test := range tests {
+
+		dec, err := tDur.GetDecimalDuration(test.unit, test.precision, test.roundMode)
+
+		if err != nil {
+			t.Errorf("Error returned by tDur.GetDecimalDuration(). Error='%v'", err.Error())
+			continue
+		}
+
+		if test.expected != dec.String() {
+			t.Errorf("Error: Expected %v duration='%v'. Instead, duration='%v'",
+				test.unit.String(), test.expected, dec.String())
+		}
+	}
+
+	_, err = tDur.GetDecimalDuration(TimeUnitNONE, 2, RoundHALFUP)
+
+	if err == nil {
+		t.Error("Error: Expected an error for TimeUnitNONE. No error was returned.")
+	}
+
+}
+
+func TestTimeDto_GetDecimalDuration_01(t *testing.T) {
+
+	tDto, err := TimeDto{}.New(0, 0, 1, 3, 12, 0, 0, 0, 0, 0)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	dec, err := tDto.GetDecimalDuration(TimeUnitDAYS, 2, RoundHALFUP)
+
+	if err != nil {
+		t.Errorf("Error returned by tDto.GetDecimalDuration(). Error='%v'", err.Error())
+		return
+	}
+
+	if "10.50" != dec.String() {
+		t.Errorf("Error: Expected days='10.50'. Instead, days='%v'", dec.String())
+	}
+
+	tDto, _ = TimeDto{}.New(1, 0, 0, 0, 0, 0, 0, 0, 0, 0)
+
+	dec, _ = tDto.GetDecimalDuration(TimeUnitDAYS, 4, RoundHALFUP)
+
+	if "365.2425" != dec.String() {
+		t.Errorf("Error: Expected days='365.2425'. Instead, days='%v'", dec.String())
+	}
+
+}
@@ -0,0 +1,200 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func durationFormatTestDto(t *testing.T) TimeDurationDto {
+
+	t1 := time.Date(2018, time.Month(1), 1, 0, 0, 0, 0, time.UTC)
+
+	t2 := t1.AddDate(0, 0, 2).Add(time.Duration(3*HourNanoSeconds + 4*MinuteNanoSeconds +
+		5*SecondNanoseconds + 6*MilliSecondNanoseconds + 7*MicroSecondNanoseconds + 8))
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, ...). "+
+			"Error='%v'", err.Error())
+	}
+
+	return tDur
+}
+
+func TestDurationFormatDto_Format_01(t *testing.T) {
+
+	tDur := durationFormatTestDto(t)
+
+	durFmt, err := DurationFormatDto{}.New("{Years} {Months} {DateDays} {Hours} {Minutes} {Seconds!}")
+
+	if err != nil {
+		t.Errorf("Error returned by DurationFormatDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	str, err := tDur.GetFormattedDurationStr(durFmt)
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.GetFormattedDurationStr(durFmt). Error='%v'", err.Error())
+		return
+	}
+
+	expectedStr := "2-Days 3-Hours 4-Minutes 5-Seconds"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+}
+
+func TestDurationFormatDto_Format_02(t *testing.T) {
+
+	// Singular unit labels and clock style padding
+	tDur := durationFormatTestDto(t)
+
+	durFmt, _ := DurationFormatDto{}.New("{CumHours#:3}:{Minutes!#:2}:{Seconds!#:2}.{Milliseconds!#:3}")
+
+	str, err := durFmt.Format(tDur)
+
+	if err != nil {
+		t.Errorf("Error returned by durFmt.Format(tDur). Error='%v'", err.Error())
+		return
+	}
+
+	expectedStr := "051:04:05.006"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+	t1 := time.Date(2018, time.Month(1), 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Duration(HourNanoSeconds + MinuteNanoSeconds))
+
+	tDur2, _ := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	durFmt, _ = DurationFormatDto{}.New("{Hours}, {Minutes} and {Seconds}")
+	durFmt.UnitSeparator = " "
+	durFmt.ZeroSuppression = TDurZeroSuppressALL
+
+	str, _ = durFmt.Format(tDur2)
+
+	expectedStr = "1 Hour, 1 Minute"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+}
+
+func TestDurationFormatDto_Format_03(t *testing.T) {
+
+	// Largest and smallest units
+	tDur := durationFormatTestDto(t)
+
+	durFmt, _ := DurationFormatDto{}.New("{DateDays} {Hours} {Minutes} {Seconds} {Milliseconds}")
+	durFmt.LargestUnit = TimeUnitHOURS
+	durFmt.SmallestUnit = TimeUnitMINUTES
+
+	str, err := durFmt.Format(tDur)
+
+	if err != nil {
+		t.Errorf("Error returned by durFmt.Format(tDur). Error='%v'", err.Error())
+		return
+	}
+
+	expectedStr := "51-Hours 4-Minutes"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+	durFmt.LargestUnit = TimeUnitMILLISECONDS
+
+	_, err = durFmt.Format(tDur)
+
+	if err == nil {
+		t.Error("Error: Expected an error for LargestUnit=Milliseconds. No error was returned.")
+	}
+
+}
+
+func TestDurationFormatDto_Format_04(t *testing.T) {
+
+	// Trailing zero suppression and escaped braces
+	t1 := time.Date(2018, time.Month(1), 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Duration(3 * HourNanoSeconds))
+
+	tDur, _ := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	durFmt, _ := DurationFormatDto{}.New("{{{DateDays} {Hours} {Minutes} {Seconds}}}")
+	durFmt.ZeroSuppression = TDurZeroSuppressLEADINGTRAILING
+
+	str, _ := durFmt.Format(tDur)
+
+	expectedStr := "{3-Hours}"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+}
+
+func TestDurationFormatDto_New_01(t *testing.T) {
+
+	_, err := DurationFormatDto{}.New("{Hours} {Fortnights}")
+
+	if err == nil {
+		t.Error("Error: Expected an error for invalid placeholder '{Fortnights}'. No error was returned.")
+	}
+
+	_, err = DurationFormatDto{}.New("{Hours} {Minutes")
+
+	if err == nil {
+		t.Error("Error: Expected an error for unclosed placeholder. No error was returned.")
+	}
+
+}
+
+func TestDurationFormatDto_Predefined_01(t *testing.T) {
+
+	tDur := durationFormatTestDto(t)
+
+	str := tDur.GetYearMthDaysTimeStr()
+
+	expectedStr := "2-Days 3-Hours 4-Minutes 5-Seconds 6-Milliseconds 7-Microseconds 8-Nanoseconds"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+	str, _ = tDur.GetCumHoursTimeStr()
+
+	expectedStr = "51-Hours 4-Minutes 5-Seconds 6-Milliseconds 7-Microseconds 8-Nanoseconds"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+	// Cumulative minutes are recalculated from the time duration. The
+	// Minutes and Seconds fields of 'tDur' are not used.
+	str, _ = tDur.GetCumMinutesStr()
+
+	expectedStr = "3064-Minutes 5-Seconds 6-Milliseconds 7-Microseconds 8-Nanoseconds"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+	str = tDur.GetYrMthWkDayHrMinSecNanosecsStr()
+
+	expectedStr = "0-Years 0-Months 0-Weeks 2-WeekDays 3-Hours 4-Minutes 5-Seconds 6007008-Nanoseconds"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+}
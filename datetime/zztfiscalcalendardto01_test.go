@@ -0,0 +1,172 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiscalCalendarDto_GetFiscalDate_01(t *testing.T) {
+
+	fCal, err := FiscalCalendarDto{}.New(time.October, FiscalYearNamedByENDYEAR)
+
+	if err != nil {
+		t.Errorf("Error returned by FiscalCalendarDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	dtz, err := DateTzDto{}.NewDateTimeElements(2018, 11, 15, 10, 0, 0, 0,
+		TzIanaUsCentral, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTimeElements(). Error='%v'", err.Error())
+		return
+	}
+
+	fDate, err := fCal.GetFiscalDate(dtz)
+
+	if err != nil {
+		t.Errorf("Error returned by fCal.GetFiscalDate(dtz). Error='%v'", err.Error())
+		return
+	}
+
+	expected := FiscalDateDto{FiscalYear: 2019, HalfYear: 1, Quarter: 1, Period: 2, Week: 7, DayOfYear: 46}
+
+	if expected != fDate {
+		t.Errorf("Error: Expected fDate='%+v'. Instead, fDate='%+v'", expected, fDate)
+	}
+
+	startDtz, endDtz, err := fCal.GetBoundaries(2019, TimeUnitQUARTERS, 2,
+		TzIanaUsCentral, "2006-01-02 15:04:05 -0700 MST")
+
+	if err != nil {
+		t.Errorf("Error returned by fCal.GetBoundaries(). Error='%v'", err.Error())
+		return
+	}
+
+	if "2019-01-01 00:00:00 -0600 CST" != startDtz.String() {
+		t.Errorf("Error: Expected Q2 start='2019-01-01 00:00:00 -0600 CST'. Instead, start='%v'",
+			startDtz.String())
+	}
+
+	if "2019-04-01 00:00:00 -0500 CDT" != endDtz.String() {
+		t.Errorf("Error: Expected Q2 end='2019-04-01 00:00:00 -0500 CDT'. Instead, end='%v'",
+			endDtz.String())
+	}
+
+	tDur, err := fCal.GetTimeDuration(2019, TimeUnitQUARTERS, 1, TDurCalcTypeCUMDAYS,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by fCal.GetTimeDuration(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur.DateDays != 92 || tDur.Hours != 0 {
+		t.Errorf("Error: Expected Q1 duration of 92-Days. Instead, DateDays='%v' Hours='%v'",
+			tDur.DateDays, tDur.Hours)
+	}
+
+	_, _, err = fCal.GetBoundaries(2019, TimeUnitQUARTERS, 5, TzIanaUTC, "")
+
+	if err == nil {
+		t.Error("Error: Expected an error for quarter 5. No error was returned.")
+	}
+
+}
+
+func TestFiscalCalendarDto_NewWeekPattern_01(t *testing.T) {
+
+	// US National Retail Federation 4-5-4 calendar
+	fCal, err := FiscalCalendarDto{}.NewWeekPattern(time.February, FiscalWeekPattern454,
+		FiscalYearEndNEARESTDAYOFWEEK, time.Saturday, FiscalYearNamedBySTARTYEAR)
+
+	if err != nil {
+		t.Errorf("Error returned by FiscalCalendarDto{}.NewWeekPattern(). Error='%v'", err.Error())
+		return
+	}
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	startDtz, endDtz, err := fCal.GetBoundaries(2018, TimeUnitYEARS, 0, TzIanaUTC, fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by fCal.GetBoundaries(). Error='%v'", err.Error())
+		return
+	}
+
+	if "2018-02-04 00:00:00 +0000 UTC" != startDtz.String() ||
+		"2019-02-03 00:00:00 +0000 UTC" != endDtz.String() {
+		t.Errorf("Error: Expected FY2018 2018-02-04 to 2019-02-03. Instead, start='%v' end='%v'",
+			startDtz.String(), endDtz.String())
+	}
+
+	if 53 != fCal.GetWeeksInYear(2017) {
+		t.Errorf("Error: Expected 53 weeks in FY2017. Instead, weeks='%v'", fCal.GetWeeksInYear(2017))
+	}
+
+	if 52 != fCal.GetWeeksInYear(2018) {
+		t.Errorf("Error: Expected 52 weeks in FY2018. Instead, weeks='%v'", fCal.GetWeeksInYear(2018))
+	}
+
+	dtz, _ := DateTzDto{}.NewDateTimeElements(2018, 12, 25, 0, 0, 0, 0, TzIanaUTC, fmtStr)
+
+	fDate, err := fCal.GetFiscalDate(dtz)
+
+	if err != nil {
+		t.Errorf("Error returned by fCal.GetFiscalDate(dtz). Error='%v'", err.Error())
+		return
+	}
+
+	expected := FiscalDateDto{FiscalYear: 2018, HalfYear: 2, Quarter: 4, Period: 11, Week: 47, DayOfYear: 325}
+
+	if expected != fDate {
+		t.Errorf("Error: Expected fDate='%+v'. Instead, fDate='%+v'", expected, fDate)
+	}
+
+	// The 53rd week of FY2017 is added to period 12.
+	startDtz, endDtz, _ = fCal.GetBoundaries(2017, TimeUnitMONTHS, 12, TzIanaUTC, fmtStr)
+
+	tDur := endDtz.Sub(startDtz)
+
+	if time.Duration(5*WeekNanoSeconds) != tDur {
+		t.Errorf("Error: Expected FY2017 period 12 to contain 5 weeks. Instead, duration='%v'", tDur)
+	}
+
+}
+
+func TestFiscalCalendarDto_NewWeekPattern_02(t *testing.T) {
+
+	// Year ends on the last Saturday of September. 4-4-5 periods.
+	fCal, err := FiscalCalendarDto{}.NewWeekPattern(time.October, FiscalWeekPattern445,
+		FiscalYearEndLASTDAYOFWEEK, time.Saturday, FiscalYearNamedByENDYEAR)
+
+	if err != nil {
+		t.Errorf("Error returned by FiscalCalendarDto{}.NewWeekPattern(). Error='%v'", err.Error())
+		return
+	}
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	dtz, _ := DateTzDto{}.NewDateTimeElements(2018, 12, 1, 9, 0, 0, 0, TzIanaUsCentral, fmtStr)
+
+	startDtz, endDtz, err := fCal.GetBoundariesForDate(dtz, TimeUnitMONTHS)
+
+	if err != nil {
+		t.Errorf("Error returned by fCal.GetBoundariesForDate(). Error='%v'", err.Error())
+		return
+	}
+
+	if "2018-11-25 00:00:00 -0600 CST" != startDtz.String() ||
+		"2018-12-30 00:00:00 -0600 CST" != endDtz.String() {
+		t.Errorf("Error: Expected period 3 2018-11-25 to 2018-12-30. Instead, start='%v' end='%v'",
+			startDtz.String(), endDtz.String())
+	}
+
+	_, err = FiscalCalendarDto{}.NewWeekPattern(time.October, FiscalWeekPatternNONE,
+		FiscalYearEndLASTDAYOFWEEK, time.Saturday, FiscalYearNamedByENDYEAR)
+
+	if err == nil {
+		t.Error("Error: Expected an error for FiscalWeekPatternNONE. No error was returned.")
+	}
+
+}
@@ -0,0 +1,481 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHolidayCalendarDto_Easter_01(t *testing.T) {
+
+	gregorian := []string{"2018-04-01", "2019-04-21", "2020-04-12", "2024-03-31", "2038-04-25"}
+	orthodox := []string{"2018-04-08", "2019-04-28", "2020-04-19", "2021-05-02", "2024-05-05"}
+
+	for _, expected := range gregorian {
+
+		year, _ := time.Parse("2006-01-02", expected)
+
+		m, d := gregorianEasterDate(year.Year())
+
+		actual := time.Date(year.Year(), m, d, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+
+		if expected != actual {
+			t.Errorf("Error: Expected Easter='%v'. Instead, Easter='%v'", expected, actual)
+		}
+	}
+
+	for _, expected := range orthodox {
+
+		year, _ := time.Parse("2006-01-02", expected)
+
+		m, d := orthodoxEasterDate(year.Year())
+
+		actual := time.Date(year.Year(), m, d, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+
+		if expected != actual {
+			t.Errorf("Error: Expected Orthodox Easter='%v'. Instead, Orthodox Easter='%v'", expected, actual)
+		}
+	}
+}
+
+func TestHolidayCalendarDto_GetHolidays_01(t *testing.T) {
+
+	usCal := HolidayCalendarDto{}.NewUS()
+
+	holidays, err := usCal.GetHolidays(2021, TzIanaUsCentral)
+
+	if err != nil {
+		t.Errorf("Error returned by usCal.GetHolidays(2021). Error='%v'", err.Error())
+		return
+	}
+
+	if len(holidays) != 11 {
+		t.Errorf("Error: Expected 11 holidays. Instead, count='%v'", len(holidays))
+		return
+	}
+
+	expected := map[string][2]string{
+		"Martin Luther King Jr. Day":           {"2021-01-18", "2021-01-18"},
+		"Memorial Day":                         {"2021-05-31", "2021-05-31"},
+		"Juneteenth National Independence Day": {"2021-06-19", "2021-06-18"},
+		"Independence Day":                     {"2021-07-04", "2021-07-05"},
+		"Thanksgiving Day":                     {"2021-11-25", "2021-11-25"},
+		"Christmas Day":                        {"2021-12-25", "2021-12-24"},
+	}
+
+	for _, h := range holidays {
+
+		dates, ok := expected[h.Name]
+
+		if !ok {
+			continue
+		}
+
+		actualDate := h.Date.DateTime.Format("2006-01-02")
+		actualObserved := h.ObservedDate.DateTime.Format("2006-01-02")
+
+		if dates[0] != actualDate || dates[1] != actualObserved {
+			t.Errorf("Error: Expected %v Date='%v' Observed='%v'. Instead, Date='%v' Observed='%v'",
+				h.Name, dates[0], dates[1], actualDate, actualObserved)
+		}
+
+		if h.Date.TimeZone.LocationName != TzIanaUsCentral {
+			t.Errorf("Error: Expected Location='%v'. Instead, Location='%v'",
+				TzIanaUsCentral, h.Date.TimeZone.LocationName)
+		}
+	}
+
+	// New Year's Day 2022 falls on Saturday and is observed on 2021-12-31.
+	if !usCal.IsHoliday(2021, time.December, 31) {
+		t.Error("Error: Expected 2021-12-31 to be an observed holiday. It was NOT!")
+	}
+
+	if usCal.IsHoliday(2021, time.December, 30) {
+		t.Error("Error: Expected 2021-12-30 to NOT be a holiday. It WAS!")
+	}
+}
+
+func TestHolidayCalendarDto_NewUK_01(t *testing.T) {
+
+	ukCal := HolidayCalendarDto{}.NewUK()
+
+	dtz, err := DateTzDto{}.New(time.Date(2021, time.December, 28, 10, 0, 0, 0, time.UTC), "")
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	// Christmas Day (Saturday) is observed Monday the 27th. Boxing Day
+	// (Sunday) is observed Tuesday the 28th.
+	hDto, ok := ukCal.GetHoliday(dtz)
+
+	if !ok {
+		t.Error("Error: Expected 2021-12-28 to be a holiday. It was NOT!")
+		return
+	}
+
+	if hDto.Name != "Boxing Day" {
+		t.Errorf("Error: Expected Name='Boxing Day'. Instead, Name='%v'", hDto.Name)
+	}
+
+	if !ukCal.IsHoliday(2021, time.December, 27) {
+		t.Error("Error: Expected 2021-12-27 to be a holiday. It was NOT!")
+	}
+
+	if !ukCal.IsHoliday(2018, time.May, 28) {
+		t.Error("Error: Expected Spring Bank Holiday 2018-05-28. It was NOT a holiday!")
+	}
+}
+
+func TestHolidayCalendarDto_NewDEState_01(t *testing.T) {
+
+	byCal, err := HolidayCalendarDto{}.NewDEState("BY")
+
+	if err != nil {
+		t.Errorf("Error returned by NewDEState(\"BY\"). Error='%v'", err.Error())
+		return
+	}
+
+	if !byCal.IsHoliday(2018, time.May, 31) {
+		t.Error("Error: Expected Fronleichnam 2018-05-31 in Bavaria. It was NOT a holiday!")
+	}
+
+	if !byCal.IsHoliday(2018, time.October, 3) {
+		t.Error("Error: Expected Tag der Deutschen Einheit 2018-10-03. It was NOT a holiday!")
+	}
+
+	deCal := HolidayCalendarDto{}.NewDE()
+
+	if deCal.IsHoliday(2018, time.May, 31) {
+		t.Error("Error: Expected 2018-05-31 to NOT be a national holiday. It WAS!")
+	}
+
+	snCal, err := HolidayCalendarDto{}.NewDEState("sn")
+
+	if err != nil {
+		t.Errorf("Error returned by NewDEState(\"sn\"). Error='%v'", err.Error())
+		return
+	}
+
+	if !snCal.IsHoliday(2022, time.November, 16) {
+		t.Error("Error: Expected Buss- und Bettag 2022-11-16 in Saxony. It was NOT a holiday!")
+	}
+
+	if !snCal.IsHoliday(2017, time.November, 22) {
+		t.Error("Error: Expected Buss- und Bettag 2017-11-22 in Saxony. It was NOT a holiday!")
+	}
+
+	_, err = HolidayCalendarDto{}.NewDEState("XX")
+
+	if err == nil {
+		t.Error("Error: Expected an error for state code 'XX'. NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestHolidayCalendarDto_NewComposite_01(t *testing.T) {
+
+	regional := HolidayCalendarDto{}.New("Regional")
+
+	err := regional.AddRule(HolidayRuleDto{Name: "Orthodox Good Friday",
+		RuleType: HolidayRuleORTHODOXEASTER, Offset: -2})
+
+	if err != nil {
+		t.Errorf("Error returned by regional.AddRule(). Error='%v'", err.Error())
+		return
+	}
+
+	err = regional.AddRule(HolidayRuleDto{Name: "Christmas Day", RuleType: HolidayRuleFIXEDDATE,
+		Month: 1, Day: 7})
+
+	if err != nil {
+		t.Errorf("Error returned by regional.AddRule(). Error='%v'", err.Error())
+		return
+	}
+
+	cal := HolidayCalendarDto{}.NewComposite("Combined", HolidayCalendarDto{}.NewIN(), regional)
+
+	if !cal.IsHoliday(2021, time.April, 30) {
+		t.Error("Error: Expected Orthodox Good Friday 2021-04-30. It was NOT a holiday!")
+	}
+
+	if !cal.IsHoliday(2021, time.January, 26) {
+		t.Error("Error: Expected Republic Day 2021-01-26. It was NOT a holiday!")
+	}
+
+	// The regional "Christmas Day" replaces the national rule.
+	if cal.IsHoliday(2021, time.December, 25) {
+		t.Error("Error: Expected 2021-12-25 to NOT be a holiday. It WAS!")
+	}
+
+	if !cal.IsHoliday(2021, time.January, 7) {
+		t.Error("Error: Expected 2021-01-07 to be a holiday. It was NOT!")
+	}
+
+	err = regional.AddRule(HolidayRuleDto{Name: "Bad Rule", RuleType: HolidayRuleNTHWEEKDAY,
+		Month: 5, WeekDay: time.Monday, Nth: 0})
+
+	if err == nil {
+		t.Error("Error: Expected an error for Nth=0. NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestHolidayCalendarDto_NewFromJSON_01(t *testing.T) {
+
+	data := []byte(`{
+	  "name": "Acme Corp",
+	  "include": ["US"],
+	  "rules": [
+	    {"name": "Day After Thanksgiving", "type": "nthWeekday", "month": 11,
+	     "weekday": "thursday", "nth": 4, "offset": 1},
+	    {"name": "Company Anniversary", "type": "oneOff", "year": 2018, "month": 6, "day": 15}
+	  ]
+	}`)
+
+	cal, err := HolidayCalendarDto{}.NewFromJSON(data)
+
+	if err != nil {
+		t.Errorf("Error returned by NewFromJSON(). Error='%v'", err.Error())
+		return
+	}
+
+	if cal.Name != "Acme Corp" {
+		t.Errorf("Error: Expected Name='Acme Corp'. Instead, Name='%v'", cal.Name)
+	}
+
+	if !cal.IsHoliday(2018, time.November, 23) {
+		t.Error("Error: Expected 2018-11-23 to be a holiday. It was NOT!")
+	}
+
+	if !cal.IsHoliday(2018, time.June, 15) || cal.IsHoliday(2019, time.June, 15) {
+		t.Error("Error: Expected one-off holiday only on 2018-06-15.")
+	}
+
+	if !cal.IsHoliday(2018, time.July, 4) {
+		t.Error("Error: Expected included holiday 2018-07-04. It was NOT a holiday!")
+	}
+
+	_, err = HolidayCalendarDto{}.NewFromJSON([]byte(`{"rules": [{"name": "X", "type": "lunar"}]}`))
+
+	if err == nil {
+		t.Error("Error: Expected an error for rule type 'lunar'. NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestHolidayCalendarDto_NewFromYAML_01(t *testing.T) {
+
+	text := `
+# Regional calendar
+name: "Munich Office"
+include: [DE-BY]
+rules:
+  - name: Heiligabend
+    type: fixed
+    month: 12
+    day: 24
+  - name: Faschingsdienstag   # Shrove Tuesday
+    type: easter
+    offset: -47
+`
+
+	cal, err := HolidayCalendarDto{}.NewFromYAML([]byte(text))
+
+	if err != nil {
+		t.Errorf("Error returned by NewFromYAML(). Error='%v'", err.Error())
+		return
+	}
+
+	if cal.Name != "Munich Office" {
+		t.Errorf("Error: Expected Name='Munich Office'. Instead, Name='%v'", cal.Name)
+	}
+
+	if !cal.IsHoliday(2018, time.February, 13) {
+		t.Error("Error: Expected Faschingsdienstag 2018-02-13. It was NOT a holiday!")
+	}
+
+	if !cal.IsHoliday(2018, time.December, 24) {
+		t.Error("Error: Expected Heiligabend 2018-12-24. It was NOT a holiday!")
+	}
+
+	if !cal.IsHoliday(2018, time.August, 15) {
+		t.Error("Error: Expected Mariae Himmelfahrt 2018-08-15. It was NOT a holiday!")
+	}
+
+	_, err = HolidayCalendarDto{}.NewFromYAML([]byte("rules:\n  - name: X\n    month: twelve\n"))
+
+	if err == nil {
+		t.Error("Error: Expected an error for month 'twelve'. NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestHolidayCalendarDto_NewFromYAML_02(t *testing.T) {
+
+	text := `
+name: "Branch #7 Calendar"   # Quoted value containing '#'
+rules:
+  - name: 'Bank Holiday #1' # Comment
+    type: fixed
+    month: 3
+    day: 2
+  - name: St. Patrick's Day # Unquoted value. The comment is removed.
+    type: fixed
+    month: 3
+    day: 17
+  - name: "Founder's Day #3"
+    type: fixed
+    month: 4
+    day: 9
+`
+
+	cal, err := HolidayCalendarDto{}.NewFromYAML([]byte(text))
+
+	if err != nil {
+		t.Errorf("Error returned by NewFromYAML(). Error='%v'", err.Error())
+		return
+	}
+
+	if cal.Name != "Branch #7 Calendar" {
+		t.Errorf("Error: Expected Name='Branch #7 Calendar'. Instead, Name='%v'", cal.Name)
+	}
+
+	expected := []string{"Bank Holiday #1", "St. Patrick's Day", "Founder's Day #3"}
+
+	if len(cal.Rules) != len(expected) {
+		t.Errorf("Error: Expected %v rules. Instead, rules='%v'", len(expected), len(cal.Rules))
+		return
+	}
+
+	for i, name := range expected {
+		if cal.Rules[i].Name != name {
+			t.Errorf("Error: Expected rule Name='%v'. Instead, Name='%v'", name, cal.Rules[i].Name)
+		}
+	}
+}
+
+func TestHolidayCalendarDto_NewFromYAML_03(t *testing.T) {
+
+	// Compact sequence style. Rule list items begin in column zero.
+	text := `
+name: Compact
+rules:
+- name: Heiligabend
+  type: fixed
+  month: 12
+  day: 24
+-
+  name: Silvester
+  type: fixed
+  month: 12
+  day: 31
+include: [US]
+`
+
+	cal, err := HolidayCalendarDto{}.NewFromYAML([]byte(text))
+
+	if err != nil {
+		t.Errorf("Error returned by NewFromYAML(). Error='%v'", err.Error())
+		return
+	}
+
+	if !cal.IsHoliday(2018, time.December, 24) {
+		t.Error("Error: Expected Heiligabend 2018-12-24. It was NOT a holiday!")
+	}
+
+	if !cal.IsHoliday(2018, time.December, 31) {
+		t.Error("Error: Expected Silvester 2018-12-31. It was NOT a holiday!")
+	}
+
+	if !cal.IsHoliday(2018, time.July, 4) {
+		t.Error("Error: Expected Independence Day 2018-07-04. It was NOT a holiday!")
+	}
+
+	// A field in column zero after 'rules:' is not a rule field.
+	_, err = HolidayCalendarDto{}.NewFromYAML([]byte("rules:\n- name: X\nmonth: 1\n"))
+
+	if err == nil {
+		t.Error("Error: Expected an error for key 'month' in column zero. NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestHolidayCalendarDto_BusinessCalendar_01(t *testing.T) {
+
+	bCal := BusinessCalendarDto{}.NewSatSunWeekend(HolidayCalendarDto{}.NewUS())
+
+	dtz, err := DateTzDto{}.New(
+		time.Date(2018, time.July, 3, 9, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	dtz2, err := dtz.AddBusinessDays(1, bCal, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddBusinessDays(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := "2018-07-05"
+	actual := dtz2.DateTime.Format("2006-01-02")
+
+	if expected != actual {
+		t.Errorf("Error: Expected Date='%v'. Instead, Date='%v'", expected, actual)
+	}
+}
+
+func TestHolidayCalendarDto_IsHoliday_01(t *testing.T) {
+
+	// Holiday dates are cached per year and shared by copies of the
+	// calendar value.
+	usCal := HolidayCalendarDto{}.NewUS()
+
+	bCal := BusinessCalendarDto{}.NewSatSunWeekend(usCal)
+
+	if !bCal.Holidays.IsHoliday(2018, time.July, 4) {
+		t.Error("Error: Expected Independence Day 2018-07-04. It was NOT a holiday!")
+	}
+
+	if usCal.cache == nil || len(usCal.cache.years) == 0 {
+		t.Error("Error: Expected cached years in the original calendar value. Instead, the cache is empty.")
+	}
+
+	// The cache is discarded when the rules change.
+	err := usCal.AddRule(HolidayRuleDto{Name: "Company Day", RuleType: HolidayRuleFIXEDDATE,
+		Month: 8, Day: 1})
+
+	if err != nil {
+		t.Errorf("Error returned by usCal.AddRule(). Error='%v'", err.Error())
+		return
+	}
+
+	if !usCal.IsHoliday(2018, time.August, 1) {
+		t.Error("Error: Expected Company Day 2018-08-01 after AddRule(). It was NOT a holiday!")
+	}
+
+	usCal.Rules[len(usCal.Rules)-1].Day = 2
+
+	if usCal.IsHoliday(2018, time.August, 1) || !usCal.IsHoliday(2018, time.August, 2) {
+		t.Error("Error: Expected Company Day to move to 2018-08-02 after the rule was modified.")
+	}
+
+	// A struct literal computes holiday dates without a cache.
+	literalCal := HolidayCalendarDto{Name: "Literal", Rules: usCal.Rules}
+
+	if !literalCal.IsHoliday(2018, time.August, 2) || literalCal.IsHoliday(2018, time.August, 3) {
+		t.Error("Error: Expected Company Day 2018-08-02 in a struct literal calendar.")
+	}
+
+	// Counting business days over 30 years
+	dtz, _ := DateTzDto{}.New(time.Date(2000, time.January, 3, 9, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	dtz2, err := dtz.AddBusinessDays(30*250, bCal, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddBusinessDays(). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz2.DateTime.Year() != 2029 && dtz2.DateTime.Year() != 2030 {
+		t.Errorf("Error: Expected a date in 2029 or 2030. Instead, Date='%v'", dtz2.String())
+	}
+}
@@ -0,0 +1,426 @@
+package datetime
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testICalText = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Test//Test Calendar//EN\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Eastern Standard Time\r\n" +
+	"X-LIC-LOCATION:America/New_York\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:16010101T020000\r\n" +
+	"TZOFFSETFROM:-0400\r\n" +
+	"TZOFFSETTO:-0500\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:event-1@example.com\r\n" +
+	"DTSTAMP:20261001T120000Z\r\n" +
+	"DTSTART;TZID=America/Chicago:20261019T090000\r\n" +
+	"DTEND;TZID=America/Chicago:20261019T103000\r\n" +
+	"SUMMARY:Planning\\, Budget\\; Review\r\n" +
+	"DESCRIPTION:Line one\\nLine two\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:event-2@example.com\r\n" +
+	"DTSTART:20261019T140000Z\r\n" +
+	"DURATION:PT45M\r\n" +
+	"SUMMARY:UTC Call\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:event-3@example.com\r\n" +
+	"DTSTART:20261020T080000\r\n" +
+	"DTEND:20261020T083000\r\n" +
+	"SUMMARY:Floating\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:event-4@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20261225\r\n" +
+	"SUMMARY:Holiday\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:event-5@example.com\r\n" +
+	"DTSTART;TZID=Eastern Standard Time:20261031T090000\r\n" +
+	"DURATION:P1DT1H\r\n" +
+	"SUMMARY:Across DST\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestICalendarDto_NewFromICalendar_01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	iCal, err := ICalendarDto{}.NewFromICalendar(testICalText, TzIanaUsCentral, fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendarDto{}.NewFromICalendar(). Error='%v'", err.Error())
+		return
+	}
+
+	if iCal.ProductId != "-//Test//Test Calendar//EN" {
+		t.Errorf("Error: Expected ProductId='-//Test//Test Calendar//EN'. Instead, ProductId='%v'",
+			iCal.ProductId)
+	}
+
+	if len(iCal.Events) != 5 {
+		t.Errorf("Error: Expected 5 events. Instead, events='%v'", len(iCal.Events))
+		return
+	}
+
+	testCases := []struct {
+		start      string
+		end        string
+		duration   time.Duration
+		isAllDay   bool
+		isFloating bool
+	}{
+		{"2026-10-19 09:00:00 -0500 CDT", "2026-10-19 10:30:00 -0500 CDT", 90 * time.Minute, false, false},
+		{"2026-10-19 14:00:00 +0000 UTC", "2026-10-19 14:45:00 +0000 UTC", 45 * time.Minute, false, false},
+		{"2026-10-20 08:00:00 -0500 CDT", "2026-10-20 08:30:00 -0500 CDT", 30 * time.Minute, false, true},
+		{"2026-12-25 00:00:00 -0600 CST", "2026-12-26 00:00:00 -0600 CST", 24 * time.Hour, true, false},
+		// The nominal day spans the end of Daylight Savings Time.
+		{"2026-10-31 09:00:00 -0400 EDT", "2026-11-01 10:00:00 -0500 EST", 26 * time.Hour, false, false},
+	}
+
+	for i, tc := range testCases {
+
+		evt := iCal.Events[i]
+
+		if evt.Start.String() != tc.start {
+			t.Errorf("Error: Event %v Expected Start='%v'. Instead, Start='%v'", i, tc.start, evt.Start.String())
+		}
+
+		if evt.End.String() != tc.end {
+			t.Errorf("Error: Event %v Expected End='%v'. Instead, End='%v'", i, tc.end, evt.End.String())
+		}
+
+		if evt.Duration.TimeDuration != tc.duration {
+			t.Errorf("Error: Event %v Expected Duration='%v'. Instead, Duration='%v'",
+				i, tc.duration, evt.Duration.TimeDuration)
+		}
+
+		if evt.IsAllDay != tc.isAllDay {
+			t.Errorf("Error: Event %v Expected IsAllDay='%v'. Instead, IsAllDay='%v'", i, tc.isAllDay, evt.IsAllDay)
+		}
+
+		if evt.IsFloating != tc.isFloating {
+			t.Errorf("Error: Event %v Expected IsFloating='%v'. Instead, IsFloating='%v'",
+				i, tc.isFloating, evt.IsFloating)
+		}
+	}
+
+	if iCal.Events[0].Summary != "Planning, Budget; Review" {
+		t.Errorf("Error: Expected Summary='Planning, Budget; Review'. Instead, Summary='%v'",
+			iCal.Events[0].Summary)
+	}
+
+	if iCal.Events[0].Description != "Line one\nLine two" {
+		t.Errorf("Error: Expected Description='Line one\\nLine two'. Instead, Description='%v'",
+			iCal.Events[0].Description)
+	}
+
+	expectedStamp := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	if !iCal.Events[0].DtStamp.Equal(expectedStamp) {
+		t.Errorf("Error: Expected DtStamp='%v'. Instead, DtStamp='%v'", expectedStamp, iCal.Events[0].DtStamp)
+	}
+}
+
+func TestICalendarDto_NewFromICalendar_02(t *testing.T) {
+
+	badTexts := []string{
+		// Missing DTSTART
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		// DTEND and DURATION
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nDTSTART:20261019T090000Z\r\n" +
+			"DTEND:20261019T100000Z\r\nDURATION:PT1H\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		// Invalid DURATION
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nDTSTART:20261019T090000Z\r\n" +
+			"DURATION:PT1X\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		// Unknown TZID
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nDTSTART;TZID=Nowhere/City:20261019T090000\r\n" +
+			"END:VEVENT\r\nEND:VCALENDAR\r\n",
+		// End before start
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nDTSTART:20261019T090000Z\r\n" +
+			"DTEND:20261019T080000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+		// Missing END
+		"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1\r\nDTSTART:20261019T090000Z\r\nEND:VCALENDAR\r\n",
+	}
+
+	for i, text := range badTexts {
+
+		_, err := ICalendarDto{}.NewFromICalendar(text, TzIanaUTC, "")
+
+		if err == nil {
+			t.Errorf("Error: Expected an error for test text %v. Instead, no error was returned.", i)
+		}
+	}
+}
+
+func TestICalendarDto_GetICalendarStr_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	start, _ := DateTzDto{}.New(time.Date(2026, 10, 19, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+	end, _ := DateTzDto{}.New(time.Date(2026, 10, 19, 10, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	evt, err := ICalEventDto{}.New("event-1@example.com", "Status, Weekly; Team", start, end)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalEventDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	evt.DtStamp = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	evt.Description = strings.Repeat("A long description. ", 10)
+
+	iCal := ICalendarDto{}.New("-//Test//Test Calendar//EN")
+
+	iCal.AddEvent(evt)
+
+	icsText, err := iCal.GetICalendarStr()
+
+	if err != nil {
+		t.Errorf("Error returned by iCal.GetICalendarStr(). Error='%v'", err.Error())
+		return
+	}
+
+	expectedLines := []string{
+		"BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\nX-LIC-LOCATION:America/New_York\r\n",
+		"BEGIN:STANDARD\r\nDTSTART:20251102T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\n" +
+			"TZNAME:EST\r\nEND:STANDARD\r\n",
+		"BEGIN:DAYLIGHT\r\nDTSTART:20260308T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\n" +
+			"TZNAME:EDT\r\nEND:DAYLIGHT\r\n",
+		"BEGIN:STANDARD\r\nDTSTART:20261101T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\n" +
+			"TZNAME:EST\r\nEND:STANDARD\r\n",
+		"DTSTART;TZID=America/New_York:20261019T090000\r\n",
+		"DTEND;TZID=America/New_York:20261019T100000\r\n",
+		"DTSTAMP:20261001T120000Z\r\n",
+		"SUMMARY:Status\\, Weekly\\; Team\r\n",
+	}
+
+	for _, expected := range expectedLines {
+
+		if !strings.Contains(icsText, expected) {
+			t.Errorf("Error: Expected iCalendar text to contain '%v'. Instead, text='%v'", expected, icsText)
+		}
+	}
+
+	if strings.Count(icsText, "BEGIN:STANDARD") != 2 || strings.Count(icsText, "BEGIN:DAYLIGHT") != 1 {
+		t.Errorf("Error: Expected 2 STANDARD and 1 DAYLIGHT components. Instead, text='%v'", icsText)
+	}
+
+	for _, line := range strings.Split(icsText, "\r\n") {
+
+		if len(line) > 75 {
+			t.Errorf("Error: Expected lines of 75 octets or less. Instead, line='%v'", line)
+		}
+	}
+
+	// Round trip
+	iCal2, err := ICalendarDto{}.NewFromICalendar(icsText, TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendarDto{}.NewFromICalendar(). Error='%v'", err.Error())
+		return
+	}
+
+	if len(iCal2.Events) != 1 {
+		t.Errorf("Error: Expected 1 event. Instead, events='%v'", len(iCal2.Events))
+		return
+	}
+
+	evt2 := iCal2.Events[0]
+
+	if !evt2.Start.DateTime.Equal(start.DateTime) || evt2.Start.DateTime.Location().String() != TzIanaUsEast {
+		t.Errorf("Error: Expected Start='%v'. Instead, Start='%v'", start.String(), evt2.Start.String())
+	}
+
+	if evt2.Summary != evt.Summary || evt2.Description != evt.Description {
+		t.Errorf("Error: Expected Summary='%v' Description='%v'. Instead, Summary='%v' Description='%v'",
+			evt.Summary, evt.Description, evt2.Summary, evt2.Description)
+	}
+}
+
+func TestICalendarDto_GetICalendarStr_02(t *testing.T) {
+
+	// Recurring event covers the years through UNTIL. Zones without
+	// Daylight Savings Time generate a single STANDARD component.
+	locNY, _ := time.LoadLocation(TzIanaUsEast)
+	locIN, _ := time.LoadLocation("Asia/Kolkata")
+
+	start, _ := DateTzDto{}.New(time.Date(2026, 1, 5, 9, 0, 0, 0, locNY), FmtDateTimeYrMDayFmtStr)
+	end, _ := DateTzDto{}.New(time.Date(2026, 1, 5, 10, 0, 0, 0, locNY), FmtDateTimeYrMDayFmtStr)
+
+	evt1, _ := ICalEventDto{}.New("event-1", "Weekly", start, end)
+	evt1.RRule = "FREQ=WEEKLY;UNTIL=20271231T235959Z"
+	evt1.DtStamp = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	start, _ = DateTzDto{}.New(time.Date(2026, 3, 2, 9, 0, 0, 0, locIN), FmtDateTimeYrMDayFmtStr)
+	end, _ = DateTzDto{}.New(time.Date(2026, 3, 2, 10, 0, 0, 0, locIN), FmtDateTimeYrMDayFmtStr)
+
+	evt2, _ := ICalEventDto{}.New("event-2", "Mumbai", start, end)
+	evt2.DtStamp = evt1.DtStamp
+
+	iCal := ICalendarDto{}.New("")
+	iCal.AddEvent(evt1)
+	iCal.AddEvent(evt2)
+
+	icsText, err := iCal.GetICalendarStr()
+
+	if err != nil {
+		t.Errorf("Error returned by iCal.GetICalendarStr(). Error='%v'", err.Error())
+		return
+	}
+
+	// America/New_York: initial STANDARD + 2 transitions per year
+	if strings.Count(icsText, "TZOFFSETTO:-0400") != 2 || strings.Count(icsText, "TZOFFSETTO:-0500") != 3 {
+		t.Errorf("Error: Expected transitions for 2026 and 2027. Instead, text='%v'", icsText)
+	}
+
+	if !strings.Contains(icsText, "DTSTART:20271107T020000") {
+		t.Errorf("Error: Expected the 2027 transition 'DTSTART:20271107T020000'. Instead, text='%v'", icsText)
+	}
+
+	if strings.Count(icsText, "TZOFFSETTO:+0530") != 1 {
+		t.Errorf("Error: Expected a single Asia/Kolkata component. Instead, text='%v'", icsText)
+	}
+
+	if !strings.Contains(icsText, "RRULE:FREQ=WEEKLY;UNTIL=20271231T235959Z\r\n") {
+		t.Errorf("Error: Expected RRULE in iCalendar text. Instead, text='%v'", icsText)
+	}
+
+	// Events in UTC do not require a VTIMEZONE.
+	utcStart, _ := DateTzDto{}.New(time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	evt3, _ := ICalEventDto{}.New("event-3", "UTC", utcStart, utcStart)
+
+	iCal = ICalendarDto{}.New("")
+	iCal.AddEvent(evt3)
+
+	icsText, _ = iCal.GetICalendarStr()
+
+	if strings.Contains(icsText, "VTIMEZONE") || !strings.Contains(icsText, "DTSTART:20260302T090000Z") {
+		t.Errorf("Error: Expected a UTC DTSTART without VTIMEZONE. Instead, text='%v'", icsText)
+	}
+}
+
+func TestICalendarDto_GetICalendarStr_03(t *testing.T) {
+
+	// A COUNT rule starting 2026-12-01 runs through June 2027. The
+	// VTIMEZONE must include the 2027 transitions.
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	start, _ := DateTzDto{}.New(time.Date(2026, 12, 1, 10, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+	end, _ := DateTzDto{}.New(time.Date(2026, 12, 1, 11, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	evt, _ := ICalEventDto{}.New("event-1", "Weekly", start, end)
+	evt.RRule = "FREQ=WEEKLY;COUNT=30"
+	evt.DtStamp = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	iCal := ICalendarDto{}.New("")
+	iCal.AddEvent(evt)
+
+	icsText, err := iCal.GetICalendarStr()
+
+	if err != nil {
+		t.Errorf("Error returned by iCal.GetICalendarStr(). Error='%v'", err.Error())
+		return
+	}
+
+	if !strings.Contains(icsText, "BEGIN:DAYLIGHT\r\nDTSTART:20270314T020000\r\n") {
+		t.Errorf("Error: Expected the 2027 DAYLIGHT transition 'DTSTART:20270314T020000'. "+
+			"Instead, text='%v'", icsText)
+	}
+
+	if strings.Contains(icsText, "DTSTART:20280312T020000") {
+		t.Errorf("Error: Expected no 2028 transitions. Instead, text='%v'", icsText)
+	}
+
+	// An unlimited rule covers iCalOpenRRuleYears years after the later
+	// of the DTSTART year and the current year.
+	evt.RRule = "FREQ=WEEKLY"
+
+	iCal = ICalendarDto{}.New("")
+	iCal.AddEvent(evt)
+
+	icsText, err = iCal.GetICalendarStr()
+
+	if err != nil {
+		t.Errorf("Error returned by iCal.GetICalendarStr(). Error='%v'", err.Error())
+		return
+	}
+
+	lastYear := 2026
+
+	if nowYear := time.Now().Year(); nowYear > lastYear {
+		lastYear = nowYear
+	}
+
+	lastYear += iCalOpenRRuleYears
+
+	lastTransition := time.Date(lastYear, 11, 1, 0, 0, 0, 0, loc)
+
+	for lastTransition.Weekday() != time.Sunday {
+		lastTransition = lastTransition.AddDate(0, 0, 1)
+	}
+
+	expected := "DTSTART:" + lastTransition.Format("20060102") + "T020000"
+
+	if !strings.Contains(icsText, expected) {
+		t.Errorf("Error: Expected the %v transition '%v'. Instead, text='%v'", lastYear, expected, icsText)
+	}
+
+	if strings.Contains(icsText, "DTSTART:"+strconv.Itoa(lastYear+1)) {
+		t.Errorf("Error: Expected no transitions after %v. Instead, text='%v'", lastYear, icsText)
+	}
+}
+
+func TestICalendarUtility_ParseICalDuration_01(t *testing.T) {
+
+	testCases := []struct {
+		value string
+		days  int
+		exact time.Duration
+	}{
+		{"P1W", 7, 0},
+		{"P15DT5H0M20S", 15, 5*time.Hour + 20*time.Second},
+		{"PT90M", 0, 90 * time.Minute},
+		{"-PT15M", 0, -15 * time.Minute},
+		{"+P2D", 2, 0},
+	}
+
+	for _, tc := range testCases {
+
+		days, exact, err := parseICalDuration(tc.value)
+
+		if err != nil {
+			t.Errorf("Error returned by parseICalDuration(%v). Error='%v'", tc.value, err.Error())
+			continue
+		}
+
+		if days != tc.days || exact != tc.exact {
+			t.Errorf("Error: value='%v' Expected days='%v' exact='%v'. Instead, days='%v' exact='%v'",
+				tc.value, tc.days, tc.exact, days, exact)
+		}
+	}
+
+	for _, value := range []string{"", "P", "PT", "1D", "PT1D", "P1H", "P1DT", "PDT1H"} {
+
+		_, _, err := parseICalDuration(value)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error for value='%v'. Instead, no error was returned.", value)
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package datetime
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// testTreeBaseTime - The base time of the random test intervals
+var testTreeBaseTime = time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+
+// testTreeDateTzDto - Returns a DateTzDto 'minutes' after testTreeBaseTime.
+func testTreeDateTzDto(minutes int) DateTzDto {
+
+	dtz, _ := DateTzDto{}.New(testTreeBaseTime.Add(time.Duration(minutes)*time.Minute), FmtDateTimeYrMDayFmtStr)
+
+	return dtz
+}
+
+// testTreeInterval - Returns the interval [startMinute, endMinute).
+func testTreeInterval(startMinute, endMinute int) TimeIntervalDto {
+
+	interval, _ := TimeIntervalDto{}.New(testTreeDateTzDto(startMinute), testTreeDateTzDto(endMinute))
+
+	return interval
+}
+
+// testTreeRandomEntries - Returns 'n' random entries with durations of
+// zero to two hours spread over 'n' hours.
+func testTreeRandomEntries(n int, seed int64) []IntervalTreeEntryDto {
+
+	rnd := rand.New(rand.NewSource(seed))
+
+	entries := make([]IntervalTreeEntryDto, n)
+
+	for i := range entries {
+
+		start := rnd.Intn(n * 60)
+
+		entries[i] = IntervalTreeEntryDto{
+			Interval: testTreeInterval(start, start+rnd.Intn(121)),
+			Id:       fmt.Sprintf("B%06d", i),
+		}
+	}
+
+	return entries
+}
+
+func testTreeCompareEntries(t *testing.T, label string, actual, expected []IntervalTreeEntryDto) {
+
+	if len(actual) != len(expected) {
+		t.Errorf("Error: %v Expected %v entries. Instead, entries='%v'", label, len(expected), len(actual))
+		return
+	}
+
+	ids := make(map[string]bool)
+
+	for _, entry := range expected {
+		ids[entry.Id] = true
+	}
+
+	for _, entry := range actual {
+
+		if !ids[entry.Id] {
+			t.Errorf("Error: %v Unexpected entry Id='%v'", label, entry.Id)
+		}
+	}
+}
+
+func TestIntervalTreeDto_Queries_01(t *testing.T) {
+
+	entries := testTreeRandomEntries(2000, 1)
+
+	tree := IntervalTreeDto{}.New()
+
+	for _, entry := range entries {
+		tree.Insert(entry.Interval, entry.Id)
+	}
+
+	if tree.GetLength() != len(entries) {
+		t.Errorf("Error: Expected GetLength()='%v'. Instead, GetLength()='%v'", len(entries), tree.GetLength())
+	}
+
+	// Delete every third entry.
+	remaining := make([]IntervalTreeEntryDto, 0, len(entries))
+
+	for i, entry := range entries {
+
+		if i%3 != 0 {
+			remaining = append(remaining, entry)
+			continue
+		}
+
+		if !tree.Delete(entry.Interval, entry.Id) {
+			t.Errorf("Error: Expected Delete('%v')='true'. Instead, Delete='false'", entry.Id)
+		}
+	}
+
+	if tree.Delete(entries[0].Interval, entries[0].Id) {
+		t.Error("Error: Expected Delete() of a deleted entry to return 'false'.")
+	}
+
+	if tree.GetLength() != len(remaining) {
+		t.Errorf("Error: Expected GetLength()='%v'. Instead, GetLength()='%v'", len(remaining), tree.GetLength())
+	}
+
+	all := tree.GetAll()
+
+	for i := 1; i < len(all); i++ {
+
+		if all[i].Interval.StartTime.DateTime.Before(all[i-1].Interval.StartTime.DateTime) {
+			t.Error("Error: Expected GetAll() to return entries ordered by start time.")
+			break
+		}
+	}
+
+	// AVL trees are no taller than 1.44 * log2(n + 2).
+	maxHeight := int(1.44*math.Log2(float64(len(remaining)+2))) + 1
+
+	if tree.root.height > maxHeight {
+		t.Errorf("Error: Expected tree height <= '%v'. Instead, height='%v'", maxHeight, tree.root.height)
+	}
+
+	rnd := rand.New(rand.NewSource(2))
+
+	for q := 0; q < 200; q++ {
+
+		point := rnd.Intn(2000 * 60)
+
+		dtz := testTreeDateTzDto(point)
+
+		expected := make([]IntervalTreeEntryDto, 0)
+
+		for _, entry := range remaining {
+
+			if entry.Interval.Contains(dtz) {
+				expected = append(expected, entry)
+			}
+		}
+
+		testTreeCompareEntries(t, "GetCovering()", tree.GetCovering(dtz), expected)
+
+		query := testTreeInterval(point, point+rnd.Intn(90))
+
+		expected = expected[:0]
+
+		for _, entry := range remaining {
+
+			if entry.Interval.Overlaps(query) {
+				expected = append(expected, entry)
+			}
+		}
+
+		testTreeCompareEntries(t, "GetOverlapping()", tree.GetOverlapping(query), expected)
+
+		if tree.HasOverlap(query) != (len(expected) > 0) {
+			t.Errorf("Error: Expected HasOverlap('%v')='%v'. Instead, HasOverlap='%v'",
+				query.String(), len(expected) > 0, !(len(expected) > 0))
+		}
+	}
+}
+
+func TestIntervalTreeDto_Queries_02(t *testing.T) {
+
+	tree := IntervalTreeDto{}.New()
+
+	tree.Insert(testTreeInterval(540, 600), "A") // 09:00 - 10:00
+	tree.Insert(testTreeInterval(570, 660), "B") // 09:30 - 11:00
+	tree.Insert(testTreeInterval(660, 720), "C") // 11:00 - 12:00
+	tree.Insert(testTreeInterval(785, 785), "E") // Empty 13:05
+	tree.Insert(testTreeInterval(840, 900), "D") // 14:00 - 15:00
+	tree.Insert(testTreeInterval(840, 900), "D") // Duplicate
+
+	if tree.GetLength() != 5 {
+		t.Errorf("Error: Expected GetLength()='5'. Instead, GetLength()='%v'", tree.GetLength())
+	}
+
+	covering := tree.GetCovering(testTreeDateTzDto(845))
+
+	if len(covering) != 1 || covering[0].Id != "D" {
+		t.Errorf("Error: Expected GetCovering(14:05)='[D]'. Instead, GetCovering='%v'", covering)
+	}
+
+	covering = tree.GetCovering(testTreeDateTzDto(660))
+
+	if len(covering) != 1 || covering[0].Id != "C" {
+		t.Errorf("Error: Expected GetCovering(11:00)='[C]'. Instead, GetCovering='%v'", covering)
+	}
+
+	if tree.HasOverlap(testTreeInterval(720, 840)) {
+		t.Error("Error: Expected HasOverlap(12:00 - 14:00)='false'. Instead, HasOverlap='true'")
+	}
+
+	// Empty intervals overlap nothing.
+	if tree.HasOverlap(testTreeInterval(784, 786)) {
+		t.Error("Error: Expected HasOverlap(13:04 - 13:06)='false'. Instead, HasOverlap='true'")
+	}
+
+	if !tree.HasOverlap(testTreeInterval(899, 901)) {
+		t.Error("Error: Expected HasOverlap(14:59 - 15:01)='true'. Instead, HasOverlap='false'")
+	}
+
+	testCases := []struct {
+		searchStart int
+		searchEnd   int
+		duration    time.Duration
+		found       bool
+		slotStart   int
+	}{
+		{480, 1020, time.Hour, true, 480},      // 08:00 free
+		{540, 1020, time.Hour, true, 720},      // Blocked by A, B and C
+		{540, 1020, 2 * time.Hour, true, 720},  // 12:00 - 14:00 fits exactly
+		{540, 1020, 2*time.Hour + 1, false, 0}, // Blocked by D. No slot ends by 17:00
+		{540, 1080, 3 * time.Hour, true, 900},  // After D. Ends at 18:00
+	}
+
+	for i, tc := range testCases {
+
+		slot, found, err := tree.FindFreeSlot(
+			testTreeDateTzDto(tc.searchStart), testTreeDateTzDto(tc.searchEnd), tc.duration)
+
+		if err != nil {
+			t.Errorf("Error returned by tree.FindFreeSlot(). Error='%v'", err.Error())
+			continue
+		}
+
+		if found != tc.found {
+			t.Errorf("Error: Test %v Expected found='%v'. Instead, found='%v'", i, tc.found, found)
+			continue
+		}
+
+		expectedStart := testTreeDateTzDto(tc.slotStart)
+
+		if found && !slot.StartTime.DateTime.Equal(expectedStart.DateTime) {
+			t.Errorf("Error: Test %v Expected slot start='%v'. Instead, slot='%v'",
+				i, expectedStart.String(), slot.String())
+		}
+	}
+
+	_, _, err := tree.FindFreeSlot(testTreeDateTzDto(540), testTreeDateTzDto(1000), 0)
+
+	if err == nil {
+		t.Error("Error: Expected an error for a zero duration. Instead, no error was returned.")
+	}
+}
+
+// testTreeBenchmarkTrees - Trees shared by the benchmarks, keyed by size
+var testTreeBenchmarkTrees = make(map[int]*IntervalTreeDto)
+
+func testTreeBenchmarkTree(n int) *IntervalTreeDto {
+
+	if tree, ok := testTreeBenchmarkTrees[n]; ok {
+		return tree
+	}
+
+	tree := IntervalTreeDto{}.New()
+
+	for _, entry := range testTreeRandomEntries(n, 3) {
+		tree.Insert(entry.Interval, entry.Id)
+	}
+
+	testTreeBenchmarkTrees[n] = &tree
+
+	return &tree
+}
+
+// Run with: go test -run XXX -bench IntervalTree
+//
+// The time per operation grows with log(n). Multiplying the number of
+// entries by ten adds a roughly constant amount of time per operation.
+func BenchmarkIntervalTreeDto_HasOverlap(b *testing.B) {
+
+	for _, n := range []int{1000, 10000, 100000} {
+
+		tree := testTreeBenchmarkTree(n)
+
+		queries := make([]TimeIntervalDto, 1024)
+
+		rnd := rand.New(rand.NewSource(4))
+
+		for i := range queries {
+			start := rnd.Intn(n * 60)
+			queries[i] = testTreeInterval(start, start+30)
+		}
+
+		b.Run(fmt.Sprintf("n=%v", n), func(b *testing.B) {
+
+			for i := 0; i < b.N; i++ {
+				tree.HasOverlap(queries[i%len(queries)])
+			}
+		})
+	}
+}
+
+func BenchmarkIntervalTreeDto_GetCovering(b *testing.B) {
+
+	for _, n := range []int{1000, 10000, 100000} {
+
+		tree := testTreeBenchmarkTree(n)
+
+		points := make([]DateTzDto, 1024)
+
+		rnd := rand.New(rand.NewSource(5))
+
+		for i := range points {
+			points[i] = testTreeDateTzDto(rnd.Intn(n * 60))
+		}
+
+		b.Run(fmt.Sprintf("n=%v", n), func(b *testing.B) {
+
+			for i := 0; i < b.N; i++ {
+				tree.GetCovering(points[i%len(points)])
+			}
+		})
+	}
+}
+
+func BenchmarkIntervalTreeDto_InsertDelete(b *testing.B) {
+
+	for _, n := range []int{1000, 10000, 100000} {
+
+		tree := testTreeBenchmarkTree(n)
+
+		entries := testTreeRandomEntries(1024, 6)
+
+		b.Run(fmt.Sprintf("n=%v", n), func(b *testing.B) {
+
+			for i := 0; i < b.N; i++ {
+				entry := entries[i%len(entries)]
+				tree.Insert(entry.Interval, "X"+entry.Id)
+				tree.Delete(entry.Interval, "X"+entry.Id)
+			}
+		})
+	}
+}
+
+func BenchmarkIntervalTreeDto_LinearScan(b *testing.B) {
+
+	// Baseline: a linear scan for comparison with HasOverlap.
+	for _, n := range []int{1000, 10000, 100000} {
+
+		entries := testTreeBenchmarkTree(n).GetAll()
+
+		query := testTreeInterval(n*30, n*30+30)
+
+		b.Run(fmt.Sprintf("n=%v", n), func(b *testing.B) {
+
+			for i := 0; i < b.N; i++ {
+
+				for j := range entries {
+
+					if entries[j].Interval.Overlaps(query) {
+						break
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTzDto_GetISOWeek_01(t *testing.T) {
+
+	tests := []struct {
+		dateTime        time.Time
+		expectedIsoYear int
+		expectedWeek    int
+		expectedWeekDay int
+		expectedWeekStr string
+		expectedOrdStr  string
+	}{
+		{time.Date(2026, time.Month(10), 16, 8, 30, 0, 0, time.UTC),
+			2026, 42, 5, "2026-W42-5", "2026-289"},
+		{time.Date(2018, time.Month(12), 31, 0, 0, 0, 0, time.UTC),
+			2019, 1, 1, "2019-W01-1", "2018-365"},
+		{time.Date(2021, time.Month(1), 3, 0, 0, 0, 0, time.UTC),
+			2020, 53, 7, "2020-W53-7", "2021-003"},
+		{time.Date(2016, time.Month(12), 31, 0, 0, 0, 0, time.UTC),
+			2016, 52, 6, "2016-W52-6", "2016-366"},
+	}
+
+	for _, test := range tests {
+
+		dtz, err := DateTzDto{}.New(test.dateTime, FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.New(). Error='%v'", err.Error())
+			continue
+		}
+
+		isoYear, week := dtz.GetISOWeek()
+
+		if test.expectedIsoYear != isoYear || test.expectedWeek != week {
+			t.Errorf("Error: Expected isoYear='%v' week='%v'. Instead, isoYear='%v' week='%v'",
+				test.expectedIsoYear, test.expectedWeek, isoYear, week)
+		}
+
+		if test.expectedWeekDay != dtz.GetISOWeekDay() {
+			t.Errorf("Error: Expected ISO weekday='%v'. Instead, weekday='%v'",
+				test.expectedWeekDay, dtz.GetISOWeekDay())
+		}
+
+		if test.expectedWeekStr != dtz.GetISOWeekDateStr() {
+			t.Errorf("Error: Expected ISO week date='%v'. Instead, week date='%v'",
+				test.expectedWeekStr, dtz.GetISOWeekDateStr())
+		}
+
+		if test.expectedOrdStr != dtz.GetOrdinalDateStr() {
+			t.Errorf("Error: Expected ordinal date='%v'. Instead, ordinal date='%v'",
+				test.expectedOrdStr, dtz.GetOrdinalDateStr())
+		}
+
+		tDto, _ := TimeDto{}.NewFromDateTime(test.dateTime)
+
+		isoYear, week = tDto.GetISOWeek()
+
+		if test.expectedIsoYear != isoYear || test.expectedWeek != week ||
+			test.expectedWeekDay != tDto.GetISOWeekDay() {
+			t.Errorf("Error: Expected TimeDto ISO week date='%v'. Instead, isoYear='%v' week='%v' weekday='%v'",
+				test.expectedWeekStr, isoYear, week, tDto.GetISOWeekDay())
+		}
+
+		if dtz.GetDayOfYear() != tDto.GetDayOfYear() {
+			t.Errorf("Error: Expected TimeDto day of year='%v'. Instead, day of year='%v'",
+				dtz.GetDayOfYear(), tDto.GetDayOfYear())
+		}
+	}
+
+}
+
+func TestDateTzDto_NewISOWeekDate_01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	dtz, err := DateTzDto{}.NewISOWeekDate(2026, 42, 5, 8, 30, 0, 0, TzIanaUsCentral, fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewISOWeekDate(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := "2026-10-16 08:30:00 -0500 CDT"
+
+	if expected != dtz.String() {
+		t.Errorf("Error: Expected dtz='%v'. Instead, dtz='%v'", expected, dtz.String())
+	}
+
+	dtz, _ = DateTzDto{}.NewISOWeekDate(2019, 1, 1, 0, 0, 0, 0, TzIanaUTC, fmtStr)
+
+	expected = "2018-12-31 00:00:00 +0000 UTC"
+
+	if expected != dtz.String() {
+		t.Errorf("Error: Expected dtz='%v'. Instead, dtz='%v'", expected, dtz.String())
+	}
+
+	dtz, _ = DateTzDto{}.NewISOWeekDate(2026, 53, 7, 0, 0, 0, 0, TzIanaUTC, fmtStr)
+
+	expected = "2027-01-03 00:00:00 +0000 UTC"
+
+	if expected != dtz.String() {
+		t.Errorf("Error: Expected dtz='%v'. Instead, dtz='%v'", expected, dtz.String())
+	}
+
+	_, err = DateTzDto{}.NewISOWeekDate(2027, 53, 1, 0, 0, 0, 0, TzIanaUTC, fmtStr)
+
+	if err == nil {
+		t.Error("Error: Expected an error for week 53 of 2027. No error was returned.")
+	}
+
+	_, err = DateTzDto{}.NewISOWeekDate(2026, 10, 8, 0, 0, 0, 0, TzIanaUTC, fmtStr)
+
+	if err == nil {
+		t.Error("Error: Expected an error for weekday 8. No error was returned.")
+	}
+
+}
+
+func TestDateTzDto_NewOrdinalDate_01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	dtz, err := DateTzDto{}.NewOrdinalDate(2026, 290, 0, 0, 0, 0, TzIanaUTC, fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewOrdinalDate(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := "2026-10-17 00:00:00 +0000 UTC"
+
+	if expected != dtz.String() {
+		t.Errorf("Error: Expected dtz='%v'. Instead, dtz='%v'", expected, dtz.String())
+	}
+
+	dtz, _ = DateTzDto{}.NewOrdinalDate(2016, 366, 0, 0, 0, 0, TzIanaUTC, fmtStr)
+
+	expected = "2016-12-31 00:00:00 +0000 UTC"
+
+	if expected != dtz.String() {
+		t.Errorf("Error: Expected dtz='%v'. Instead, dtz='%v'", expected, dtz.String())
+	}
+
+	_, err = DateTzDto{}.NewOrdinalDate(2026, 366, 0, 0, 0, 0, TzIanaUTC, fmtStr)
+
+	if err == nil {
+		t.Error("Error: Expected an error for day 366 of 2026. No error was returned.")
+	}
+
+}
+
+func TestFormatDateTimeUtility_ParseISOWeekDate_01(t *testing.T) {
+
+	tests := []struct {
+		dateTimeStr string
+		expected    string
+	}{
+		{"2026-W42-5", "2026-10-16 00:00:00 +0000 UTC"},
+		{"2026W425", "2026-10-16 00:00:00 +0000 UTC"},
+		{"2026-W42", "2026-10-12 00:00:00 +0000 UTC"},
+		{"2026-290", "2026-10-17 00:00:00 +0000 UTC"},
+		{"2026290", "2026-10-17 00:00:00 +0000 UTC"},
+		{"2026-W42-5T08:30:15Z", "2026-10-16 08:30:15 +0000 UTC"},
+		{"2026-290T23:15:00-05:00", "2026-10-17 23:15:00 -0500 -0500"},
+		{"2026-290 23:15", "2026-10-17 23:15:00 +0000 UTC"},
+	}
+
+	dtf := FormatDateTimeUtility{}
+
+	for _, test := range tests {
+
+		dt, err := dtf.ParseDateTimeString(test.dateTimeStr, "")
+
+		if err != nil {
+			t.Errorf("Error returned by dtf.ParseDateTimeString(%v). Error='%v'",
+				test.dateTimeStr, err.Error())
+			continue
+		}
+
+		if test.expected != dt.Format("2006-01-02 15:04:05 -0700 MST") {
+			t.Errorf("Error: Expected '%v' to parse as '%v'. Instead, result='%v'",
+				test.dateTimeStr, test.expected, dt.Format("2006-01-02 15:04:05 -0700 MST"))
+		}
+	}
+
+	_, err := dtf.ParseDateTimeString("2026-W54-1", "")
+
+	if err == nil {
+		t.Error("Error: Expected an error for '2026-W54-1'. No error was returned.")
+	}
+
+}
@@ -0,0 +1,139 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocaleDto_New_01(t *testing.T) {
+
+	for _, tag := range (LocaleDto{}).GetSupportedLocaleTags() {
+
+		locale, err := LocaleDto{}.New(tag)
+
+		if err != nil {
+			t.Errorf("Error returned by LocaleDto{}.New(tag). tag='%v' Error='%v'", tag, err.Error())
+			continue
+		}
+
+		if locale.Tag != tag {
+			t.Errorf("Error: Expected locale.Tag='%v'. Instead, locale.Tag='%v'", tag, locale.Tag)
+		}
+
+		for name := range durFmtElements {
+			if locale.DurationUnitLabels[name].Plural == "" {
+				t.Errorf("Error: Missing duration unit label. tag='%v' name='%v'", tag, name)
+			}
+		}
+	}
+
+	locale, err := LocaleDto{}.New("pt-BR")
+
+	if err != nil {
+		t.Errorf("Error returned by LocaleDto{}.New(\"pt-BR\"). Error='%v'", err.Error())
+	} else if locale.Tag != LocaleTagPortuguese {
+		t.Errorf("Error: Expected locale.Tag='%v'. Instead, locale.Tag='%v'", LocaleTagPortuguese, locale.Tag)
+	}
+
+	_, err = LocaleDto{}.New("xx")
+
+	if err == nil {
+		t.Error("Error: Expected an error for unsupported locale 'xx'. No error was returned.")
+	}
+
+}
+
+func TestLocaleDto_FormatDateTime_01(t *testing.T) {
+
+	dt := time.Date(2017, time.Month(4), 29, 19, 54, 30, 123456489, time.UTC)
+
+	dtz, err := DateTzDto{}.New(dt, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(dt, FmtDateTimeYrMDayFmtStr). Error='%v'", err.Error())
+		return
+	}
+
+	expected := map[string]string{
+		LocaleTagEnglish:    "Saturday April 29, 2017 19:54:30.123456489 +0000 UTC",
+		LocaleTagSpanish:    "sábado abril 29, 2017 19:54:30.123456489 +0000 UTC",
+		LocaleTagFrench:     "samedi avril 29, 2017 19:54:30.123456489 +0000 UTC",
+		LocaleTagGerman:     "Samstag April 29, 2017 19:54:30.123456489 +0000 UTC",
+		LocaleTagPortuguese: "sábado abril 29, 2017 19:54:30.123456489 +0000 UTC",
+		LocaleTagJapanese:   "土曜日 4月 29, 2017 19:54:30.123456489 +0000 UTC",
+	}
+
+	for tag, expectedStr := range expected {
+
+		locale, _ := LocaleDto{}.New(tag)
+
+		str := dtz.GetDateTimeEverythingLocale(locale)
+
+		if expectedStr != str {
+			t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+		}
+	}
+
+	locale, _ := LocaleDto{}.New(LocaleTagJapanese)
+
+	str := DtMgr{}.GetDateTimeCustomFmtLocale(dt, "2006年Jan2日 (Mon) PM3:04", locale)
+
+	expectedStr := "2017年4月29日 (土) 午後7:54"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+}
+
+func TestLocaleDto_DurationLabels_01(t *testing.T) {
+
+	t1 := time.Date(2018, time.Month(1), 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.AddDate(0, 0, 1).Add(time.Duration(2*HourNanoSeconds + MinuteNanoSeconds))
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	durFmt, _ := DurationFormatDto{}.New("{DateDays} {Hours} {Minutes} {Seconds}")
+
+	expected := map[string]string{
+		LocaleTagEnglish:    "1 Day 2 Hours 1 Minute 0 Seconds",
+		LocaleTagSpanish:    "1 día 2 horas 1 minuto 0 segundos",
+		LocaleTagFrench:     "1 jour 2 heures 1 minute 0 seconde",
+		LocaleTagGerman:     "1 Tag 2 Stunden 1 Minute 0 Sekunden",
+		LocaleTagPortuguese: "1 dia 2 horas 1 minuto 0 segundo",
+		LocaleTagJapanese:   "1日 2時間 1分 0秒",
+	}
+
+	for tag, expectedStr := range expected {
+
+		locale, _ := LocaleDto{}.New(tag)
+
+		str, err := tDur.GetFormattedDurationLocaleStr(durFmt, locale)
+
+		if err != nil {
+			t.Errorf("Error returned by tDur.GetFormattedDurationLocaleStr(). Error='%v'", err.Error())
+			continue
+		}
+
+		if expectedStr != str {
+			t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+		}
+	}
+
+	locale, _ := LocaleDto{}.New(LocaleTagGerman)
+
+	str := tDur.GetYearMthDaysTimeLocaleStr(locale)
+
+	expectedStr := "1 Tag 2 Stunden 1 Minute 0 Sekunden 0 Millisekunden 0 Mikrosekunden 0 Nanosekunden"
+
+	if expectedStr != str {
+		t.Errorf("Error: Expected str='%v'. Instead, str='%v'", expectedStr, str)
+	}
+
+}
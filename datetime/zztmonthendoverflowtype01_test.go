@@ -0,0 +1,380 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMonthEndOverflowType_DateTzDto_01(t *testing.T) {
+
+	dtz, _ := DateTzDto{}.New(time.Date(2019, 1, 31, 9, 30, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	testCases := []struct {
+		years    int
+		months   int
+		overflow MonthEndOverflowType
+		expected string
+	}{
+		{0, 1, MonthEndOverflowNORMALIZE, "2019-03-03 09:30"},
+		{0, 1, MonthEndOverflowCLAMP, "2019-02-28 09:30"},
+		{0, 1, MonthEndOverflowROLLNEXT, "2019-03-01 09:30"},
+		{0, 3, MonthEndOverflowCLAMP, "2019-04-30 09:30"},
+		{0, 10, MonthEndOverflowROLLNEXT, "2019-12-01 09:30"},
+		{0, -2, MonthEndOverflowCLAMP, "2018-11-30 09:30"},
+		{1, 1, MonthEndOverflowCLAMP, "2020-02-29 09:30"},
+	}
+
+	for _, tc := range testCases {
+
+		dtz2, err := dtz.AddDateOverflow(tc.years, tc.months, 0, tc.overflow, FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by dtz.AddDateOverflow(). Error='%v'", err.Error())
+			continue
+		}
+
+		actual := dtz2.DateTime.Format("2006-01-02 15:04")
+
+		if actual != tc.expected {
+			t.Errorf("Error: %v years + %v months %v. Expected='%v'. Instead, result='%v'",
+				tc.years, tc.months, tc.overflow.String(), tc.expected, actual)
+		}
+	}
+
+	// AddDate() retains the time.AddDate() behavior.
+	dtz2, err := dtz.AddDate(0, 1, 0, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil || dtz2.DateTime.Format("2006-01-02") != "2019-03-03" {
+		t.Errorf("Error: Expected AddDate(0, 1, 0)='2019-03-03'. Instead, result='%v'",
+			dtz2.DateTime.Format("2006-01-02"))
+	}
+
+	// Leap day plus one year. Days are added after the policy is applied.
+	leapDtz, _ := DateTzDto{}.New(time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	err = leapDtz.AddDateTimeOverflowToThis(1, 0, 1, 6, 0, 0, 0, 0, 0, MonthEndOverflowCLAMP)
+
+	if err != nil {
+		t.Errorf("Error returned by leapDtz.AddDateTimeOverflowToThis(). Error='%v'", err.Error())
+	} else if leapDtz.DateTime.Format("2006-01-02 15:04") != "2021-03-01 06:00" {
+		t.Errorf("Error: Expected '2021-03-01 06:00'. Instead, result='%v'",
+			leapDtz.DateTime.Format("2006-01-02 15:04"))
+	}
+
+	_, err = dtz.AddDateOverflow(0, 1, 0, MonthEndOverflowType(9), FmtDateTimeYrMDayFmtStr)
+
+	if err == nil {
+		t.Error("Error: Expected an error for an invalid overflow policy. Instead, no error was returned.")
+	}
+}
+
+func TestMonthEndOverflowType_DateTzDto_02(t *testing.T) {
+
+	// An empty format string applies the format of the current DateTzDto.
+	fmtStr := "2006-01-02"
+
+	dtz, err := DateTzDto{}.New(time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC), fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	dtz2, err := dtz.AddDate(0, 1, 0, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddDate(). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz2.DateTimeFmt != fmtStr || dtz2.String() != "2019-03-03" {
+		t.Errorf("Error: Expected DateTimeFmt='%v' and '2019-03-03'. Instead, DateTimeFmt='%v' result='%v'",
+			fmtStr, dtz2.DateTimeFmt, dtz2.String())
+	}
+
+	dtz2, err = dtz.AddDateOverflow(0, 1, 0, MonthEndOverflowCLAMP, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddDateOverflow(). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz2.DateTimeFmt != fmtStr || dtz2.String() != "2019-02-28" {
+		t.Errorf("Error: Expected DateTimeFmt='%v' and '2019-02-28'. Instead, DateTimeFmt='%v' result='%v'",
+			fmtStr, dtz2.DateTimeFmt, dtz2.String())
+	}
+
+	dtz2, err = dtz.AddDate(0, 1, 0, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddDate(). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz2.DateTimeFmt != FmtDateTimeYrMDayFmtStr {
+		t.Errorf("Error: Expected DateTimeFmt='%v'. Instead, DateTimeFmt='%v'",
+			FmtDateTimeYrMDayFmtStr, dtz2.DateTimeFmt)
+	}
+}
+
+func TestMonthEndOverflowType_TimeDto_01(t *testing.T) {
+
+	oneMonth, err := TimeDto{}.New(0, 1, 0, 0, 0, 0, 0, 0, 0, 0)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	dtz, _ := DateTzDto{}.New(time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	dtz2, err := dtz.AddPlusTimeDtoOverflow(oneMonth, MonthEndOverflowCLAMP)
+
+	if err != nil || dtz2.DateTime.Format("2006-01-02") != "2019-02-28" {
+		t.Errorf("Error: Expected AddPlusTimeDtoOverflow()='2019-02-28'. Instead, result='%v'",
+			dtz2.DateTime.Format("2006-01-02"))
+	}
+
+	dtz3, _ := DateTzDto{}.New(time.Date(2019, 3, 31, 0, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	dtz4, err := dtz3.AddMinusTimeDtoOverflow(oneMonth, MonthEndOverflowROLLNEXT)
+
+	if err != nil || dtz4.DateTime.Format("2006-01-02") != "2019-03-01" {
+		t.Errorf("Error: Expected AddMinusTimeDtoOverflow()='2019-03-01'. Instead, result='%v'",
+			dtz4.DateTime.Format("2006-01-02"))
+	}
+
+	testCases := []struct {
+		overflow MonthEndOverflowType
+		month    int
+		day      int
+	}{
+		{MonthEndOverflowNORMALIZE, 3, 3},
+		{MonthEndOverflowCLAMP, 2, 28},
+		{MonthEndOverflowROLLNEXT, 3, 1},
+	}
+
+	for _, tc := range testCases {
+
+		tDto, _ := TimeDto{}.NewFromDateTime(time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC))
+
+		err = tDto.AddTimeDtoToDate(oneMonth, tc.overflow)
+
+		if err != nil {
+			t.Errorf("Error returned by tDto.AddTimeDtoToDate(). Error='%v'", err.Error())
+			continue
+		}
+
+		if tDto.Years != 2019 || tDto.Months != tc.month || tDto.DateDays != tc.day {
+			t.Errorf("Error: %v Expected 2019-%v-%v. Instead, result=%v-%v-%v", tc.overflow.String(),
+				tc.month, tc.day, tDto.Years, tDto.Months, tDto.DateDays)
+		}
+	}
+}
+
+func TestMonthEndOverflowType_TimeDto_02(t *testing.T) {
+
+	// Durations are added element by element without a month end
+	// overflow policy. 1-Month 31-Days + 1-Month = 2-Months 31-Days
+	tDto, err := TimeDto{}.New(0, 1, 0, 31, 0, 0, 0, 0, 0, 0)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	oneMonth, _ := TimeDto{}.New(0, 1, 0, 0, 0, 0, 0, 0, 0, 0)
+
+	err = tDto.AddTimeDto(oneMonth)
+
+	if err != nil {
+		t.Errorf("Error returned by tDto.AddTimeDto(). Error='%v'", err.Error())
+		return
+	}
+
+	expected, _ := TimeDto{}.New(0, 2, 0, 31, 0, 0, 0, 0, 0, 0)
+
+	if !tDto.Equal(expected) {
+		t.Errorf("Error: Expected %v-Years %v-Months %v-Days. Instead, result=%v-Years %v-Months %v-Days",
+			expected.Years, expected.Months, expected.DateDays, tDto.Years, tDto.Months, tDto.DateDays)
+	}
+
+	if tDto.Months == 2 && tDto.DateDays == 29 {
+		t.Error("Error: Expected no month end clamping of a duration. Instead, result=2-Months 29-Days")
+	}
+
+	// A duration is not a calendar date.
+	tDto, _ = TimeDto{}.New(0, 0, 0, 31, 0, 0, 0, 0, 0, 0)
+
+	err = tDto.AddTimeDtoToDate(oneMonth, MonthEndOverflowCLAMP)
+
+	if err == nil {
+		t.Error("Error: Expected an error from AddTimeDtoToDate() for Months=0. Instead, no error was returned.")
+	}
+}
+
+func TestMonthEndOverflowType_TimeZoneDto_01(t *testing.T) {
+
+	tIn := time.Date(2019, 1, 31, 20, 0, 0, 0, time.UTC)
+
+	tzDto, err := TimeZoneDto{}.New(tIn, TzIanaUsCentral, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	err = tzDto.AddDateOverflow(0, 1, 0, MonthEndOverflowCLAMP)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDto.AddDateOverflow(). Error='%v'", err.Error())
+		return
+	}
+
+	if tzDto.TimeIn.DateTime.Format("2006-01-02 15:04") != "2019-02-28 20:00" {
+		t.Errorf("Error: Expected TimeIn='2019-02-28 20:00'. Instead, TimeIn='%v'",
+			tzDto.TimeIn.DateTime.Format("2006-01-02 15:04"))
+	}
+
+	if !tzDto.TimeOut.DateTime.Equal(tzDto.TimeIn.DateTime) ||
+		!tzDto.TimeUTC.DateTime.Equal(tzDto.TimeIn.DateTime) {
+		t.Error("Error: Expected TimeIn, TimeOut and TimeUTC to identify the same instant.")
+	}
+
+	if tzDto.TimeOut.DateTime.Location().String() != TzIanaUsCentral {
+		t.Errorf("Error: Expected TimeOut location='%v'. Instead, location='%v'",
+			TzIanaUsCentral, tzDto.TimeOut.DateTime.Location().String())
+	}
+}
+
+func TestMonthEndOverflowType_TimeDurationDto_01(t *testing.T) {
+
+	t1 := time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur.Months != 0 || tDur.DateDays != 29 {
+		t.Errorf("Error: Expected 0-Months 29-Days. Instead, Months='%v' DateDays='%v'", tDur.Months, tDur.DateDays)
+	}
+
+	err = tDur.SetMonthEndOverflow(MonthEndOverflowCLAMP)
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.SetMonthEndOverflow(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur.Months != 1 || tDur.DateDays != 1 || tDur.MonthsNanosecs != int64(28*24*time.Hour) {
+		t.Errorf("Error: Expected 1-Month 1-Day. Instead, Months='%v' DateDays='%v'", tDur.Months, tDur.DateDays)
+	}
+
+	// Leap day to the first day of March of the following year
+	t3 := time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)
+	t4 := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	err = tDur.SetStartEndTimesCalcTz(t3, t4, TDurCalcTypeSTDYEARMTH, TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.SetStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur.MonthEndOverflow != MonthEndOverflowCLAMP || tDur.Years != 1 || tDur.Months != 0 || tDur.DateDays != 1 {
+		t.Errorf("Error: Expected 1-Year 1-Day with policy Clamp. Instead, Years='%v' Months='%v' DateDays='%v' policy='%v'",
+			tDur.Years, tDur.Months, tDur.DateDays, tDur.MonthEndOverflow.String())
+	}
+
+	tDur2 := tDur.CopyOut()
+
+	if !tDur2.Equal(tDur) || tDur2.MonthEndOverflow != MonthEndOverflowCLAMP {
+		t.Error("Error: Expected CopyOut() to retain the month end overflow policy.")
+	}
+}
+
+func TestMonthEndOverflowType_TimeDurationDto_02(t *testing.T) {
+
+	// A duration applied forward reproduces the ending date time under each
+	// policy. With MonthEndOverflowCLAMP, the duration applied backward from
+	// the ending date time also returns to the starting date time.
+	start := time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 5, 15, 10, 0, 0, 0, time.UTC)
+
+	for _, overflow := range []MonthEndOverflowType{
+		MonthEndOverflowNORMALIZE, MonthEndOverflowCLAMP, MonthEndOverflowROLLNEXT} {
+
+		tDur := TimeDurationDto{}
+		tDur.MonthEndOverflow = overflow
+
+		err := tDur.SetStartEndTimesCalcTz(start, end, TDurCalcTypeSTDYEARMTH, TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by tDur.SetStartEndTimesCalcTz(). Error='%v'", err.Error())
+			continue
+		}
+
+		if tDur.Months != 3 {
+			t.Errorf("Error: %v Expected Months='3'. Instead, Months='%v'", overflow.String(), tDur.Months)
+		}
+
+		tDto, err := TimeDto{}.New(int(tDur.Years), int(tDur.Months), 0, int(tDur.DateDays),
+			int(tDur.Hours), int(tDur.Minutes), int(tDur.Seconds), 0, 0, 0)
+
+		if err != nil {
+			t.Errorf("Error returned by TimeDto{}.New(). Error='%v'", err.Error())
+			continue
+		}
+
+		forward := TimeDurationDto{}
+		forward.MonthEndOverflow = overflow
+
+		err = forward.SetStartTimePlusTimeDtoCalcTz(start, tDto, TDurCalcTypeSTDYEARMTH,
+			TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by forward.SetStartTimePlusTimeDtoCalcTz(). Error='%v'", err.Error())
+			continue
+		}
+
+		if !forward.EndTimeDateTz.DateTime.Equal(end) {
+			t.Errorf("Error: %v Expected forward end='%v'. Instead, end='%v'",
+				overflow.String(), end, forward.EndTimeDateTz.DateTime)
+		}
+
+		if overflow != MonthEndOverflowCLAMP {
+			continue
+		}
+
+		backward := TimeDurationDto{}
+		backward.MonthEndOverflow = overflow
+
+		err = backward.SetEndTimeMinusTimeDtoCalcTz(end, tDto, TDurCalcTypeSTDYEARMTH,
+			TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by backward.SetEndTimeMinusTimeDtoCalcTz(). Error='%v'", err.Error())
+			continue
+		}
+
+		if !backward.StartTimeDateTz.DateTime.Equal(start) {
+			t.Errorf("Error: %v Expected backward start='%v'. Instead, start='%v'",
+				overflow.String(), start, backward.StartTimeDateTz.DateTime)
+		}
+
+		if !backward.Equal(tDur) {
+			t.Errorf("Error: %v Expected the backward duration to equal the original duration.", overflow.String())
+		}
+	}
+
+	tDur := TimeDurationDto{}
+
+	if tDur.SetMonthEndOverflow(MonthEndOverflowType(-1)) == nil {
+		t.Error("Error: Expected an error for an invalid overflow policy. Instead, no error was returned.")
+	}
+}
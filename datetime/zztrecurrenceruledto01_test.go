@@ -0,0 +1,327 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// testRRuleOccurrences - Expands 'rRuleStr' from 'dtStart' (local time in
+// America/New_York, format "20060102T150405") and compares the first
+// len(expected) occurrences with 'expected'.
+func testRRuleOccurrences(t *testing.T, dtStart, rRuleStr string, expected []string) {
+
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	naive, _ := time.Parse("20060102T150405", dtStart)
+
+	startDtz, err := DateTzDto{}.New(rfc5545LocalInstant(naive, loc), FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	rule, err := RecurrenceRuleDto{}.New(rRuleStr, startDtz)
+
+	if err != nil {
+		t.Errorf("Error returned by RecurrenceRuleDto{}.New(%v). Error='%v'", rRuleStr, err.Error())
+		return
+	}
+
+	occurrences, err := rule.GetOccurrences(len(expected) + 1)
+
+	if err != nil {
+		t.Errorf("Error returned by rule.GetOccurrences(). Error='%v'", err.Error())
+		return
+	}
+
+	actual := make([]string, len(occurrences))
+
+	for i, dtz := range occurrences {
+		actual[i] = dtz.DateTime.Format("20060102T150405")
+	}
+
+	if len(actual) > len(expected) && strings.Contains(rRuleStr, "COUNT") {
+		t.Errorf("Error: RRULE='%v' Expected %v occurrences. Instead, count='%v'",
+			rRuleStr, len(expected), len(actual))
+	}
+
+	if len(actual) > len(expected) {
+		actual = actual[:len(expected)]
+	}
+
+	if strings.Join(expected, ",") != strings.Join(actual, ",") {
+		t.Errorf("Error: RRULE='%v'\nExpected='%v'\nInstead ='%v'",
+			rRuleStr, strings.Join(expected, ","), strings.Join(actual, ","))
+	}
+}
+
+func TestRecurrenceRuleDto_RFC5545Examples_01(t *testing.T) {
+
+	testRRuleOccurrences(t, "19970902T090000", "FREQ=DAILY;COUNT=4",
+		[]string{"19970902T090000", "19970903T090000", "19970904T090000", "19970905T090000"})
+
+	testRRuleOccurrences(t, "19970902T090000", "RRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH;COUNT=8",
+		[]string{"19970902T090000", "19970904T090000", "19970916T090000", "19970918T090000",
+			"19970930T090000", "19971002T090000", "19971014T090000", "19971016T090000"})
+
+	testRRuleOccurrences(t, "19970905T090000", "FREQ=MONTHLY;COUNT=6;BYDAY=1FR",
+		[]string{"19970905T090000", "19971003T090000", "19971107T090000", "19971205T090000",
+			"19980102T090000", "19980206T090000"})
+
+	testRRuleOccurrences(t, "19970930T090000", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=6",
+		[]string{"19970930T090000", "19971031T090000", "19971128T090000", "19971231T090000",
+			"19980130T090000", "19980227T090000"})
+
+	testRRuleOccurrences(t, "19970512T090000", "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;COUNT=3",
+		[]string{"19970512T090000", "19980511T090000", "19990517T090000"})
+
+	testRRuleOccurrences(t, "19970519T090000", "FREQ=YEARLY;BYDAY=20MO;COUNT=3",
+		[]string{"19970519T090000", "19980518T090000", "19990517T090000"})
+
+	testRRuleOccurrences(t, "19961105T090000",
+		"FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8;COUNT=3",
+		[]string{"19961105T090000", "20001107T090000", "20041102T090000"})
+
+	testRRuleOccurrences(t, "19970928T090000", "FREQ=MONTHLY;BYMONTHDAY=-3;COUNT=6",
+		[]string{"19970928T090000", "19971029T090000", "19971128T090000", "19971229T090000",
+			"19980129T090000", "19980226T090000"})
+
+	testRRuleOccurrences(t, "19970101T090000", "FREQ=YEARLY;INTERVAL=3;COUNT=7;BYYEARDAY=1,100,200",
+		[]string{"19970101T090000", "19970410T090000", "19970719T090000", "20000101T090000",
+			"20000409T090000", "20000718T090000", "20030101T090000"})
+
+	testRRuleOccurrences(t, "20070115T090000", "FREQ=MONTHLY;BYMONTHDAY=15,30;COUNT=5",
+		[]string{"20070115T090000", "20070130T090000", "20070215T090000", "20070315T090000",
+			"20070330T090000"})
+
+	testRRuleOccurrences(t, "19970902T090000", "FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T210000Z",
+		[]string{"19970902T090000", "19970902T120000", "19970902T150000"})
+
+	testRRuleOccurrences(t, "19970902T090000", "FREQ=SECONDLY;INTERVAL=90;COUNT=3",
+		[]string{"19970902T090000", "19970902T090130", "19970902T090300"})
+}
+
+func TestRecurrenceRuleDto_WeekStart_01(t *testing.T) {
+
+	testRRuleOccurrences(t, "19970805T090000", "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+		[]string{"19970805T090000", "19970810T090000", "19970819T090000", "19970824T090000"})
+
+	testRRuleOccurrences(t, "19970805T090000", "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+		[]string{"19970805T090000", "19970817T090000", "19970819T090000", "19970831T090000"})
+}
+
+func TestRecurrenceRuleDto_Minutely_01(t *testing.T) {
+
+	// Every 20 minutes from 9:00 AM to 4:40 PM. The 25th occurrence is
+	// on the following day.
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	startDtz, _ := DateTzDto{}.New(time.Date(1997, 9, 2, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	rule, err := RecurrenceRuleDto{}.New("FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11,12,13,14,15,16;COUNT=25",
+		startDtz)
+
+	if err != nil {
+		t.Errorf("Error returned by RecurrenceRuleDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	occurrences, err := rule.GetOccurrences(0)
+
+	if err != nil {
+		t.Errorf("Error returned by rule.GetOccurrences(0). Error='%v'", err.Error())
+		return
+	}
+
+	if len(occurrences) != 25 {
+		t.Errorf("Error: Expected 25 occurrences. Instead, count='%v'", len(occurrences))
+		return
+	}
+
+	expected := []string{"19970902T164000", "19970903T090000"}
+	actual := []string{occurrences[23].DateTime.Format("20060102T150405"),
+		occurrences[24].DateTime.Format("20060102T150405")}
+
+	if strings.Join(expected, ",") != strings.Join(actual, ",") {
+		t.Errorf("Error: Expected='%v'. Instead='%v'", expected, actual)
+	}
+}
+
+func TestRecurrenceRuleDto_DaylightSavings_01(t *testing.T) {
+
+	// 10:00 local time is retained across the change to Daylight Savings Time.
+	testRRuleOccurrences(t, "20260301T100000", "FREQ=WEEKLY;COUNT=3",
+		[]string{"20260301T100000", "20260308T100000", "20260315T100000"})
+
+	// 02:30 does not exist on 2026-03-08 and is shifted to 03:30.
+	testRRuleOccurrences(t, "20260307T023000", "FREQ=DAILY;COUNT=3",
+		[]string{"20260307T023000", "20260308T033000", "20260309T023000"})
+
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	startDtz, _ := DateTzDto{}.New(time.Date(2026, 3, 1, 10, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	rule, _ := RecurrenceRuleDto{}.New("FREQ=WEEKLY;COUNT=2", startDtz)
+
+	occurrences, _ := rule.GetOccurrences(0)
+
+	if len(occurrences) != 2 {
+		t.Errorf("Error: Expected 2 occurrences. Instead, count='%v'", len(occurrences))
+		return
+	}
+
+	elapsed := occurrences[1].DateTime.Sub(occurrences[0].DateTime)
+
+	if elapsed != 167*time.Hour {
+		t.Errorf("Error: Expected elapsed time='167h0m0s'. Instead, elapsed='%v'", elapsed)
+	}
+}
+
+func TestRecurrenceRuleDto_NewFromICalendar_01(t *testing.T) {
+
+	iCalText := "DTSTART;TZID=America/New_York:20260106T100000\r\n" +
+		"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;UNTIL=20271231T235959Z\r\n" +
+		"EXDATE;TZID=America/New_York:20260203T100000\r\n" +
+		"RDATE;TZID=America/New_York:20260205T100000\r\n"
+
+	rule, err := RecurrenceRuleDto{}.NewFromICalendar(iCalText, "")
+
+	if err != nil {
+		t.Errorf("Error returned by NewFromICalendar(). Error='%v'", err.Error())
+		return
+	}
+
+	if rule.DtStart.TimeZone.LocationName != TzIanaUsEast {
+		t.Errorf("Error: Expected Location='%v'. Instead, Location='%v'",
+			TzIanaUsEast, rule.DtStart.TimeZone.LocationName)
+	}
+
+	loc, _ := time.LoadLocation(TzIanaUsEast)
+
+	startDtz, _ := DateTzDto{}.New(time.Date(2026, 1, 1, 0, 0, 0, 0, loc), "")
+	endDtz, _ := DateTzDto{}.New(time.Date(2026, 3, 3, 10, 0, 0, 0, loc), "")
+
+	occurrences, err := rule.GetBetween(startDtz, endDtz, true)
+
+	if err != nil {
+		t.Errorf("Error returned by rule.GetBetween(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := "2026-01-06,2026-01-20,2026-02-05,2026-02-17,2026-03-03"
+
+	actualDates := make([]string, len(occurrences))
+
+	for i, dtz := range occurrences {
+		actualDates[i] = dtz.DateTime.Format("2006-01-02")
+	}
+
+	actual := strings.Join(actualDates, ",")
+
+	if expected != actual {
+		t.Errorf("Error: Expected='%v'. Instead='%v'", expected, actual)
+	}
+
+	occurrences, err = rule.GetBetween(startDtz, endDtz, false)
+
+	if err != nil {
+		t.Errorf("Error returned by rule.GetBetween(exclusive). Error='%v'", err.Error())
+		return
+	}
+
+	if len(occurrences) != 4 {
+		t.Errorf("Error: Expected 4 exclusive occurrences. Instead, count='%v'", len(occurrences))
+	}
+
+	all, err := rule.GetOccurrences(0)
+
+	if err != nil {
+		t.Errorf("Error returned by rule.GetOccurrences(0). Error='%v'", err.Error())
+		return
+	}
+
+	// 52 rule occurrences in 2026-2027 minus one EXDATE plus one RDATE.
+	if len(all) != 52 {
+		t.Errorf("Error: Expected 52 occurrences. Instead, count='%v'", len(all))
+	}
+
+	expectedRRule := "FREQ=WEEKLY;UNTIL=20271231T235959Z;INTERVAL=2;BYDAY=TU"
+
+	if expectedRRule != rule.GetRRuleStr() {
+		t.Errorf("Error: Expected RRULE='%v'. Instead, RRULE='%v'", expectedRRule, rule.GetRRuleStr())
+	}
+}
+
+func TestRecurrenceRuleDto_Iterator_01(t *testing.T) {
+
+	startDtz, _ := DateTzDto{}.New(time.Date(2026, 1, 31, 8, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	// Unlimited rule. The last day of every month.
+	rule, err := RecurrenceRuleDto{}.New("FREQ=MONTHLY;BYMONTHDAY=-1", startDtz)
+
+	if err != nil {
+		t.Errorf("Error returned by RecurrenceRuleDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	iter := rule.NewIterator()
+
+	expected := []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"}
+
+	for _, exp := range expected {
+
+		dtz, ok := iter.Next()
+
+		if !ok {
+			t.Errorf("Error: Expected occurrence '%v'. Iterator returned 'false'. Error='%v'",
+				exp, iter.Error())
+			return
+		}
+
+		if exp != dtz.DateTime.Format("2006-01-02") {
+			t.Errorf("Error: Expected '%v'. Instead, '%v'", exp, dtz.DateTime.Format("2006-01-02"))
+		}
+	}
+
+	_, err = rule.GetOccurrences(0)
+
+	if err == nil {
+		t.Error("Error: Expected an error for GetOccurrences(0) on an unlimited rule. NO ERROR WAS RETURNED!")
+	}
+
+	// An impossible rule terminates.
+	rule2, _ := RecurrenceRuleDto{}.New("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", startDtz)
+
+	occurrences, err := rule2.GetOccurrences(5)
+
+	if err != nil || len(occurrences) != 0 {
+		t.Errorf("Error: Expected zero occurrences. count='%v' err='%v'", len(occurrences), err)
+	}
+}
+
+func TestRecurrenceRuleDto_New_Errors_01(t *testing.T) {
+
+	startDtz, _ := DateTzDto{}.New(time.Date(2026, 1, 31, 8, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	badRules := []string{
+		"FREQ=DAILY;COUNT=5;UNTIL=20260301T000000Z",
+		"FREQ=MONTHLY;BYWEEKNO=5",
+		"FREQ=WEEKLY;BYDAY=2TU",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=FORTNIGHTLY",
+		"INTERVAL=2",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+	}
+
+	for _, badRule := range badRules {
+
+		_, err := RecurrenceRuleDto{}.New(badRule, startDtz)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error for RRULE='%v'. NO ERROR WAS RETURNED!", badRule)
+		}
+	}
+}
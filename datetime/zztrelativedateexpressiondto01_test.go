@@ -0,0 +1,176 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeDateExpressionDto_New_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	// Thursday
+	refDtz, _ := DateTzDto{}.New(time.Date(2026, 10, 15, 10, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	layout := "2006-01-02 15:04 MST"
+
+	tests := []struct {
+		expression     string
+		expected       string
+		interpretation string
+		hasTimeOfDay   bool
+	}{
+		{"tomorrow at noon", "2026-10-16 12:00 CDT", "tomorrow at 12:00", true},
+		{"Friday", "2026-10-16 00:00 CDT", "Friday", false},
+		{"next Friday", "2026-10-16 00:00 CDT", "next Friday", false},
+		{"this Thursday", "2026-10-15 00:00 CDT", "Thursday", false},
+		{"next Thursday", "2026-10-22 00:00 CDT", "next Thursday", false},
+		{"last Thursday", "2026-10-08 00:00 CDT", "last Thursday", false},
+		{"next Tuesday 3pm", "2026-10-20 15:00 CDT", "next Tuesday at 15:00", true},
+		{"Tue 3 p.m.", "2026-10-20 15:00 CDT", "Tuesday at 15:00", true},
+		{"3pm next Tuesday", "2026-10-20 15:00 CDT", "next Tuesday at 15:00", true},
+		{"the day after tomorrow at 8:30am", "2026-10-17 08:30 CDT", "the day after tomorrow at 08:30", true},
+		{"3 days ago", "2026-10-12 10:00 CDT", "3 days ago", false},
+		{"3 days ago at midnight", "2026-10-12 00:00 CDT", "3 days ago at 00:00", true},
+		{"in 90 minutes", "2026-10-15 11:30 CDT", "in 90 minutes", false},
+		{"in 2 hours and 30 minutes", "2026-10-15 12:30 CDT", "in 2 hours and 30 minutes", false},
+		{"a week from now", "2026-10-22 10:00 CDT", "in 1 week", false},
+		{"in 3 weeks", "2026-11-05 10:00 CST", "in 3 weeks", false},
+		{"2 months later", "2026-12-15 10:00 CST", "in 2 months", false},
+		{"end of month", "2026-10-31 00:00 CDT", "end of this month", false},
+		{"start of next week", "2026-10-19 00:00 CDT", "start of next week", false},
+		{"end of the year", "2026-12-31 00:00 CST", "end of this year", false},
+		{"first Monday of March", "2027-03-01 00:00 CST", "first Monday of March", false},
+		{"first Monday of October", "2027-10-04 00:00 CDT", "first Monday of October", false},
+		{"last Friday of next month", "2026-11-27 00:00 CST", "last Friday of next month", false},
+		{"2nd Tuesday in March 2026", "2026-03-10 00:00 CDT", "second Tuesday of March 2026", false},
+		{"at 9", "2026-10-15 09:00 CDT", "today at 09:00", true},
+		{"now", "2026-10-15 10:00 CDT", "now", false},
+		{"next month", "2026-11-15 00:00 CST", "next month", false},
+	}
+
+	for _, test := range tests {
+
+		relDate, err := RelativeDateExpressionDto{}.New(test.expression, refDtz, "", "")
+
+		if err != nil {
+			t.Errorf("Error returned by RelativeDateExpressionDto{}.New(%v). Error='%v'",
+				test.expression, err.Error())
+			continue
+		}
+
+		if relDate.DateTime.DateTime.Format(layout) != test.expected {
+			t.Errorf("Error: '%v' Expected DateTime='%v'. Instead, DateTime='%v'",
+				test.expression, test.expected, relDate.DateTime.DateTime.Format(layout))
+		}
+
+		if relDate.Interpretation != test.interpretation {
+			t.Errorf("Error: '%v' Expected Interpretation='%v'. Instead, Interpretation='%v'",
+				test.expression, test.interpretation, relDate.Interpretation)
+		}
+
+		if relDate.HasTimeOfDay != test.hasTimeOfDay {
+			t.Errorf("Error: '%v' Expected HasTimeOfDay='%v'. Instead, HasTimeOfDay='%v'",
+				test.expression, test.hasTimeOfDay, relDate.HasTimeOfDay)
+		}
+	}
+}
+
+func TestRelativeDateExpressionDto_New_02(t *testing.T) {
+
+	layout := "2006-01-02 15:04 MST"
+
+	// 2026-10-16 03:00 UTC is 2026-10-15 22:00 CDT.
+	refDtz, _ := DateTzDto{}.New(time.Date(2026, 10, 16, 3, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	relDate, err := RelativeDateExpressionDto{}.New("tomorrow at noon", refDtz, TzIanaUsCentral, "")
+
+	if err != nil {
+		t.Errorf("Error returned by RelativeDateExpressionDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	if relDate.DateTime.DateTime.Format(layout) != "2026-10-16 12:00 CDT" {
+		t.Errorf("Error: Expected DateTime='2026-10-16 12:00 CDT'. Instead, DateTime='%v'",
+			relDate.DateTime.DateTime.Format(layout))
+	}
+
+	if relDate.ReferenceTime.DateTime.Format(layout) != "2026-10-15 22:00 CDT" {
+		t.Errorf("Error: Expected ReferenceTime='2026-10-15 22:00 CDT'. Instead, ReferenceTime='%v'",
+			relDate.ReferenceTime.DateTime.Format(layout))
+	}
+
+	if relDate.TimeZoneLocation != TzIanaUsCentral {
+		t.Errorf("Error: Expected TimeZoneLocation='%v'. Instead, TimeZoneLocation='%v'",
+			TzIanaUsCentral, relDate.TimeZoneLocation)
+	}
+
+	expected := "tomorrow at 12:00 (Friday 2026-10-16 12:00 CDT)"
+
+	if relDate.GetConfirmationStr() != expected {
+		t.Errorf("Error: Expected GetConfirmationStr()='%v'. Instead, GetConfirmationStr()='%v'",
+			expected, relDate.GetConfirmationStr())
+	}
+
+	// 02:30 does not exist on 2026-03-08 in America/Chicago.
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	refDtz, _ = DateTzDto{}.New(time.Date(2026, 3, 7, 10, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	relDate, err = RelativeDateExpressionDto{}.New("tomorrow at 2:30am", refDtz, "", "")
+
+	if err != nil {
+		t.Errorf("Error returned by RelativeDateExpressionDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	if relDate.DateTime.DateTime.Format(layout) != "2026-03-08 03:00 CDT" {
+		t.Errorf("Error: Expected DateTime='2026-03-08 03:00 CDT'. Instead, DateTime='%v'",
+			relDate.DateTime.DateTime.Format(layout))
+	}
+}
+
+func TestRelativeDateExpressionDto_New_03(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	refDtz, _ := DateTzDto{}.New(time.Date(2026, 10, 15, 10, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	expressions := []string{
+		"",
+		"tomorrow blah",
+		"2 hours",
+		"in 3 days ago",
+		"in 2 hours at 3pm",
+		"tomorrow next Friday",
+		"tomorrow in 2 days",
+		"fifth Monday of February 2026",
+		"first Monday of next week",
+		"at 25",
+		"13pm",
+		"now at 3pm",
+		"noon at 3pm",
+		"end of blah",
+	}
+
+	for _, expression := range expressions {
+
+		_, err := RelativeDateExpressionDto{}.New(expression, refDtz, "", "")
+
+		if err == nil {
+			t.Errorf("Error: Expected an error for expression='%v'. Instead, no error was returned.", expression)
+		}
+	}
+
+	_, err := RelativeDateExpressionDto{}.New("tomorrow", refDtz, "Invalid/TimeZone", "")
+
+	if err == nil {
+		t.Error("Error: Expected an error for an invalid time zone. Instead, no error was returned.")
+	}
+
+	_, err = RelativeDateExpressionDto{}.New("tomorrow", DateTzDto{}, "", "")
+
+	if err == nil {
+		t.Error("Error: Expected an error for a zero reference time. Instead, no error was returned.")
+	}
+}
@@ -0,0 +1,189 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeTimeFormatDto_Format_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	// Thursday
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, loc)
+
+	nowDtz, _ := DateTzDto{}.New(now, FmtDateTimeYrMDayFmtStr)
+
+	tests := []struct {
+		dateTime  time.Time
+		precision int
+		calendar  bool
+		expected  string
+	}{
+		{now.Add(-10 * time.Second), 1, true, "just now"},
+		{now.Add(-5*time.Minute - 30*time.Second), 1, true, "5 minutes ago"},
+		{now.Add(-61 * time.Minute), 1, true, "1 hour ago"},
+		{now.Add(2*time.Hour + 10*time.Minute), 1, true, "in 2 hours"},
+		{now.Add(2*time.Hour + 10*time.Minute), 2, true, "in 2 hours and 10 minutes"},
+		{now.Add(2 * time.Hour), 2, true, "in 2 hours"},
+		{time.Date(2026, 10, 14, 9, 30, 0, 0, loc), 1, true, "yesterday at 09:30"},
+		{time.Date(2026, 10, 16, 18, 45, 0, 0, loc), 1, true, "tomorrow at 18:45"},
+		{time.Date(2026, 10, 13, 12, 0, 0, 0, loc), 1, true, "last Tuesday"},
+		{time.Date(2026, 10, 20, 18, 0, 0, 0, loc), 1, true, "next Tuesday"},
+		{time.Date(2026, 10, 13, 12, 0, 0, 0, loc), 1, false, "2 days ago"},
+		{time.Date(2026, 10, 14, 9, 30, 0, 0, loc), 2, false, "1 day and 2 hours ago"},
+		{time.Date(2026, 11, 5, 12, 0, 0, 0, loc), 1, true, "in 3 weeks"},
+		{time.Date(2026, 7, 15, 12, 0, 0, 0, loc), 1, true, "3 months ago"},
+		{time.Date(2024, 4, 1, 12, 0, 0, 0, loc), 1, true, "2 years ago"},
+		{time.Date(2024, 4, 1, 12, 0, 0, 0, loc), 2, true, "2 years and 6 months ago"},
+	}
+
+	for i, test := range tests {
+
+		relFmt := RelativeTimeFormatDto{}.New()
+		relFmt.Precision = test.precision
+		relFmt.CalendarPhrases = test.calendar
+
+		dtz, _ := DateTzDto{}.New(test.dateTime, FmtDateTimeYrMDayFmtStr)
+
+		str, err := relFmt.Format(dtz, nowDtz)
+
+		if err != nil {
+			t.Errorf("Error returned by relFmt.Format() test %v. Error='%v'", i, err.Error())
+			continue
+		}
+
+		if str != test.expected {
+			t.Errorf("Error: Test %v Expected '%v'. Instead, str='%v'", i, test.expected, str)
+		}
+	}
+}
+
+func TestRelativeTimeFormatDto_Format_02(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, loc)
+
+	nowDtz, _ := DateTzDto{}.New(now, FmtDateTimeYrMDayFmtStr)
+
+	tests := []struct {
+		localeTag string
+		dateTime  time.Time
+		calendar  bool
+		expected  string
+	}{
+		{LocaleTagSpanish, now.Add(-5 * time.Minute), true, "hace 5 minutos"},
+		{LocaleTagSpanish, time.Date(2026, 10, 13, 12, 0, 0, 0, loc), true, "el martes pasado"},
+		{LocaleTagFrench, time.Date(2026, 10, 14, 9, 30, 0, 0, loc), true, "hier à 09:30"},
+		{LocaleTagGerman, time.Date(2026, 10, 13, 12, 0, 0, 0, loc), false, "vor 2 Tagen"},
+		{LocaleTagPortuguese, now.Add(time.Hour), true, "em 1 hora"},
+		{LocaleTagJapanese, now.Add(3 * time.Hour), true, "3時間後"},
+	}
+
+	for _, test := range tests {
+
+		locale, err := LocaleDto{}.New(test.localeTag)
+
+		if err != nil {
+			t.Errorf("Error returned by LocaleDto{}.New(%v). Error='%v'", test.localeTag, err.Error())
+			return
+		}
+
+		relFmt := RelativeTimeFormatDto{}.New()
+		relFmt.SetLocale(locale)
+		relFmt.CalendarPhrases = test.calendar
+
+		dtz, _ := DateTzDto{}.New(test.dateTime, FmtDateTimeYrMDayFmtStr)
+
+		str, err := dtz.GetRelativeTimeStr(nowDtz, relFmt)
+
+		if err != nil {
+			t.Errorf("Error returned by dtz.GetRelativeTimeStr() locale %v. Error='%v'", test.localeTag, err.Error())
+			continue
+		}
+
+		if str != test.expected {
+			t.Errorf("Error: Locale %v Expected '%v'. Instead, str='%v'", test.localeTag, test.expected, str)
+		}
+	}
+}
+
+func TestRelativeTimeFormatDto_Thresholds_01(t *testing.T) {
+
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	nowDtz, _ := DateTzDto{}.New(now, FmtDateTimeYrMDayFmtStr)
+	dtz, _ := DateTzDto{}.New(now.Add(-10*time.Second), FmtDateTimeYrMDayFmtStr)
+
+	relFmt := RelativeTimeFormatDto{}.New()
+	relFmt.Thresholds.JustNow = time.Second
+
+	str, _ := relFmt.Format(dtz, nowDtz)
+
+	if str != "10 seconds ago" {
+		t.Errorf("Error: Expected '10 seconds ago'. Instead, str='%v'", str)
+	}
+
+	// Hours are displayed up to 48 hours.
+	relFmt = RelativeTimeFormatDto{}.New()
+	relFmt.Thresholds.Hours = 48
+
+	dtz, _ = DateTzDto{}.New(now.Add(-30*time.Hour), FmtDateTimeYrMDayFmtStr)
+
+	str, _ = relFmt.Format(dtz, nowDtz)
+
+	if str != "30 hours ago" {
+		t.Errorf("Error: Expected '30 hours ago'. Instead, str='%v'", str)
+	}
+
+	// An empty RelativeTimeFormatDto uses English and default thresholds.
+	relFmt = RelativeTimeFormatDto{}
+
+	str, _ = relFmt.Format(dtz, nowDtz)
+
+	if str != "1 day ago" {
+		t.Errorf("Error: Expected '1 day ago'. Instead, str='%v'", str)
+	}
+
+	relFmt.Precision = 3
+
+	_, err := relFmt.Format(dtz, nowDtz)
+
+	if err == nil {
+		t.Error("Error: Expected an error for Precision=3. Instead, no error was returned.")
+	}
+}
+
+func TestRelativeTimeFormatDto_FormatTimeDurationDto_01(t *testing.T) {
+
+	t2 := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	t1 := t2.Add(-3 * time.Hour)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	relFmt := RelativeTimeFormatDto{}.New()
+
+	str, err := relFmt.FormatTimeDurationDto(tDur, false)
+
+	if err != nil {
+		t.Errorf("Error returned by relFmt.FormatTimeDurationDto(). Error='%v'", err.Error())
+		return
+	}
+
+	if str != "3 hours ago" {
+		t.Errorf("Error: Expected '3 hours ago'. Instead, str='%v'", str)
+	}
+
+	str, _ = relFmt.FormatTimeDurationDto(tDur, true)
+
+	if str != "in 3 hours" {
+		t.Errorf("Error: Expected 'in 3 hours'. Instead, str='%v'", str)
+	}
+}
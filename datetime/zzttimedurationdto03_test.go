@@ -0,0 +1,230 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeDurationDto_SplitAtBoundaries_01(t *testing.T) {
+
+	// Daylight Savings Time begins in Europe/Berlin on 2026-03-29.
+	locBerlin, _ := time.LoadLocation("Europe/Berlin")
+
+	t1 := time.Date(2026, 3, 28, 22, 0, 0, 0, locBerlin)
+	t2 := time.Date(2026, 4, 2, 3, 0, 0, 0, locBerlin)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeCUMHOURS,
+		"Europe/Berlin", FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	pieces, err := tDur.SplitAtBoundaries(TimeUnitDAYS, time.Monday, "Europe/Berlin")
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.SplitAtBoundaries(). Error='%v'", err.Error())
+		return
+	}
+
+	expectedHours := []int64{2, 23, 24, 24, 24, 3}
+
+	if len(pieces) != len(expectedHours) {
+		t.Errorf("Error: Expected %v pieces. Instead, pieces='%v'", len(expectedHours), len(pieces))
+		return
+	}
+
+	var total time.Duration
+
+	for i, piece := range pieces {
+
+		if piece.Hours != expectedHours[i] || piece.TimeDuration != time.Duration(expectedHours[i])*time.Hour {
+			t.Errorf("Error: Piece %v Expected Hours='%v'. Instead, Hours='%v' TimeDuration='%v'",
+				i, expectedHours[i], piece.Hours, piece.TimeDuration)
+		}
+
+		if piece.CalcType != TDurCalcTypeCUMHOURS {
+			t.Errorf("Error: Piece %v Expected CalcType='%v'. Instead, CalcType='%v'",
+				i, TDurCalcTypeCUMHOURS.String(), piece.CalcType.String())
+		}
+
+		if i > 0 && !piece.StartTimeDateTz.DateTime.Equal(pieces[i-1].EndTimeDateTz.DateTime) {
+			t.Errorf("Error: Piece %v does not begin where piece %v ends.", i, i-1)
+		}
+
+		total += piece.TimeDuration
+	}
+
+	if total != tDur.TimeDuration {
+		t.Errorf("Error: Expected the pieces to sum to '%v'. Instead, sum='%v'", tDur.TimeDuration, total)
+	}
+
+	expectedStr := "2026-03-30 00:00:00.000000000 +0200 CEST"
+
+	if pieces[1].EndTimeDateTz.String() != expectedStr {
+		t.Errorf("Error: Expected piece 1 EndTime='%v'. Instead, EndTime='%v'",
+			expectedStr, pieces[1].EndTimeDateTz.String())
+	}
+}
+
+func TestTimeDurationDto_SplitAtBoundaries_02(t *testing.T) {
+
+	// The fall back day in America/Chicago has 25 hours. Boundaries are
+	// computed in the requested time zone, not the duration's time zone.
+	t1 := time.Date(2026, 10, 31, 12, 0, 0, 0, time.UTC)
+	t2 := time.Date(2026, 11, 3, 12, 0, 0, 0, time.UTC)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	pieces, err := tDur.SplitAtBoundaries(TimeUnitDAYS, time.Sunday, TzIanaUsCentral)
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.SplitAtBoundaries(). Error='%v'", err.Error())
+		return
+	}
+
+	// 2026-10-31 07:00 CDT to 2026-11-03 06:00 CST
+	expected := []time.Duration{17 * time.Hour, 25 * time.Hour, 24 * time.Hour, 6 * time.Hour}
+
+	if len(pieces) != len(expected) {
+		t.Errorf("Error: Expected %v pieces. Instead, pieces='%v'", len(expected), len(pieces))
+		return
+	}
+
+	for i, piece := range pieces {
+
+		if piece.TimeDuration != expected[i] {
+			t.Errorf("Error: Piece %v Expected TimeDuration='%v'. Instead, TimeDuration='%v'",
+				i, expected[i], piece.TimeDuration)
+		}
+
+		if piece.StartTimeDateTz.DateTime.Location().String() != TzIanaUsCentral {
+			t.Errorf("Error: Piece %v Expected location='%v'. Instead, location='%v'",
+				i, TzIanaUsCentral, piece.StartTimeDateTz.DateTime.Location().String())
+		}
+	}
+}
+
+func TestTimeDurationDto_SplitAtBoundaries_03(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	t1 := time.Date(2026, 2, 15, 0, 0, 0, 0, loc)
+	t2 := time.Date(2027, 1, 10, 0, 0, 0, 0, loc)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUsCentral, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	testCases := []struct {
+		unit           TimeUnitType
+		expectedStarts []string
+	}{
+		{TimeUnitQUARTERS, []string{"2026-02-15", "2026-04-01", "2026-07-01", "2026-10-01", "2027-01-01"}},
+		{TimeUnitHALFYEARS, []string{"2026-02-15", "2026-07-01", "2027-01-01"}},
+		{TimeUnitYEARS, []string{"2026-02-15", "2027-01-01"}},
+	}
+
+	for _, tc := range testCases {
+
+		pieces, err := tDur.SplitAtBoundaries(tc.unit, time.Sunday, TzIanaUsCentral)
+
+		if err != nil {
+			t.Errorf("Error returned by tDur.SplitAtBoundaries(%v). Error='%v'", tc.unit.String(), err.Error())
+			continue
+		}
+
+		if len(pieces) != len(tc.expectedStarts) {
+			t.Errorf("Error: %v Expected %v pieces. Instead, pieces='%v'",
+				tc.unit.String(), len(tc.expectedStarts), len(pieces))
+			continue
+		}
+
+		for i, piece := range pieces {
+
+			startStr := piece.StartTimeDateTz.DateTime.Format("2006-01-02")
+
+			if startStr != tc.expectedStarts[i] {
+				t.Errorf("Error: %v Piece %v Expected start='%v'. Instead, start='%v'",
+					tc.unit.String(), i, tc.expectedStarts[i], startStr)
+			}
+		}
+	}
+
+	// Months: February 15 to January 10 yields 12 pieces.
+	pieces, err := tDur.SplitAtBoundaries(TimeUnitMONTHS, time.Sunday, TzIanaUsCentral)
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.SplitAtBoundaries(TimeUnitMONTHS). Error='%v'", err.Error())
+		return
+	}
+
+	if len(pieces) != 12 || pieces[1].TimeDuration != 31*24*time.Hour-time.Hour {
+		t.Errorf("Error: Expected 12 pieces where March has 743 hours. Instead, pieces='%v'", len(pieces))
+	}
+}
+
+func TestTimeDurationDto_SplitAtBoundaries_04(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	// Thursday 2026-10-15 to Wednesday 2026-10-28
+	t1 := time.Date(2026, 10, 15, 9, 0, 0, 0, loc)
+	t2 := time.Date(2026, 10, 28, 9, 0, 0, 0, loc)
+
+	tDur, _ := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeCUMDAYS,
+		TzIanaUsCentral, FmtDateTimeYrMDayFmtStr)
+
+	pieces, err := tDur.SplitAtBoundaries(TimeUnitWEEKS, time.Monday, TzIanaUsCentral)
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.SplitAtBoundaries(TimeUnitWEEKS). Error='%v'", err.Error())
+		return
+	}
+
+	expectedStarts := []string{"2026-10-15 09:00", "2026-10-19 00:00", "2026-10-26 00:00"}
+
+	if len(pieces) != len(expectedStarts) {
+		t.Errorf("Error: Expected %v pieces. Instead, pieces='%v'", len(expectedStarts), len(pieces))
+		return
+	}
+
+	for i, piece := range pieces {
+
+		startStr := piece.StartTimeDateTz.DateTime.Format("2006-01-02 15:04")
+
+		if startStr != expectedStarts[i] {
+			t.Errorf("Error: Piece %v Expected start='%v'. Instead, start='%v'", i, expectedStarts[i], startStr)
+		}
+	}
+
+	// A duration within one period yields a single piece.
+	pieces, err = tDur.SplitAtBoundaries(TimeUnitMONTHS, time.Monday, TzIanaUsCentral)
+
+	if err != nil || len(pieces) != 1 || pieces[0].TimeDuration != tDur.TimeDuration {
+		t.Errorf("Error: Expected a single piece equal to the original duration. pieces='%v'", len(pieces))
+	}
+
+	_, err = tDur.SplitAtBoundaries(TimeUnitHOURS, time.Monday, TzIanaUsCentral)
+
+	if err == nil {
+		t.Error("Error: Expected an error for TimeUnitHOURS. Instead, no error was returned.")
+	}
+
+	_, err = tDur.SplitAtBoundaries(TimeUnitDAYS, time.Monday, "Invalid/Zone")
+
+	if err == nil {
+		t.Error("Error: Expected an error for an invalid time zone. Instead, no error was returned.")
+	}
+}
@@ -0,0 +1,161 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeDurationDto_AnniversaryCalc_01(t *testing.T) {
+
+	type result struct {
+		years, months, dateDays, hours int64
+	}
+
+	tests := []struct {
+		start    time.Time
+		end      time.Time
+		overflow MonthEndOverflowType
+		fwd      result
+		bwd      result
+		eom      result
+		thirty   result
+	}{
+		{time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 30, 0, 0, 0, 0, time.UTC),
+			MonthEndOverflowCLAMP, result{0, 1, 30, 0}, result{0, 1, 28, 0}, result{0, 1, 30, 0}, result{0, 2, 0, 0}},
+		{time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2019, 2, 28, 0, 0, 0, 0, time.UTC),
+			MonthEndOverflowCLAMP, result{0, 1, 0, 0}, result{0, 0, 28, 0}, result{0, 1, 0, 0}, result{0, 0, 28, 0}},
+		{time.Date(2019, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 31, 0, 0, 0, 0, time.UTC),
+			MonthEndOverflowCLAMP, result{0, 1, 3, 0}, result{0, 1, 0, 0}, result{0, 1, 0, 0}, result{0, 1, 2, 0}},
+		{time.Date(2019, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC),
+			MonthEndOverflowNORMALIZE, result{0, 0, 29, 0}, result{0, 1, 1, 0}, result{0, 1, 1, 0}, result{0, 1, 1, 0}},
+		{time.Date(2016, 2, 29, 10, 0, 0, 0, time.UTC), time.Date(2020, 2, 28, 9, 0, 0, 0, time.UTC),
+			MonthEndOverflowCLAMP, result{3, 11, 29, 23}, result{3, 11, 27, 23}, result{3, 11, 27, 23}, result{3, 11, 28, 23}},
+		{time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+			MonthEndOverflowNORMALIZE, result{0, 0, 30, 0}, result{0, 0, 30, 0}, result{0, 0, 30, 0}, result{0, 0, 29, 0}},
+		{time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+			MonthEndOverflowROLLNEXT, result{0, 0, 30, 0}, result{0, 0, 30, 0}, result{0, 0, 30, 0}, result{0, 0, 29, 0}},
+	}
+
+	for i, test := range tests {
+
+		tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(test.start, test.end,
+			TDurCalcTypeFWDANNIVERSARY, TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz() test %v. Error='%v'", i, err.Error())
+			continue
+		}
+
+		err = tDur.SetMonthEndOverflow(test.overflow)
+
+		if err != nil {
+			t.Errorf("Error returned by tDur.SetMonthEndOverflow() test %v. Error='%v'", i, err.Error())
+			continue
+		}
+
+		for _, calc := range []struct {
+			calcType TDurCalcType
+			expected result
+		}{
+			{TDurCalcTypeFWDANNIVERSARY, test.fwd},
+			{TDurCalcTypeBWDANNIVERSARY, test.bwd},
+			{TDurCalcTypeEOMANNIVERSARY, test.eom},
+			{TDurCalcType30DAYMONTHS, test.thirty},
+		} {
+
+			err = tDur.ReCalcTimeDurationAllocation(calc.calcType)
+
+			if err != nil {
+				t.Errorf("Error returned by ReCalcTimeDurationAllocation(%v) test %v. Error='%v'",
+					calc.calcType.String(), i, err.Error())
+				continue
+			}
+
+			actual := result{tDur.Years, tDur.Months, tDur.DateDays, tDur.Hours}
+
+			if actual != calc.expected {
+				t.Errorf("Error: Test %v %v Expected Years/Months/DateDays/Hours='%v'. Instead, '%v'",
+					i, calc.calcType.String(), calc.expected, actual)
+			}
+
+			if tDur.CalcType != calc.calcType {
+				t.Errorf("Error: Test %v Expected CalcType='%v'. Instead, CalcType='%v'",
+					i, calc.calcType.String(), tDur.CalcType.String())
+			}
+
+			if calc.calcType != TDurCalcType30DAYMONTHS &&
+				tDur.TotDateNanoseconds+tDur.TotTimeNanoseconds != int64(tDur.TimeDuration) {
+				t.Errorf("Error: Test %v %v Expected allocated nanoseconds='%v'. Instead, '%v'",
+					i, calc.calcType.String(), int64(tDur.TimeDuration),
+					tDur.TotDateNanoseconds+tDur.TotTimeNanoseconds)
+			}
+		}
+	}
+}
+
+func TestTimeDurationDto_AnniversaryCalc_02(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	// Exact anniversary across a Daylight Savings Time transition.
+	t1 := time.Date(2026, 1, 15, 9, 30, 0, 0, loc)
+	t2 := time.Date(2026, 7, 15, 9, 30, 0, 0, loc)
+
+	for _, calcType := range []TDurCalcType{TDurCalcTypeFWDANNIVERSARY, TDurCalcTypeBWDANNIVERSARY,
+		TDurCalcTypeEOMANNIVERSARY, TDurCalcType30DAYMONTHS} {
+
+		tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, calcType,
+			TzIanaUsCentral, FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(%v). Error='%v'",
+				calcType.String(), err.Error())
+			continue
+		}
+
+		if tDur.Months != 6 || tDur.DateDays != 0 || tDur.Hours != 0 || tDur.Minutes != 0 {
+			t.Errorf("Error: %v Expected 6-Months. Instead, Months='%v' DateDays='%v' Hours='%v' Minutes='%v'",
+				calcType.String(), tDur.Months, tDur.DateDays, tDur.Hours, tDur.Minutes)
+		}
+	}
+
+	// Backward anniversaries never land later than the target month.
+	// 2026-03-31 minus 1 month is 2026-02-28, which precedes the start.
+	t1 = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	t2 = time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeBWDANNIVERSARY,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur.Months != 0 || tDur.DateDays != 30 {
+		t.Errorf("Error: Expected 0-Months 30-Days. Instead, Months='%v' DateDays='%v'",
+			tDur.Months, tDur.DateDays)
+	}
+
+	// 30 Day Months: the ending time of day precedes the starting time of day.
+	t1 = time.Date(2026, 1, 30, 12, 0, 0, 0, time.UTC)
+	t2 = time.Date(2026, 1, 31, 6, 0, 0, 0, time.UTC)
+
+	tDur, err = TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcType30DAYMONTHS,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur.DateDays != 0 || tDur.Hours != 18 || tDur.TotTimeNanoseconds != int64(tDur.TimeDuration) {
+		t.Errorf("Error: Expected 0-Days 18-Hours. Instead, DateDays='%v' Hours='%v'",
+			tDur.DateDays, tDur.Hours)
+	}
+
+	if TDurCalcType30DAYMONTHS.String() != "ThirtyDayMonthsCalc" {
+		t.Errorf("Error: Expected String()='ThirtyDayMonthsCalc'. Instead, String()='%v'",
+			TDurCalcType30DAYMONTHS.String())
+	}
+}
@@ -0,0 +1,140 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeDurationDto_GetCumQuartersCalcDto_01(t *testing.T) {
+
+	t1 := time.Date(2019, 1, 15, 10, 0, 0, 0, time.UTC)
+	t2 := time.Date(2020, 6, 20, 23, 26, 46, 0, time.UTC)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeSTDYEARMTH,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	qDur, err := tDur.GetCumQuartersCalcDto()
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.GetCumQuartersCalcDto(). Error='%v'", err.Error())
+		return
+	}
+
+	if qDur.CalcType != TDurCalcTypeCUMQUARTERS || qDur.Years != 0 || qDur.HalfYears != 0 ||
+		qDur.Quarters != 5 || qDur.Months != 2 || qDur.DateDays != 5 || qDur.Hours != 13 {
+		t.Errorf("Error: Expected 5-Quarters 2-Months 5-Days 13-Hours. Instead, CalcType='%v' Years='%v' "+
+			"HalfYears='%v' Quarters='%v' Months='%v' DateDays='%v' Hours='%v'", qDur.CalcType.String(),
+			qDur.Years, qDur.HalfYears, qDur.Quarters, qDur.Months, qDur.DateDays, qDur.Hours)
+	}
+
+	if qDur.TotDateNanoseconds+qDur.TotTimeNanoseconds != int64(qDur.TimeDuration) {
+		t.Errorf("Error: Expected allocated nanoseconds='%v'. Instead, '%v'",
+			int64(qDur.TimeDuration), qDur.TotDateNanoseconds+qDur.TotTimeNanoseconds)
+	}
+
+	expected := "5-Quarters 2-Months 5-Days 13-Hours 26-Minutes 46-Seconds 0-Milliseconds 0-Microseconds 0-Nanoseconds"
+
+	str, err := tDur.GetCumQuartersTimeStr()
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.GetCumQuartersTimeStr(). Error='%v'", err.Error())
+	} else if str != expected {
+		t.Errorf("Error: Expected GetCumQuartersTimeStr()='%v'. Instead, '%v'", expected, str)
+	}
+
+	hDur, err := tDur.GetCumHalfYearsCalcDto()
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.GetCumHalfYearsCalcDto(). Error='%v'", err.Error())
+		return
+	}
+
+	if hDur.CalcType != TDurCalcTypeCUMHALFYEARS || hDur.Quarters != 0 ||
+		hDur.HalfYears != 2 || hDur.Months != 5 || hDur.DateDays != 5 {
+		t.Errorf("Error: Expected 2-Half-Years 5-Months 5-Days. Instead, CalcType='%v' Quarters='%v' "+
+			"HalfYears='%v' Months='%v' DateDays='%v'", hDur.CalcType.String(),
+			hDur.Quarters, hDur.HalfYears, hDur.Months, hDur.DateDays)
+	}
+
+	expected = "2-Half-Years 5-Months 5-Days 13-Hours 26-Minutes 46-Seconds 0-Milliseconds 0-Microseconds 0-Nanoseconds"
+
+	str, err = tDur.GetCumHalfYearsTimeStr()
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.GetCumHalfYearsTimeStr(). Error='%v'", err.Error())
+	} else if str != expected {
+		t.Errorf("Error: Expected GetCumHalfYearsTimeStr()='%v'. Instead, '%v'", expected, str)
+	}
+
+	// The original TimeDurationDto is not altered.
+	if tDur.CalcType != TDurCalcTypeSTDYEARMTH || tDur.Years != 1 || tDur.Months != 5 {
+		t.Errorf("Error: Expected 1-Year 5-Months. Instead, CalcType='%v' Years='%v' Months='%v'",
+			tDur.CalcType.String(), tDur.Years, tDur.Months)
+	}
+}
+
+func TestTimeDurationDto_GetCumQuartersCalcDto_02(t *testing.T) {
+
+	// Month end overflow applies to quarters.
+	t1 := time.Date(2019, 11, 30, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(t1, t2, TDurCalcTypeCUMQUARTERS,
+		TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDurationDto{}.NewStartEndTimesCalcTz(). Error='%v'", err.Error())
+		return
+	}
+
+	// 2019-11-30 + 3 months = 2020-03-01 (Normalized)
+	if tDur.Quarters != 1 || tDur.Months != 0 || tDur.DateDays != 1 {
+		t.Errorf("Error: NORMALIZE Expected 1-Quarter 1-Day. Instead, Quarters='%v' Months='%v' DateDays='%v'",
+			tDur.Quarters, tDur.Months, tDur.DateDays)
+	}
+
+	err = tDur.SetMonthEndOverflow(MonthEndOverflowCLAMP)
+
+	if err != nil {
+		t.Errorf("Error returned by tDur.SetMonthEndOverflow(). Error='%v'", err.Error())
+		return
+	}
+
+	// 2019-11-30 + 3 months = 2020-02-29 (Clamped)
+	if tDur.Quarters != 1 || tDur.Months != 0 || tDur.DateDays != 2 {
+		t.Errorf("Error: CLAMP Expected 1-Quarter 2-Days. Instead, Quarters='%v' Months='%v' DateDays='%v'",
+			tDur.Quarters, tDur.Months, tDur.DateDays)
+	}
+
+	durFmt, err := DurationFormatDto{}.New("{Quarters} {Months} {DateDays}")
+
+	if err != nil {
+		t.Errorf("Error returned by DurationFormatDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	durFmt.LargestUnit = TimeUnitQUARTERS
+	durFmt.ZeroSuppression = TDurZeroSuppressALL
+
+	locale, _ := LocaleDto{}.New(LocaleTagGerman)
+
+	durFmt.SetLocale(locale)
+
+	str, err := durFmt.Format(tDur)
+
+	if err != nil {
+		t.Errorf("Error returned by durFmt.Format(tDur). Error='%v'", err.Error())
+	} else if str != "1 Quartal 2 Tage" {
+		t.Errorf("Error: Expected durFmt.Format()='1 Quartal 2 Tage'. Instead, '%v'", str)
+	}
+
+	if TDurCalcTypeCUMHALFYEARS.String() != "CumHalfYearsCalc" {
+		t.Errorf("Error: Expected String()='CumHalfYearsCalc'. Instead, String()='%v'",
+			TDurCalcTypeCUMHALFYEARS.String())
+	}
+}
@@ -0,0 +1,320 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+// testInterval - Returns the interval [startHour, endHour) on
+// 2026-10-19 in time zone America/Chicago.
+func testInterval(t *testing.T, startHour, endHour int) TimeIntervalDto {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	start, _ := DateTzDto{}.New(time.Date(2026, 10, 19, startHour, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+	end, _ := DateTzDto{}.New(time.Date(2026, 10, 19, endHour, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	interval, err := TimeIntervalDto{}.New(start, end)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeIntervalDto{}.New(). Error='%v'", err.Error())
+	}
+
+	return interval
+}
+
+// testIntervalHours - Returns the start and end hours of the intervals.
+func testIntervalHours(intervals []TimeIntervalDto) [][2]int {
+
+	hours := make([][2]int, len(intervals))
+
+	for i, interval := range intervals {
+		hours[i] = [2]int{interval.StartTime.DateTime.Hour(), interval.EndTime.DateTime.Hour()}
+	}
+
+	return hours
+}
+
+func testCheckIntervalHours(t *testing.T, label string, intervals []TimeIntervalDto, expected [][2]int) {
+
+	actual := testIntervalHours(intervals)
+
+	if len(actual) != len(expected) {
+		t.Errorf("Error: %v Expected intervals='%v'. Instead, intervals='%v'", label, expected, actual)
+		return
+	}
+
+	for i := range actual {
+
+		if actual[i] != expected[i] {
+			t.Errorf("Error: %v Expected intervals='%v'. Instead, intervals='%v'", label, expected, actual)
+			return
+		}
+	}
+}
+
+func TestTimeIntervalDto_New_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	start, _ := DateTzDto{}.New(time.Date(2026, 10, 19, 10, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+	end, _ := DateTzDto{}.New(time.Date(2026, 10, 19, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	_, err := TimeIntervalDto{}.New(start, end)
+
+	if err == nil {
+		t.Error("Error: Expected an error for 'endTime' earlier than 'startTime'. Instead, no error was returned.")
+	}
+
+	_, err = TimeIntervalDto{}.NewStartDuration(start, -time.Hour)
+
+	if err == nil {
+		t.Error("Error: Expected an error for a negative duration. Instead, no error was returned.")
+	}
+
+	interval, err := TimeIntervalDto{}.NewStartDuration(start, 90*time.Minute)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeIntervalDto{}.NewStartDuration(). Error='%v'", err.Error())
+		return
+	}
+
+	if interval.GetDuration() != 90*time.Minute {
+		t.Errorf("Error: Expected duration='1h30m0s'. Instead, duration='%v'", interval.GetDuration())
+	}
+
+	if interval.EndTime.DateTime.Location().String() != TzIanaUsCentral {
+		t.Errorf("Error: Expected EndTime location='%v'. Instead, location='%v'",
+			TzIanaUsCentral, interval.EndTime.DateTime.Location().String())
+	}
+}
+
+func TestTimeIntervalDto_Contains_01(t *testing.T) {
+
+	interval := testInterval(t, 9, 17)
+
+	testCases := []struct {
+		hour     int
+		expected bool
+	}{
+		{8, false},
+		{9, true},
+		{16, true},
+		{17, false},
+	}
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	for _, tc := range testCases {
+
+		dtz, _ := DateTzDto{}.New(time.Date(2026, 10, 19, tc.hour, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+		if interval.Contains(dtz) != tc.expected {
+			t.Errorf("Error: Expected Contains(%v:00)='%v'. Instead, Contains='%v'",
+				tc.hour, tc.expected, !tc.expected)
+		}
+	}
+
+	// The same instant in a different time zone
+	utcDtz, _ := DateTzDto{}.New(time.Date(2026, 10, 19, 14, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	if !interval.Contains(utcDtz) {
+		t.Errorf("Error: Expected Contains('%v')='true'. Instead, Contains='false'", utcDtz.String())
+	}
+
+	if !interval.ContainsInterval(testInterval(t, 9, 17)) ||
+		!interval.ContainsInterval(testInterval(t, 10, 12)) ||
+		interval.ContainsInterval(testInterval(t, 8, 10)) {
+		t.Error("Error: ContainsInterval() returned an incorrect result.")
+	}
+}
+
+func TestTimeIntervalDto_Overlaps_01(t *testing.T) {
+
+	a := testInterval(t, 9, 12)
+
+	testCases := []struct {
+		b          TimeIntervalDto
+		overlaps   bool
+		isAdjacent bool
+	}{
+		{testInterval(t, 11, 14), true, false},
+		{testInterval(t, 12, 14), false, true},
+		{testInterval(t, 7, 9), false, true},
+		{testInterval(t, 13, 14), false, false},
+		{testInterval(t, 10, 10), false, false},
+	}
+
+	for i, tc := range testCases {
+
+		if a.Overlaps(tc.b) != tc.overlaps {
+			t.Errorf("Error: Test %v Expected Overlaps='%v'. Instead, Overlaps='%v'", i, tc.overlaps, !tc.overlaps)
+		}
+
+		if a.IsAdjacent(tc.b) != tc.isAdjacent {
+			t.Errorf("Error: Test %v Expected IsAdjacent='%v'. Instead, IsAdjacent='%v'",
+				i, tc.isAdjacent, !tc.isAdjacent)
+		}
+	}
+}
+
+func TestTimeIntervalDto_SetOperations_01(t *testing.T) {
+
+	a := testInterval(t, 9, 12)
+
+	common, ok := a.Intersection(testInterval(t, 11, 14))
+
+	if !ok || !common.Equal(testInterval(t, 11, 12)) {
+		t.Errorf("Error: Expected Intersection='[11, 12)'. Instead, Intersection='%v'", common.String())
+	}
+
+	_, ok = a.Intersection(testInterval(t, 12, 14))
+
+	if ok {
+		t.Error("Error: Expected no intersection for adjacent intervals.")
+	}
+
+	union, err := a.Union(testInterval(t, 12, 14))
+
+	if err != nil || !union.Equal(testInterval(t, 9, 14)) {
+		t.Errorf("Error: Expected Union='[9, 14)'. Instead, Union='%v' err='%v'", union.String(), err)
+	}
+
+	_, err = a.Union(testInterval(t, 13, 14))
+
+	if err == nil {
+		t.Error("Error: Expected an error for the union of disjoint intervals. Instead, no error was returned.")
+	}
+
+	testCheckIntervalHours(t, "Difference([10, 11))", a.Difference(testInterval(t, 10, 11)),
+		[][2]int{{9, 10}, {11, 12}})
+
+	testCheckIntervalHours(t, "Difference([8, 10))", a.Difference(testInterval(t, 8, 10)),
+		[][2]int{{10, 12}})
+
+	testCheckIntervalHours(t, "Difference([8, 13))", a.Difference(testInterval(t, 8, 13)),
+		[][2]int{})
+
+	testCheckIntervalHours(t, "Difference([12, 13))", a.Difference(testInterval(t, 12, 13)),
+		[][2]int{{9, 12}})
+
+	gap, ok := a.Gap(testInterval(t, 14, 15))
+
+	if !ok || !gap.Equal(testInterval(t, 12, 14)) {
+		t.Errorf("Error: Expected Gap='[12, 14)'. Instead, Gap='%v'", gap.String())
+	}
+
+	_, ok = a.Gap(testInterval(t, 12, 15))
+
+	if ok {
+		t.Error("Error: Expected no gap for adjacent intervals.")
+	}
+}
+
+func TestTimeIntervalDto_GetTimeDurationDto_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	start, _ := DateTzDto{}.New(time.Date(2026, 1, 15, 8, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+	end, _ := DateTzDto{}.New(time.Date(2026, 3, 7, 10, 30, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	interval, _ := TimeIntervalDto{}.New(start, end)
+
+	tDur, err := interval.GetTimeDurationDto(TDurCalcTypeCUMDAYS, "")
+
+	if err != nil {
+		t.Errorf("Error returned by interval.GetTimeDurationDto(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur.CalcType != TDurCalcTypeCUMDAYS || tDur.DateDays != 51 || tDur.Hours != 2 || tDur.Minutes != 30 {
+		t.Errorf("Error: Expected CalcType='%v' 51 days 2 hours 30 minutes. Instead, CalcType='%v' "+
+			"DateDays='%v' Hours='%v' Minutes='%v'",
+			TDurCalcTypeCUMDAYS.String(), tDur.CalcType.String(), tDur.DateDays, tDur.Hours, tDur.Minutes)
+	}
+
+	tDur, err = interval.GetTimeDurationDto(TDurCalcTypeCUMHOURS, "")
+
+	if err != nil {
+		t.Errorf("Error returned by interval.GetTimeDurationDto(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur.TimeDuration != interval.GetDuration() || tDur.Hours != 51*24+2 {
+		t.Errorf("Error: Expected TimeDuration='%v' Hours='%v'. Instead, TimeDuration='%v' Hours='%v'",
+			interval.GetDuration(), 51*24+2, tDur.TimeDuration, tDur.Hours)
+	}
+}
+
+func TestIntervalSet_New_01(t *testing.T) {
+
+	iSet := IntervalSet{}.New(
+		testInterval(t, 15, 16),
+		testInterval(t, 9, 11),
+		testInterval(t, 10, 12),
+		testInterval(t, 12, 13),
+		testInterval(t, 14, 14))
+
+	testCheckIntervalHours(t, "New()", iSet.GetIntervals(), [][2]int{{9, 13}, {15, 16}})
+
+	if iSet.GetTotalDuration() != 5*time.Hour {
+		t.Errorf("Error: Expected total duration='5h0m0s'. Instead, total duration='%v'", iSet.GetTotalDuration())
+	}
+
+	testCheckIntervalHours(t, "GetGaps()", iSet.GetGaps(), [][2]int{{13, 15}})
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	dtz, _ := DateTzDto{}.New(time.Date(2026, 10, 19, 13, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	if iSet.Contains(dtz) {
+		t.Error("Error: Expected Contains(13:00)='false'. Instead, Contains='true'")
+	}
+
+	dtz, _ = DateTzDto{}.New(time.Date(2026, 10, 19, 15, 30, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	if !iSet.Contains(dtz) {
+		t.Error("Error: Expected Contains(15:30)='true'. Instead, Contains='false'")
+	}
+
+	if iSet.Overlaps(testInterval(t, 13, 15)) || !iSet.Overlaps(testInterval(t, 12, 15)) {
+		t.Error("Error: Overlaps() returned an incorrect result.")
+	}
+}
+
+func TestIntervalSet_SetOperations_01(t *testing.T) {
+
+	shifts := IntervalSet{}.New(testInterval(t, 8, 12), testInterval(t, 13, 17))
+
+	bookings := IntervalSet{}.New(
+		testInterval(t, 7, 9),
+		testInterval(t, 10, 11),
+		testInterval(t, 11, 14),
+		testInterval(t, 16, 18))
+
+	free := shifts.Difference(bookings)
+
+	testCheckIntervalHours(t, "Difference()", free.GetIntervals(), [][2]int{{9, 10}, {14, 16}})
+
+	booked := shifts.Intersection(bookings)
+
+	testCheckIntervalHours(t, "Intersection()", booked.GetIntervals(),
+		[][2]int{{8, 9}, {10, 12}, {13, 14}, {16, 17}})
+
+	all := shifts.Union(bookings)
+
+	testCheckIntervalHours(t, "Union()", all.GetIntervals(), [][2]int{{7, 18}})
+
+	empty := IntervalSet{}
+
+	emptyIntersection := shifts.Intersection(empty)
+
+	if !emptyIntersection.IsEmpty() {
+		t.Error("Error: Expected an empty intersection with an empty set.")
+	}
+
+	unchanged := shifts.Difference(empty)
+
+	testCheckIntervalHours(t, "Difference(empty)", unchanged.GetIntervals(),
+		[][2]int{{8, 12}, {13, 17}})
+}
@@ -0,0 +1,222 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeMathModeType_DateTzDto_01(t *testing.T) {
+
+	// Daylight Savings Time begins in America/Chicago on 2026-03-08.
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	dtz, err := DateTzDto{}.New(time.Date(2026, 3, 7, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	layout := "2006-01-02 15:04 MST"
+
+	dtz2, err := dtz.AddDateTimeMode(0, 0, 1, 0, 0, 0, 0, 0, 0, TimeMathABSOLUTE, MonthEndOverflowNORMALIZE, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddDateTimeMode(ABSOLUTE). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz2.DateTime.Format(layout) != "2026-03-08 10:00 CDT" {
+		t.Errorf("Error: Expected ABSOLUTE='2026-03-08 10:00 CDT'. Instead, ABSOLUTE='%v'", dtz2.DateTime.Format(layout))
+	}
+
+	dtz2, err = dtz.AddDateTimeMode(0, 0, 1, 0, 0, 0, 0, 0, 0, TimeMathWALLCLOCK, MonthEndOverflowNORMALIZE, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddDateTimeMode(WALLCLOCK). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz2.DateTime.Format(layout) != "2026-03-08 09:00 CDT" {
+		t.Errorf("Error: Expected WALLCLOCK='2026-03-08 09:00 CDT'. Instead, WALLCLOCK='%v'", dtz2.DateTime.Format(layout))
+	}
+
+	// 24 wall clock hours
+	dtz2, _ = dtz.AddDurationMode(24*time.Hour, TimeMathWALLCLOCK, "")
+
+	if dtz2.DateTime.Format(layout) != "2026-03-08 09:00 CDT" {
+		t.Errorf("Error: Expected Duration WALLCLOCK='2026-03-08 09:00 CDT'. Instead, value='%v'", dtz2.DateTime.Format(layout))
+	}
+
+	dtz2, _ = dtz.AddDurationMode(24*time.Hour, TimeMathABSOLUTE, "")
+
+	if !dtz2.DateTime.Equal(dtz.DateTime.Add(24 * time.Hour)) {
+		t.Errorf("Error: Expected Duration ABSOLUTE='%v'. Instead, value='%v'",
+			dtz.DateTime.Add(24*time.Hour).Format(layout), dtz2.DateTime.Format(layout))
+	}
+
+	// Existing methods use absolute arithmetic.
+	dtz2, _ = dtz.AddDate(0, 0, 1, "")
+
+	if dtz2.DateTime.Format(layout) != "2026-03-08 10:00 CDT" {
+		t.Errorf("Error: Expected AddDate='2026-03-08 10:00 CDT'. Instead, AddDate='%v'", dtz2.DateTime.Format(layout))
+	}
+
+	oneDay, _ := TimeDto{}.New(0, 0, 0, 1, 0, 0, 0, 0, 0, 0)
+
+	dtz2, err = dtz.AddPlusTimeDtoMode(oneDay, TimeMathWALLCLOCK, MonthEndOverflowNORMALIZE)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddPlusTimeDtoMode(). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz2.DateTime.Format(layout) != "2026-03-08 09:00 CDT" {
+		t.Errorf("Error: Expected PlusTimeDto='2026-03-08 09:00 CDT'. Instead, value='%v'", dtz2.DateTime.Format(layout))
+	}
+
+	// Daylight Savings Time ends in America/Chicago on 2026-11-01.
+	dtz, _ = DateTzDto{}.New(time.Date(2026, 11, 1, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	dtz2, err = dtz.AddMinusTimeDtoMode(oneDay, TimeMathWALLCLOCK, MonthEndOverflowNORMALIZE)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddMinusTimeDtoMode(). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz2.DateTime.Format(layout) != "2026-10-31 09:00 CDT" {
+		t.Errorf("Error: Expected MinusTimeDto WALLCLOCK='2026-10-31 09:00 CDT'. Instead, value='%v'", dtz2.DateTime.Format(layout))
+	}
+
+	dtz2, _ = dtz.AddMinusTimeDto(oneDay)
+
+	if dtz2.DateTime.Format(layout) != "2026-10-31 10:00 CDT" {
+		t.Errorf("Error: Expected MinusTimeDto='2026-10-31 10:00 CDT'. Instead, value='%v'", dtz2.DateTime.Format(layout))
+	}
+}
+
+func TestTimeMathModeType_DateTzDto_02(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	layout := "2006-01-02 15:04 MST"
+
+	// 02:30 does not exist on 2026-03-08. The first instant after the gap is used.
+	dtz, _ := DateTzDto{}.New(time.Date(2026, 3, 7, 2, 30, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	err := dtz.AddDateTimeModeToThis(0, 0, 1, 0, 0, 0, 0, 0, 0, TimeMathWALLCLOCK, MonthEndOverflowNORMALIZE)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddDateTimeModeToThis(). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz.DateTime.Format(layout) != "2026-03-08 03:00 CDT" {
+		t.Errorf("Error: Expected Gap='2026-03-08 03:00 CDT'. Instead, Gap='%v'", dtz.DateTime.Format(layout))
+	}
+
+	// 01:30 occurs twice on 2026-11-01. The first occurrence is used.
+	dtz, _ = DateTzDto{}.New(time.Date(2026, 10, 31, 1, 30, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	err = dtz.AddDurationModeToThis(24*time.Hour, TimeMathWALLCLOCK)
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddDurationModeToThis(). Error='%v'", err.Error())
+		return
+	}
+
+	if dtz.DateTime.Format(layout) != "2026-11-01 01:30 CDT" {
+		t.Errorf("Error: Expected Overlap='2026-11-01 01:30 CDT'. Instead, Overlap='%v'", dtz.DateTime.Format(layout))
+	}
+
+	// Months are calendar units in both modes.
+	dtz, _ = DateTzDto{}.New(time.Date(2026, 1, 31, 9, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	dtz2, _ := dtz.AddDateTimeMode(0, 1, 0, 0, 0, 0, 0, 0, 0, TimeMathWALLCLOCK, MonthEndOverflowCLAMP, "")
+
+	if dtz2.DateTime.Format(layout) != "2026-02-28 09:00 CST" {
+		t.Errorf("Error: Expected Month='2026-02-28 09:00 CST'. Instead, Month='%v'", dtz2.DateTime.Format(layout))
+	}
+
+	_, err = dtz.AddDateTimeMode(0, 0, 1, 0, 0, 0, 0, 0, 0, TimeMathModeType(5), MonthEndOverflowNORMALIZE, "")
+
+	if err == nil {
+		t.Error("Error: Expected an error for an invalid time math mode. Instead, no error was returned.")
+	}
+
+	if TimeMathWALLCLOCK.String() != "WallClock" {
+		t.Errorf("Error: Expected String()='WallClock'. Instead, String()='%v'", TimeMathWALLCLOCK.String())
+	}
+}
+
+func TestTimeMathModeType_TimeZoneDto_01(t *testing.T) {
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	tIn := time.Date(2026, 3, 7, 9, 0, 0, 0, loc)
+
+	tzDto, err := TimeZoneDto{}.New(tIn, TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDto{}.New(). Error='%v'", err.Error())
+		return
+	}
+
+	tzDto2 := tzDto.CopyOut()
+
+	err = tzDto2.AddDateTimeMode(0, 0, 0, 24, 0, 0, 0, 0, 0, TimeMathWALLCLOCK, MonthEndOverflowNORMALIZE)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDto2.AddDateTimeMode(). Error='%v'", err.Error())
+		return
+	}
+
+	// 2026-03-08 09:00 CDT is 14:00 UTC.
+	if tzDto2.TimeOut.DateTime.Format("2006-01-02 15:04") != "2026-03-08 14:00" {
+		t.Errorf("Error: Expected TimeOut='2026-03-08 14:00'. Instead, TimeOut='%v'",
+			tzDto2.TimeOut.DateTime.Format("2006-01-02 15:04"))
+	}
+
+	tzDto2 = tzDto.CopyOut()
+
+	err = tzDto2.AddDurationMode(24*time.Hour, TimeMathABSOLUTE)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDto2.AddDurationMode(). Error='%v'", err.Error())
+		return
+	}
+
+	if tzDto2.TimeOut.DateTime.Format("2006-01-02 15:04") != "2026-03-08 15:00" {
+		t.Errorf("Error: Expected TimeOut='2026-03-08 15:00'. Instead, TimeOut='%v'",
+			tzDto2.TimeOut.DateTime.Format("2006-01-02 15:04"))
+	}
+
+	oneWeek, _ := TimeDto{}.New(0, 0, 1, 0, 0, 0, 0, 0, 0, 0)
+
+	tzDto2 = tzDto.CopyOut()
+
+	err = tzDto2.AddPlusTimeDtoMode(oneWeek, TimeMathWALLCLOCK, MonthEndOverflowNORMALIZE)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDto2.AddPlusTimeDtoMode(). Error='%v'", err.Error())
+		return
+	}
+
+	if tzDto2.TimeIn.DateTime.Format("2006-01-02 15:04 MST") != "2026-03-14 09:00 CDT" {
+		t.Errorf("Error: Expected TimeIn='2026-03-14 09:00 CDT'. Instead, TimeIn='%v'",
+			tzDto2.TimeIn.DateTime.Format("2006-01-02 15:04 MST"))
+	}
+
+	err = tzDto2.AddMinusTimeDtoMode(oneWeek, TimeMathWALLCLOCK, MonthEndOverflowNORMALIZE)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDto2.AddMinusTimeDtoMode(). Error='%v'", err.Error())
+		return
+	}
+
+	if !tzDto2.TimeIn.DateTime.Equal(tIn) {
+		t.Errorf("Error: Expected TimeIn='%v'. Instead, TimeIn='%v'",
+			tIn.Format("2006-01-02 15:04 MST"), tzDto2.TimeIn.DateTime.Format("2006-01-02 15:04 MST"))
+	}
+}
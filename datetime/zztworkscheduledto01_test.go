@@ -0,0 +1,209 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWorkScheduleDto_NewStartEndWorkingTime_01(t *testing.T) {
+
+	schedule, err := WorkScheduleDto{}.NewMonFri(TzIanaUsCentral, "09:00", "17:00",
+		HolidayCalendarDto{}.NewUS())
+
+	if err != nil {
+		t.Errorf("Error returned by WorkScheduleDto{}.NewMonFri(). Error='%v'", err.Error())
+		return
+	}
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	// Friday 16:00 through Thursday 10:30. July 4th is a holiday.
+	startDtz, _ := DateTzDto{}.New(time.Date(2018, 6, 29, 16, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+	endDtz, _ := DateTzDto{}.New(time.Date(2018, 7, 5, 10, 30, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	tDur, err := TimeDurationDto{}.NewStartEndWorkingTime(startDtz, endDtz, schedule, "")
+
+	if err != nil {
+		t.Errorf("Error returned by NewStartEndWorkingTime(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := 18*time.Hour + 30*time.Minute
+
+	if expected != tDur.TimeDuration {
+		t.Errorf("Error: Expected TimeDuration='%v'. Instead, TimeDuration='%v'", expected, tDur.TimeDuration)
+	}
+
+	if tDur.CalcType != TDurCalcTypeWORKINGHOURS {
+		t.Errorf("Error: Expected CalcType='WorkingHoursCalc'. Instead, CalcType='%v'", tDur.CalcType.String())
+	}
+
+	if tDur.Hours != 18 || tDur.Minutes != 30 || tDur.DateDays != 0 {
+		t.Errorf("Error: Expected 18-Hours 30-Minutes. Instead, DateDays='%v' Hours='%v' Minutes='%v'",
+			tDur.DateDays, tDur.Hours, tDur.Minutes)
+	}
+
+	if !tDur.EndTimeDateTz.DateTime.Equal(endDtz.DateTime) {
+		t.Errorf("Error: Expected EndTime='%v'. Instead, EndTime='%v'",
+			endDtz.DateTime.Format(FmtDateTimeYrMDayFmtStr),
+			tDur.EndTimeDateTz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	// Reversed start and end date times are swapped.
+	tDur2, err := TimeDurationDto{}.NewStartEndWorkingTime(endDtz, startDtz, schedule, "")
+
+	if err != nil {
+		t.Errorf("Error returned by NewStartEndWorkingTime(endDtz, startDtz). Error='%v'", err.Error())
+		return
+	}
+
+	if expected != tDur2.TimeDuration {
+		t.Errorf("Error: Expected reversed TimeDuration='%v'. Instead, TimeDuration='%v'",
+			expected, tDur2.TimeDuration)
+	}
+}
+
+func TestWorkScheduleDto_NewStartWorkingDuration_01(t *testing.T) {
+
+	schedule, err := WorkScheduleDto{}.NewMonFri(TzIanaUsCentral, "09:00", "17:00",
+		HolidayCalendarDto{}.NewUS())
+
+	if err != nil {
+		t.Errorf("Error returned by WorkScheduleDto{}.NewMonFri(). Error='%v'", err.Error())
+		return
+	}
+
+	loc, _ := time.LoadLocation(TzIanaUsCentral)
+
+	startDtz, _ := DateTzDto{}.New(time.Date(2018, 7, 2, 13, 0, 0, 0, loc), FmtDateTimeYrMDayFmtStr)
+
+	tDur, err := TimeDurationDto{}.NewStartWorkingDuration(startDtz, 16*time.Hour, schedule, "")
+
+	if err != nil {
+		t.Errorf("Error returned by NewStartWorkingDuration(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := time.Date(2018, 7, 5, 13, 0, 0, 0, loc)
+
+	if !expected.Equal(tDur.EndTimeDateTz.DateTime) {
+		t.Errorf("Error: Expected EndTime='%v'. Instead, EndTime='%v'",
+			expected.Format(FmtDateTimeYrMDayFmtStr), tDur.EndTimeDateTz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	if tDur.TimeDuration != 16*time.Hour || tDur.Hours != 16 {
+		t.Errorf("Error: Expected 16-Hours. Instead, TimeDuration='%v' Hours='%v'",
+			tDur.TimeDuration, tDur.Hours)
+	}
+
+	// The inverse calculation reproduces the working time.
+	tDur2, err := TimeDurationDto{}.NewStartEndWorkingTime(tDur.StartTimeDateTz, tDur.EndTimeDateTz, schedule, "")
+
+	if err != nil {
+		t.Errorf("Error returned by NewStartEndWorkingTime(). Error='%v'", err.Error())
+		return
+	}
+
+	if tDur2.TimeDuration != 16*time.Hour {
+		t.Errorf("Error: Expected inverse TimeDuration='16h0m0s'. Instead, TimeDuration='%v'",
+			tDur2.TimeDuration)
+	}
+
+	// Negative working time computes the starting date time.
+	tDur3, err := TimeDurationDto{}.NewStartWorkingDuration(startDtz, -6*time.Hour, schedule, "")
+
+	if err != nil {
+		t.Errorf("Error returned by NewStartWorkingDuration(-6h). Error='%v'", err.Error())
+		return
+	}
+
+	expected = time.Date(2018, 6, 29, 15, 0, 0, 0, loc)
+
+	if !expected.Equal(tDur3.StartTimeDateTz.DateTime) {
+		t.Errorf("Error: Expected StartTime='%v'. Instead, StartTime='%v'",
+			expected.Format(FmtDateTimeYrMDayFmtStr), tDur3.StartTimeDateTz.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+}
+
+func TestWorkScheduleDto_AddWorkPeriod_01(t *testing.T) {
+
+	schedule := WorkScheduleDto{}
+
+	schedule.TimeZoneLocation = TzIanaUTC
+
+	err := schedule.AddWorkPeriod(time.Monday, "13:00", "17:00")
+
+	if err != nil {
+		t.Errorf("Error returned by AddWorkPeriod(13:00-17:00). Error='%v'", err.Error())
+		return
+	}
+
+	err = schedule.AddWorkPeriod(time.Monday, "08:00", "12:00")
+
+	if err != nil {
+		t.Errorf("Error returned by AddWorkPeriod(08:00-12:00). Error='%v'", err.Error())
+		return
+	}
+
+	err = schedule.AddWorkPeriod(time.Monday, "11:00", "14:00")
+
+	if err == nil {
+		t.Error("Error: Expected an error for an overlapping work period. NO ERROR WAS RETURNED!")
+	}
+
+	err = schedule.AddWorkPeriod(time.Tuesday, "09:00", "25:00")
+
+	if err == nil {
+		t.Error("Error: Expected an error for time of day '25:00'. NO ERROR WAS RETURNED!")
+	}
+
+	if schedule.GetWeeklyWorkingTime() != 8*time.Hour {
+		t.Errorf("Error: Expected weekly working time='8h0m0s'. Instead, weekly working time='%v'",
+			schedule.GetWeeklyWorkingTime())
+	}
+
+	// Monday 2018-07-02 10:00 plus 3 hours spans the lunch break.
+	dtz, _ := DateTzDto{}.New(time.Date(2018, 7, 2, 10, 0, 0, 0, time.UTC), FmtDateTimeYrMDayFmtStr)
+
+	dtz2, err := dtz.AddWorkingDuration(3*time.Hour, schedule, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddWorkingDuration(). Error='%v'", err.Error())
+		return
+	}
+
+	expected := time.Date(2018, 7, 2, 14, 0, 0, 0, time.UTC)
+
+	if !expected.Equal(dtz2.DateTime) {
+		t.Errorf("Error: Expected DateTime='%v'. Instead, DateTime='%v'",
+			expected.Format(FmtDateTimeYrMDayFmtStr), dtz2.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	// Nine hours carries over to the following Monday.
+	dtz3, err := dtz.AddWorkingDuration(9*time.Hour, schedule, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dtz.AddWorkingDuration(9h). Error='%v'", err.Error())
+		return
+	}
+
+	expected = time.Date(2018, 7, 9, 11, 0, 0, 0, time.UTC)
+
+	if !expected.Equal(dtz3.DateTime) {
+		t.Errorf("Error: Expected DateTime='%v'. Instead, DateTime='%v'",
+			expected.Format(FmtDateTimeYrMDayFmtStr), dtz3.DateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+}
+
+func TestWorkScheduleDto_ReCalcTimeDurationAllocation_01(t *testing.T) {
+
+	tDur, err := TimeDurationDto{}.NewStartEndTimesCalcTz(
+		time.Date(2018, 7, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2018, 7, 2, 17, 0, 0, 0, time.UTC),
+		TDurCalcTypeWORKINGHOURS, TzIanaUTC, FmtDateTimeYrMDayFmtStr)
+
+	if err == nil {
+		t.Errorf("Error: Expected an error for TDurCalcTypeWORKINGHOURS without a schedule. " +
+			"NO ERROR WAS RETURNED! TimeDuration='%v'", tDur.TimeDuration)
+	}
+}